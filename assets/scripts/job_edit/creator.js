@@ -1,7 +1,8 @@
 (function() {
 
   function TaskCreator(id) {
-    this._base = document.getElementsByClassName('task-' + id)[0];
+    var templates = document.getElementById('task-templates');
+    this._base = templates.getElementsByClassName('task-' + id)[0];
   }
 
   TaskCreator.prototype.create = function() {
@@ -16,23 +17,33 @@
       var addArg = el.getElementsByClassName('add-button')[0];
       addArg.onclick = function() {
         var field = document.createElement('div');
-        field.className = 'text-field';
+        field.className = 'text-field gorun-arg';
         var valContainer = document.createElement('div');
         valContainer.className = 'field-value';
         var val = document.createElement('input');
         valContainer.appendChild(val);
         field.appendChild(valContainer);
 
-        var existing = el.getElementsByClassName('text-field');
-        el.insertBefore(field, existing[existing.length - 1].nextElementSibling);
+        el.insertBefore(field, addArg.parentNode);
       };
       deleteArg.onclick = function() {
-        var fields = el.getElementsByClassName('text-field');
-        if (fields.length > 1) {
+        var fields = el.getElementsByClassName('gorun-arg');
+        if (fields.length > 0) {
           var f = fields[fields.length - 1];
           f.parentNode.removeChild(f);
         }
       };
+    },
+    'group': function(el) {
+      // Nested groups come first in the document, so only
+      // the group's own children are searched.
+      var tasks = window.childByClass(el, 'group-tasks');
+      var buttons = window.childByClass(el, 'pane-buttons');
+      var addType = buttons.getElementsByClassName('group-add-type')[0];
+      var addTask = buttons.getElementsByClassName('group-add-button')[0];
+      addTask.onclick = function() {
+        tasks.appendChild(window.creators()[addType.value].create());
+      };
     }
   };
 
@@ -67,7 +78,8 @@
     }
   }
 
-  var creatorIDs = ['filetransfer', 'gorun', 'exit'];
+  var creatorIDs = ['filetransfer', 'blobtransfer', 'urlfetch', 'archiveextract',
+    'archivecreate', 'inlinefile', 'gorun', 'exit', 'group'];
   var creators = null;
   window.creators = function() {
     if (creators === null) {
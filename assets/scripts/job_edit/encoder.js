@@ -1,22 +1,66 @@
 (function() {
 
   function encodeTasks() {
-    var taskContainer = document.getElementById('tasks');
-    var tasks = taskContainer.getElementsByClassName('task');
+    return encodeTaskList(document.getElementById('tasks'));
+  }
+
+  function encodeTaskList(container) {
     var res = [];
-    for (var i = 0, len = tasks.length; i < len; ++i) {
-      res.push(encodeTask(tasks[i]));
+    for (var i = 0, len = container.children.length; i < len; ++i) {
+      var el = container.children[i];
+      if (/(^| )task( |$)/.exec(el.className)) {
+        res.push(encodeTask(el));
+      }
     }
     return res;
   }
 
   function encodeTask(el) {
     var id = taskElementID(el);
-    return {
+    var res = {
       filetransfer: encodeFileTransfer,
+      blobtransfer: encodeBlobTransfer,
+      urlfetch: encodeURLFetch,
+      archiveextract: encodeArchiveExtract,
+      archivecreate: encodeArchiveCreate,
+      inlinefile: encodeInlineFile,
       gorun: encodeGoRun,
-      exit: encodeExit
+      exit: encodeExit,
+      group: encodeGroup
     }[id](el);
+    if (id !== 'group') {
+      res.Retry = encodeRetry(el.getElementsByClassName('task-retry')[0]);
+    }
+    var runIf = el.getElementsByClassName('task-runif')[0];
+    res.RunIf = runIf.getElementsByTagName('select')[0].value;
+    return res;
+  }
+
+  function encodeRetry(el) {
+    var inputs = el.getElementsByTagName('input');
+    var maxRetries = parseInt(inputs[0].value);
+    if (isNaN(maxRetries)) {
+      throw 'bad Retries';
+    } else if (maxRetries === 0) {
+      return null;
+    }
+    var exitCodes = splitList(inputs[1].value, ',').map(function(code) {
+      var num = parseInt(code);
+      if (isNaN(num)) {
+        throw 'bad Retry exit codes';
+      }
+      return num;
+    });
+    var delay = parseInt(inputs[3].value);
+    if (isNaN(delay)) {
+      throw 'bad Retry delay';
+    }
+    return {
+      MaxRetries: maxRetries,
+      ExitCodes: exitCodes,
+      Signals: !!inputs[2].checked,
+      Delay: delay
+    };
   }
 
   function encodeFileTransfer(el) {
@@ -30,17 +74,112 @@
     };
   }
 
+  function encodeBlobTransfer(el) {
+    var inputs = el.getElementsByTagName('input');
+    return {
+      BlobTransfer: {
+        Hash: inputs[0].value.trim(),
+        SlavePath: inputs[1].value
+      }
+    };
+  }
+
+  function encodeURLFetch(el) {
+    var inputs = el.getElementsByTagName('input');
+    var maxSize = parseInt(inputs[3].value);
+    if (isNaN(maxSize) || maxSize < 0) {
+      throw 'bad Max size';
+    }
+    var retries = parseInt(inputs[4].value);
+    if (isNaN(retries) || retries < 0) {
+      throw 'bad Download retries';
+    }
+    return {
+      URLFetch: {
+        URL: inputs[0].value.trim(),
+        SlavePath: inputs[1].value,
+        SHA256: inputs[2].value.trim(),
+        MaxSize: maxSize,
+        Retries: retries
+      }
+    };
+  }
+
+  function encodeArchiveExtract(el) {
+    var inputs = el.getElementsByTagName('input');
+    return {
+      ArchiveExtract: {
+        Archive: inputs[0].value,
+        Dest: inputs[1].value,
+        Format: inputs[2].value.trim()
+      }
+    };
+  }
+
+  function encodeArchiveCreate(el) {
+    var inputs = el.getElementsByTagName('input');
+    var textAreas = el.getElementsByTagName('textarea');
+    return {
+      ArchiveCreate: {
+        Archive: inputs[0].value,
+        Format: inputs[1].value.trim(),
+        Patterns: splitList(textAreas[0].value, '\n')
+      }
+    };
+  }
+
+  function encodeInlineFile(el) {
+    var inputs = el.getElementsByTagName('input');
+    var textAreas = el.getElementsByTagName('textarea');
+    var mode = parseInt(inputs[1].value, 8);
+    if (isNaN(mode) || mode < 0 || mode > 0777) {
+      throw 'bad Mode';
+    }
+    return {
+      InlineFile: {
+        SlavePath: inputs[0].value,
+        Mode: mode,
+        Template: !!inputs[2].checked,
+        Content: textAreas[0].value
+      }
+    };
+  }
+
   function encodeGoRun(el) {
     var inputs = el.getElementsByTagName('input');
+    var textAreas = el.getElementsByTagName('textarea');
     var res = {
       GoRun: {
         GoPath: inputs[0].value,
         GoSourceDir: inputs[1].value,
+        Package: inputs[2].value,
+        Tags: splitList(inputs[3].value, ','),
+        LDFlags: inputs[4].value,
+        TrimPath: !!inputs[5].checked,
+        DisableCGO: !!inputs[6].checked,
+        BuildEnv: splitList(textAreas[0].value, '\n'),
+        Env: splitList(textAreas[1].value, '\n'),
+        StdinFile: inputs[7].value,
+        StdinText: textAreas[2].value,
+        Artifacts: splitList(textAreas[3].value, '\n'),
         Arguments: []
       }
     };
-    for (var i = 2, len = inputs.length; i < len; ++i) {
-      res.GoRun.Arguments.push(inputs[i].value);
+    var args = el.getElementsByClassName('gorun-arg');
+    for (var i = 0, len = args.length; i < len; ++i) {
+      res.GoRun.Arguments.push(args[i].getElementsByTagName('input')[0].value);
+    }
+    return res;
+  }
+
+  function splitList(str, sep) {
+    var res = [];
+    var parts = str.split(sep);
+    for (var i = 0, len = parts.length; i < len; ++i) {
+      var part = parts[i].trim();
+      if (part !== '') {
+        res.push(part);
+      }
     }
     return res;
   }
@@ -49,6 +188,25 @@
     return {'Exit': {}};
   }
 
+  function encodeGroup(el) {
+    var inputs = el.getElementsByTagName('input');
+    return {
+      Group: {
+        FailFast: !!inputs[0].checked,
+        Tasks: encodeTaskList(childByClass(el, 'group-tasks'))
+      }
+    };
+  }
+
+  function childByClass(el, className) {
+    for (var i = 0, len = el.children.length; i < len; ++i) {
+      if (el.children[i].className === className) {
+        return el.children[i];
+      }
+    }
+    return null;
+  }
+
   function taskElementID(el) {
     var classes = el.className.split(' ');
     for (var i = 0, len = classes.length; i < len; ++i) {
@@ -61,5 +219,6 @@
 
   window.encodeTasks = encodeTasks;
   window.taskElementID = taskElementID;
+  window.childByClass = childByClass;
 
 })();
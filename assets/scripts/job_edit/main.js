@@ -3,14 +3,18 @@
   function saveJob() {
     var scheduling = document.getElementById('scheduling-prefs');
     scheduling = scheduling.getElementsByTagName('input');
+    var workDir = document.getElementById('workdir-prefs');
     var jobJSON = {
       ID: document.getElementById('job-id').value,
       Name: document.getElementById('job-name').value,
+      Key: document.getElementById('job-key').value,
       Tasks: window.encodeTasks(),
       MaxInstances: parseNumValue(scheduling[0], 'Max instances'),
       Priority: parseNumValue(scheduling[1], 'Priority'),
       NumCPU: parseNumValue(scheduling[2], 'CPUs'),
-      MemUsage: parseNumValue(scheduling[3], 'Memory')
+      MemUsage: parseNumValue(scheduling[3], 'Memory'),
+      KeepDir: workDir.getElementsByTagName('select')[0].value,
+      KeepHours: parseNumValue(workDir.getElementsByTagName('input')[0], 'Keep hours')
     };
 
     if (jobJSON.Priority > 0 && jobJSON.NumCPU === 0 &&
@@ -0,0 +1,25 @@
+(function() {
+
+  function toggleStream(button) {
+    var stream = button.getAttribute('data-stream');
+    var toggleClass = 'hide-stream-' + stream;
+    var classes = document.body.className.split(' ');
+    var idx = classes.indexOf(toggleClass);
+    if (idx >= 0) {
+      classes.splice(idx, 1);
+      button.textContent = 'Hide ' + stream;
+    } else {
+      classes.push(toggleClass);
+      button.textContent = 'Show ' + stream;
+    }
+    document.body.className = classes.join(' ');
+  }
+
+  window.addEventListener('load', function() {
+    var buttons = document.getElementsByClassName('toggle-stream');
+    for (var i = 0, len = buttons.length; i < len; ++i) {
+      buttons[i].addEventListener('click', toggleStream.bind(null, buttons[i]));
+    }
+  });
+
+})();
@@ -81,7 +81,27 @@ func assets_ds_store() (*asset, error) {
 	return a, nil
 }
 
-var _assets_fields_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x52\xb1\x6e\xc2\x30\x14\xdc\xf9\x8a\xa7\xa8\x03\x0c\x04\xd3\x96\x0e\x08\x32\x32\x74\xed\x17\x18\xfc\x28\x56\x6d\x13\x25\x86\x52\x59\xf9\xf7\xda\x8e\x13\x70\x21\x0d\xaa\xba\xbe\x3b\xdf\x9d\xef\x3d\x63\x18\x6e\xb9\x42\x48\xb6\x1c\x05\x7b\xc3\x9c\x16\x54\xef\x8b\xa4\xaa\x06\x00\x0b\xc6\x8f\xb0\x11\xb4\x2c\x97\x35\x3e\x2e\x5b\x42\xb6\x98\x58\x34\x1b\x18\x83\x8a\x59\xf6\xe0\x2c\x25\xb1\x2c\xe9\x3b\xae\xdc\x8b\x6b\xa1\x80\x8e\xbd\x60\x92\x59\xd4\xe2\x82\xae\x51\xc4\x56\x47\x2a\x0e\x98\x64\xc6\xa4\x55\xb5\x98\x78\x82\x23\x77\xdb\x7a\x4a\x87\xa9\xc7\x7a\x2d\xfd\xc8\x59\x72\xc5\xf0\x04\x29\x90\xc8\xba\x27\x67\xf3\x68\x7a\x67\x5e\x46\xf5\x65\x47\xc6\x3c\xf8\x89\xd4\x30\x5f\x42\xf2\x4a\x15\x3c\xc1\x23\x21\x2f\x30\x9d\xcd\xc9\xf3\x9c\xcc\x5a\x9e\xe6\x12\x1d\x69\xd8\x5a\x8e\x02\xa4\x51\xe6\xc2\xaa\x44\x6d\x40\x4e\x79\x71\x26\x93\x11\x0c\xbd\x44\xba\xda\x17\x92\x6a\x68\x7c\x9d\xc8\x8d\x9c\x5c\xe5\x07\xdd\xd1\x6b\x54\x55\x68\xd6\x98\x4f\xae\x77\x57\xe1\xfe\x5c\xfa\xad\x3b\x0c\xa5\x07\xdc\x32\x7c\x48\xf0\xe3\xcb\x54\xd3\x36\x55\xbb\x89\x3a\x62\xfd\xcb\xdf\xd6\xa3\x0e\x72\x8d\x45\xb4\xa0\x73\xbb\x17\x9d\xd4\xed\x06\x7a\x38\x31\x48\x6f\x57\xa9\xf1\xa4\xef\x56\x74\xe4\x1e\xbd\xcd\x0e\x37\x1f\x1d\xab\xf1\xd8\x3f\x9c\x7c\x4f\xf7\xa1\x79\xfd\x95\x63\xf0\x5c\xef\x4f\x89\xfd\x1a\xdf\xc6\x27\x00\x1e\x44\xd6\x94\x1f\xe4\x9b\xa5\xfc\x58\xc4\x77\x00\x00\x00\xff\xff\x10\xa1\x08\xe0\x99\x04\x00\x00")
+var _assets_blobs_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xad\x55\xdb\x8e\x9b\x30\x10\x7d\xcf\x57\x4c\xe7\xa9\x7d\x00\x7e\x80\x44\x6a\x55\x55\xfb\xd2\x6e\xa5\xed\x0f\x0c\xf1\x64\xb1\x64\x0c\xc2\x43\xd4\x14\xe5\xdf\x6b\x9b\x80\x80\x5c\xaa\xd5\xf6\xc9\xd8\x33\xe7\xcc\xed\xd8\xf4\xbd\xe2\x83\xb6\x0c\x58\x98\xba\x70\x78\x3e\x6f\xf2\x0f\xaa\xde\xcb\xa9\x61\x28\xa5\x32\xbb\x4d\x3e\x2c\x00\x79\xc9\xa4\xc2\x07\x40\xdf\x0b\x57\x8d\x21\xf1\xc0\x60\x7e\xf2\x16\x6e\x11\xf0\xcb\xc8\xe2\xdd\xb3\xd1\x3f\x2f\x6a\x75\xba\x06\x5a\x3a\x4e\xb8\x29\x7a\xf4\xca\x95\x3e\xc2\xde\x90\x73\x5b\x34\xda\x09\x0e\xe0\xa5\xa1\x21\xcb\x93\x61\xc9\x5c\xb1\x73\xf4\xca\xdf\x34\x1b\xe5\xc9\x3f\x2b\x05\x04\x21\xc4\x90\xd9\x85\xeb\x50\xb7\x15\xd0\x5e\x74\x6d\xb7\x98\xc5\x0c\x32\x52\x1e\x50\xb1\x94\xb5\xda\xe2\xcf\xe7\x97\x5f\x08\x6c\x63\x37\xb6\x58\x75\x46\x74\x43\xad\x64\x01\x99\x28\x12\x9a\xc5\x5f\x26\x27\xfc\x5b\x92\x43\x8c\x3f\x77\xf1\x4e\x86\x0a\x36\xa3\x5b\xf4\x48\xe2\x11\xee\xbe\x93\x13\x6e\xa1\x21\x29\xf3\x2c\x9e\xad\xa0\x33\xfe\x01\x78\x24\xd3\xf1\x2a\x80\xf7\xd3\xb6\xe9\x04\x2c\x55\x1c\xba\x24\x25\x82\xef\xcb\x9e\xcb\xda\xf8\x66\xfb\x52\xc3\x59\x56\xdb\xac\x8a\x01\xd7\x09\x66\x3e\xcc\xa2\xac\xab\x83\x77\xd6\xf9\xdc\x42\xd7\x98\x9a\xd4\xff\xa8\x72\x18\xcd\x41\x1b\xc6\x4b\xc5\xf1\xfb\x3d\x35\x05\x61\x25\x45\x27\x52\x5b\x87\x10\xc6\x9c\xec\xd9\x4a\x68\x9d\xb4\x57\x99\x2c\xf2\x70\x5d\x51\x69\x41\x88\x29\x6f\x83\xf0\xf0\x41\xd8\x3c\x0a\x69\xd2\xf6\x60\xdc\x8c\x72\x6e\xc9\xbe\x32\xa4\x73\xc5\xde\x57\xff\x52\xff\xb1\xad\x17\xf5\x37\xa4\x5b\xc0\x1f\xbe\x35\x08\x69\x58\x66\x84\xab\x9b\x16\x50\x6f\x1d\xe6\x13\xb9\x3b\x6a\xbd\x81\x89\x6d\x89\x17\x31\x29\x3d\x0e\x77\x7d\x9f\x06\x82\xf3\xf9\x06\xc5\xd5\x8c\x1e\x57\xf8\xa2\xff\xf8\x0a\x3f\x16\x27\x61\x07\x69\xd8\x7d\x5a\x54\x3a\x47\xfb\x99\xf2\x02\xec\x07\xc5\x7e\x93\xc6\x75\xd9\xa0\x5b\xaf\x84\x62\xc3\xc2\xab\x87\xe2\x81\x2c\x4a\xed\x79\xed\x28\xd0\x58\xfa\x28\x91\xa9\x03\x78\xff\x1a\xbc\x4d\x90\xe1\xbd\x8d\xbe\x2b\x4d\x5e\xc8\x86\xdc\x2f\x74\xb8\xfb\x1a\xb7\x79\x36\xec\xff\x79\x6d\xe6\x82\x5d\x39\xf4\x3d\xdb\xb1\x77\x93\xc1\x13\xc7\xb7\xdf\xff\x0c\xe2\x5f\x64\x74\xfa\x0b\x5a\x00\xb3\x17\x78\x06\x00\x00")
+
+func assets_blobs_html_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_blobs_html,
+		"assets/blobs.html",
+	)
+}
+
+func assets_blobs_html() (*asset, error) {
+	bytes, err := assets_blobs_html_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/blobs.html", size: 1656, mode: os.FileMode(420), modTime: time.Unix(1792354838, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assets_fields_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x54\xcb\x6e\xc2\x30\x10\xbc\xf3\x15\x2b\xab\x07\x38\x10\x02\x2a\x3d\x20\x12\xa9\x17\x0e\xbd\xf6\x0b\x4c\xb2\x80\xd5\xbc\xe4\x18\x9a\xca\xca\xbf\xd7\x76\xe2\x40\x80\x90\xa8\xaa\xb8\x45\x9e\xf1\xce\xee\xcc\x3a\x52\x86\xb8\x63\x09\x02\xd9\x31\x8c\xc2\x4f\xcc\x28\xa7\x22\xe5\xa4\x2c\x47\x00\xeb\x90\x9d\x20\x88\x68\x9e\x7b\x15\x3e\xcd\x1b\x82\xbf\x9e\x29\xd4\x1f\x49\x89\x49\xa8\xd8\xea\xc3\x96\x8a\x31\xcf\xe9\x1e\x37\xfa\xc6\x6d\xa1\x1a\x9d\x9a\x82\xc4\x57\xa8\xc2\x23\xba\xc5\xa8\x2d\x75\xa2\xd1\x11\x89\x2f\xa5\x53\x96\xeb\x99\x21\x68\x72\xb7\xac\xa1\x74\x88\x1a\xac\x57\xd2\x1c\x69\x49\x96\x84\x58\x80\x03\x6e\x4b\xba\xa7\x4f\x7b\x69\x3e\xb0\xdf\x8c\xa7\x7b\xae\xcc\xe8\x68\xd9\xc2\xff\xd0\xf5\x4d\x8c\x75\xcf\x06\x55\xb8\x95\x82\x98\x16\x1e\x99\x13\x30\xb8\x47\x5a\x33\xe9\xc4\x2d\xd1\xde\x94\x32\x43\x1e\x60\x22\x60\xdc\x30\x27\x66\x92\x66\xf0\x47\x0e\x84\x54\x5c\x6e\x89\x94\x2f\xe6\x24\x16\xb0\xf2\x80\x7c\xd0\x04\x16\xb0\x70\xdd\x37\x98\x2f\x57\xee\xeb\xca\x5d\x36\x3c\xc1\x62\xd4\xa4\x6b\x59\x29\x05\xc6\x59\xa4\xaa\xb4\xf6\x01\x32\xca\xf8\x99\xec\x4e\x60\x6c\x4a\x38\x9b\x94\xc7\x54\x80\xd5\xd5\x45\xee\xf4\xc9\x92\xec\x28\x3a\x62\x6a\xd9\x5e\x5b\x2a\xe5\x37\x13\x87\x3b\x9e\xfc\x31\xc0\xfe\x08\x15\xc3\x34\xd9\x91\x9c\xad\x62\x23\xd1\x2d\x56\x53\x3e\x8a\x27\x39\xc6\x5b\xe4\xad\x80\xce\xee\x5e\x78\x52\xb9\x5b\xd3\xeb\x75\x05\xe7\xbe\x95\x02\x0b\x31\xb8\xa2\x26\x0f\xa8\xf7\xce\x91\x76\xa4\xa3\x61\xaa\xe0\x27\x3c\x22\x2b\x75\xfd\x27\x68\xce\x87\xbe\x8a\xe0\x80\xc1\x57\xc7\x3c\x06\x7b\xc2\x30\xd5\x32\x89\x9f\x0c\x6b\xcd\x6d\x5a\x10\x95\x16\xdb\xb5\xb7\x1a\x0c\x88\xa1\xdd\xa7\xc7\x43\xfe\x02\xc6\x53\xbe\x7e\x6e\x06\x00\x00")
 
 func assets_fields_html_bytes() ([]byte, error) {
 	return bindata_read(
@@ -96,12 +116,12 @@ func assets_fields_html() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/fields.html", size: 1177, mode: os.FileMode(420), modTime: time.Unix(1475345851, 0)}
+	info := bindata_file_info{name: "assets/fields.html", size: 1646, mode: os.FileMode(420), modTime: time.Unix(1792347726, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _assets_header_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x50\x41\x8e\xa3\x30\x10\xbc\xe7\x15\x2d\x9f\x76\xa5\x00\xbb\xb7\x3d\x60\xce\xab\xbd\xee\x0b\x3a\xa6\x09\xce\x18\xc3\xb8\x1b\x92\xc8\xe2\xef\xe3\x18\x34\x99\x91\xe6\x30\x17\x5b\x5d\x5d\xaa\xae\xaa\x18\x5b\xea\xac\x27\x50\xbd\x0c\xee\x2f\x61\x4b\x41\xad\xeb\x01\xa0\x1e\x48\x10\x4c\x8f\x81\x49\xb4\x9a\xa5\x2b\xfe\xa8\xe6\x7d\xe1\x71\x20\xad\x16\x4b\xd7\x69\x0c\xa2\x12\x0e\x60\x46\x2f\xe4\x13\xf9\x6a\x5b\xe9\x75\x4b\x8b\x35\x54\xe4\xe1\x08\xd6\x5b\xb1\xe8\x0a\x36\xe8\x48\xff\x2e\x7f\x1d\x61\xc0\x9b\x1d\xe6\xe1\x23\x34\x33\x85\x3c\xe3\x29\x41\x7e\x4c\x17\x1f\x27\xc5\x8a\xa3\x26\xc6\x72\x5d\xe1\xc7\x65\x3c\xd1\x30\xd9\x40\x3f\xeb\x6a\x5b\x64\x0e\x9b\x60\x27\x01\x0e\x46\x2b\xe4\x64\x9a\xab\x0d\xe2\x6a\x4a\xae\xf0\x3c\x7a\x2e\x2f\xac\x9a\x7a\xc7\x73\x18\x67\xfd\x0b\x04\x72\x5a\xb1\xdc\x1d\x71\x4f\x24\x0a\xfa\x40\xdd\x53\x25\x2f\xb6\xaf\x34\xcc\x0a\xe4\x3e\xa5\xf0\x42\x37\xa9\x1e\x73\x73\x88\x91\x7c\x9b\x6a\x3b\x3c\xfb\xf4\xb8\x7c\xaa\x33\xcd\x60\x5b\xad\xfa\x0d\x6c\x72\x63\x35\x42\x8c\xb6\x03\x7a\x85\x12\x54\x0a\xc6\x89\x0d\xc6\xa5\xcb\x5a\x99\x39\x14\x13\x9e\x49\xc1\x2e\xbf\xdb\xaa\x32\xaf\xf9\x97\xde\xba\xc2\xaf\x84\xd8\xe1\x42\xdf\x92\xda\x99\xcd\xff\xfc\xef\x72\x75\x95\xcc\x3e\x43\xbd\x05\x00\x00\xff\xff\x2b\xbb\xb4\x87\x24\x02\x00\x00")
+var _assets_header_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8d\x51\xc1\x4e\xc3\x30\x0c\xbd\xef\x2b\xac\x9c\x40\x5a\x5b\xb8\x71\x68\x72\xe0\x84\x10\xb7\x7d\x41\x96\xba\x6b\x20\x4d\x4b\xe2\x76\x9b\xa2\xfe\x3b\x59\x5a\x36\x90\x18\xda\xc5\xb1\x9f\x9f\x9e\xfd\x9c\x10\x2a\xac\xb5\x45\x60\x0d\xb5\xe6\x05\x65\x85\x8e\x4d\xd3\x0a\xa0\x6c\x91\x24\xa8\x46\x3a\x8f\xc4\xd9\x40\x75\xf6\xc4\xc4\xb9\x61\x65\x8b\x9c\x8d\x1a\xf7\x7d\xe7\x88\x45\x1c\x40\x75\x96\xd0\x46\xf2\x5e\x57\xd4\xf0\x0a\x47\xad\x30\x4b\xc5\x1a\xb4\xd5\xa4\xa5\xc9\xbc\x92\x06\xf9\x63\xfe\xb0\x86\x56\x1e\x74\x3b\xb4\x3f\xa1\xc1\xa3\x4b\xb5\xdc\x46\xc8\x76\x71\xe2\x69\x24\x69\x32\x28\x42\xc8\xa7\x09\xee\xde\xbb\x2d\xb6\xbd\x76\x78\x5f\x16\x73\x23\x71\xbc\x72\xba\x27\xf0\x4e\x71\x26\x7d\x5c\xda\x17\x33\xe4\x8b\x3e\x6e\x25\x77\x9d\xf5\xf9\xbb\x67\xa2\x5c\xf0\x64\xc6\x68\xfb\x01\x0e\x0d\x67\x9e\x8e\x06\x7d\x83\x48\x0c\x1a\x87\xf5\x45\x25\x35\xe6\x27\x57\xde\x33\xa0\x63\x1f\xcd\x13\x1e\xa8\x38\xd5\x62\x15\x02\xda\x2a\x9e\x2d\x26\xdf\xf7\xb4\x72\xfc\x75\xce\x58\x83\xae\x38\x6b\x66\x50\xa4\x8b\x95\x12\x42\xd0\x35\xe0\x27\xe4\xc0\xa2\x31\x1f\xd9\xa0\x4c\x9c\xcc\x99\x1a\x5c\xd6\xcb\x1d\x32\x58\xe4\x97\xb5\x8a\xc4\x13\xaf\x31\x96\x85\xfc\x4b\xc8\x1b\x39\xe2\x4d\x52\x0b\x53\x6c\xd2\x7b\x45\xce\x74\xbb\x9b\xc4\x12\x4f\xbc\xc5\x78\x45\x68\x6b\x6e\x74\x38\x13\xc5\xb3\xf9\xc7\x23\x12\x69\x7b\xdb\x62\x67\xae\xd8\x2c\xd9\x22\x5a\x16\xf1\x5b\x2e\xdf\xf7\x05\xff\xd4\x5e\x52\x0e\x03\x00\x00")
 
 func assets_header_html_bytes() ([]byte, error) {
 	return bindata_read(
@@ -116,7 +136,7 @@ func assets_header_html() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/header.html", size: 548, mode: os.FileMode(420), modTime: time.Unix(1475330032, 0)}
+	info := bindata_file_info{name: "assets/header.html", size: 782, mode: os.FileMode(420), modTime: time.Unix(1792348505, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -161,7 +181,7 @@ func assets_images_task_buttons_svg() (*asset, error) {
 	return a, nil
 }
 
-var _assets_job_edit_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x56\xcd\x72\xdb\x36\x10\xbe\xfb\x29\xb6\xb8\x24\x99\x8e\xa4\x17\x90\xd8\x71\xed\xc4\x49\x67\x9c\x6a\x1a\xf9\xdc\x81\x88\x95\x04\x9b\x04\x54\x00\x54\xec\xe1\xf8\xdd\xbb\x00\x7f\x44\x52\x14\xc5\xd8\x27\x62\x81\xfd\xfd\xf6\x8f\x79\x2e\x70\x23\x15\x02\x7b\xd4\xeb\xcf\x42\x3a\xf6\xfa\x7a\x35\xff\x4d\xe8\xd8\xbd\xec\x11\x76\x2e\x4d\xa2\xab\x79\xf1\x01\x98\xef\x90\x0b\x7f\x00\xc8\x73\x87\xe9\x3e\xe1\x8e\x44\xfd\xf3\x57\x7a\x41\xc3\x80\x79\x25\xf0\x97\x5e\x7b\x45\x9e\x71\x6e\x63\x23\xf7\x0e\xac\x89\x17\x8c\x5b\x8b\xce\xce\x8a\x2b\x3b\x23\x9b\xff\x22\xf1\xcf\x52\x2e\xd5\xf4\xd1\xb2\x68\x5e\xbe\x45\xe3\x65\x63\x83\xdc\x69\xf3\x56\x71\x54\xb1\x26\xcf\x4f\xc4\xe7\xb3\x2a\xd8\xf9\x5a\x8b\x97\xd3\xa8\x15\x3f\xd4\x41\x93\x36\x5b\x07\x2c\xd5\x3e\x73\xe0\xf1\x5b\xb0\x9d\x14\x02\x15\x03\x29\x16\x9e\x69\x22\x05\x83\x03\x4f\x32\x7a\xca\xf3\xe9\xb7\xdb\xd7\x57\x56\xfa\x2a\xe4\x01\xe2\x84\xbc\x5c\xb0\x44\x5a\x57\x5e\xb7\x1f\xf6\x5c\x61\xfd\x50\x5b\xaa\x74\x2b\x9e\x22\x03\xf2\x2e\xc6\x9d\x4e\xc8\xb1\x05\xfb\x1e\xae\x8e\x06\x3d\x5d\x9b\xec\xd3\x3e\x59\x67\xce\x69\x65\x1b\x2c\x1e\x80\x70\x59\xf1\x59\x7e\xa8\xf8\x58\xf4\x83\x88\xf9\xac\xa0\x9a\x32\x79\x2e\x37\xa0\xb4\x83\x8f\xf8\x1f\x50\xa0\xc0\xd8\xa7\x12\xa0\x33\x6a\x05\x26\xe8\x6a\xc5\x21\xaa\xf2\x8a\x82\x63\xd1\x6d\x38\xf7\x9b\x42\x25\x1a\xba\xe7\x33\x0a\xaa\x86\xaf\x20\xce\x81\x19\xcc\xd8\x78\x87\x22\x4b\xa4\xda\x4e\xf6\x06\x37\xcd\xe0\x5b\x09\xcf\xd2\x35\x9a\x2f\x12\x13\x4a\xe2\x9e\x4b\x03\xec\x9e\x3f\x83\x54\xd6\x71\x15\xa3\x65\x30\x25\xfa\x5b\x45\x36\x3c\xba\xa0\x65\x69\xa4\x36\xd2\xbd\x90\x82\xea\x38\x5e\xf8\x66\xf9\xe0\x2d\x7f\xcf\x52\x3a\x8d\x17\xbb\xc7\x54\x9b\x17\xf8\x78\x2f\xff\xfc\xe4\x1d\xc7\xf4\xc1\xf2\x2d\xd6\x0a\x7a\x70\xf3\x50\x39\x6e\x9f\xda\xf8\x18\xae\xb6\x08\xd3\x95\x7f\x68\x25\x38\xcf\x7f\x4a\xb7\x83\x47\xab\xd5\x92\x00\x87\x69\x27\xfd\xa1\x42\xa6\x5f\x64\x82\x2b\xd2\x61\x37\x68\x3a\x0c\xed\x18\xbc\xe5\x26\x33\x1b\x94\xa5\x9a\x48\x2c\x82\xb7\x70\xa7\xff\xc9\xd4\x45\xd5\x81\x8b\xf5\x73\x17\xca\x2e\xaa\xf8\xfc\x4c\xe3\x13\xa6\xfe\x73\xaa\xa0\x55\xa1\xa7\x37\x6d\xfa\x0c\xf8\x5c\x88\x89\x37\x34\x29\x4a\xb7\x7f\x28\x34\xbd\x4a\xd1\xfa\xa4\x96\x89\x67\xd7\x42\xc0\x35\xf8\x4c\xb1\x66\xbb\x9c\x1b\x01\x20\xb8\xe3\x93\x18\x95\xf3\xa3\xc4\x99\x0c\x7b\xa7\x42\xe5\xd9\x86\xb2\xe1\xaa\xe4\x44\x77\xe8\x66\xcb\xcc\xf5\xf5\x6b\x57\x70\xab\x0d\x41\x1f\xdd\x69\x20\xe8\xc7\x08\xa0\x07\x3a\xf2\x38\x9f\x72\x0f\x34\xff\x85\x91\x34\x30\x68\xdf\x0e\xd2\xd1\x79\xad\xe2\x44\xc6\x4f\x34\xdd\x75\xcc\x9d\xd4\x6a\xf1\x61\x16\x27\x5a\xe1\x1f\x14\x56\xb9\x0b\x3e\xb0\xe8\xc6\x5f\xf5\x82\xd0\x0c\xac\x43\x56\xd5\xd3\xd7\xad\x93\xaa\x1c\xce\x8d\xb5\x93\xc6\x3a\x33\x44\x8e\x5d\x32\xc0\x10\x7a\xa0\x5b\xc7\xad\x23\xc5\x16\xd6\x29\xed\xd7\xf0\x57\x51\xfb\x7e\xfc\x15\xf1\x8a\x6e\xb4\x72\x46\x27\x61\xab\x36\xc1\x0f\x21\xc5\xd5\x63\xb1\x9e\x5b\x6b\x24\x30\x14\x8b\xa3\x6f\x69\xf4\x71\xa7\xfa\x80\xd9\x9e\x45\xf7\xf4\x85\x87\xfd\x18\x76\xa1\x7f\xaa\x52\xe0\x96\x8e\x47\x91\x32\xce\x33\x51\x75\x71\xee\x46\x06\xbe\xb6\x20\x58\x69\xb7\xd3\xd5\x29\xd4\x4d\x84\x06\xdb\xde\x1b\x85\x76\x76\x8b\xd9\x5b\xe6\xa9\x29\x4a\x9b\x30\x7e\x6a\x2d\x8a\x95\x06\x9b\xd0\x92\xa7\xc9\xb6\xd2\x3f\xfc\xa9\x47\xcc\xe1\xb3\xeb\x2c\x46\x4b\x0d\x41\x84\xdb\x85\xb5\xe8\xa9\x25\x11\x63\x64\x83\x91\x4a\x34\x10\xb5\x64\x6b\x12\x8f\xf5\x7b\xc3\xfb\x65\x86\x9d\x66\xec\x57\x7d\x65\x15\xb6\x45\xea\x87\x2b\xa1\xee\xa4\x81\x12\x28\x07\xe3\x7b\x72\x5f\x0c\xd5\x0b\x49\x3f\x89\xea\xee\xef\xe5\xf5\xea\x6b\xd8\x86\xa3\x93\xa6\x33\x13\x23\x08\x69\x82\x58\x41\xde\x4a\x53\xcb\x96\xff\x09\xd7\x66\x9b\xa5\x34\x2d\x8f\xff\x0a\x83\x6a\x59\xc3\xe1\x6a\x43\x9e\x2d\x82\xa1\x38\x4e\x1d\x1a\x19\x4c\x27\xab\x6f\x59\x05\x83\x3f\xba\xd1\x04\x08\x93\xf6\xc4\xef\x08\xf8\x9d\x57\x71\xff\xde\xe5\x2e\xcb\x6c\xb8\xda\xaa\xb1\x3c\x50\x6c\xc5\x52\x7d\x4f\xad\xd5\x46\xda\xae\xfc\x1f\x00\x00\xff\xff\x89\x70\x40\x58\x63\x0e\x00\x00")
+var _assets_job_edit_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcd\x5a\x59\x8f\x1b\x37\x12\x7e\xf7\xaf\x60\x88\x00\x99\xc1\xae\xa4\x49\x36\xc9\xc3\x42\x52\xa0\x8c\xe6\x70\xec\xb1\x07\xf6\xf8\x6d\x81\x05\xd5\x4d\x49\xf4\xb4\x9a\x4a\x77\x4b\x1e\xad\xe0\xff\x9e\x2a\xb2\x0f\xb2\x0f\x8a\x1a\x79\xb0\x79\x10\x44\x36\xf9\x55\xf1\xf8\xaa\x58\x3c\xf6\xfb\x90\xcf\x45\xcc\x09\xfd\x2c\x67\x57\xa1\xc8\xe8\xd7\xaf\xaf\x86\xdf\x85\x32\xc8\x76\x6b\x4e\x96\xd9\x2a\x1a\xbf\x1a\xea\x3f\x42\x86\x4b\xce\x42\x4c\x10\xb2\xdf\x67\x7c\xb5\x8e\x58\x06\x50\x2c\xbe\x85\x12\x9e\x50\x42\x51\x08\xf9\x43\xce\x50\x10\x56\x1c\xa6\x41\x22\xd6\x19\x49\x93\x60\x44\x59\x9a\xf2\x2c\x1d\xe8\x4f\xe9\x00\x74\xfe\x97\x43\xfd\xc1\x8a\x89\xb8\xff\x39\xa5\xe3\x61\x5e\x36\xf6\xc7\x06\x09\x67\x99\x4c\x9e\x0b\xe7\x71\x20\xa1\xe5\x0d\xf8\x70\x50\x74\x76\x38\x93\xe1\xae\xd9\xeb\x98\x6d\xcb\x4e\x83\xb4\xb4\xec\xb0\x88\xd7\x9b\x8c\xe0\xf8\x8d\xe8\x52\x84\x21\x8f\x29\x11\xe1\x08\x2b\xf5\x44\x48\xc9\x96\x45\x1b\x28\xda\xef\xfb\xaf\xa7\x5f\xbf\xd2\xbc\xad\xa1\xd8\x92\x20\x82\x56\x8e\x68\x24\xd2\x2c\xff\x6c\x17\xac\x59\xcc\xcb\x82\x52\x53\x21\x3b\x66\x2b\x4e\x09\xb4\x2e\xe0\x4b\x19\x41\xc3\x46\xf4\x9d\xfa\x54\x29\xc4\x7c\xa9\xb2\x45\xc4\x23\xdf\xd5\x24\xbc\xe1\x3b\x72\x26\xd7\x99\x90\x31\x8b\xce\x4d\x59\x50\x62\x8b\xaa\x35\xb4\x37\xdb\x64\x99\x8c\x53\xa3\x0a\x8e\xa5\xfa\x58\xd4\x4b\xd9\xb6\xa8\x47\xc7\x1f\x21\x33\x1c\xe8\x9c\x89\xd9\xef\xc5\x9c\xc4\x32\x23\x67\xfc\x4f\x02\x63\x46\x28\x3d\xcf\xc7\xba\x43\x6c\xc8\x23\x9e\x95\x82\x55\xef\xf2\x4f\xd0\x49\x3a\x9e\xaa\x74\xbb\x2a\x1e\x87\x86\xec\xe1\x00\x3a\x55\xce\x84\xce\x74\xcd\x8b\x52\x93\x06\x4b\x1e\x6e\x22\x11\x2f\x7a\xeb\x84\xcf\xcd\xce\x5b\xdc\xd9\xac\x66\x3c\xb9\x16\x3c\x02\x3e\xac\x99\x48\x08\xbd\x63\x4f\x44\xc4\x69\xc6\xe2\x80\xa7\x94\xf4\x21\xff\xba\xc8\x1a\x2d\x3a\x20\xe5\x3e\x11\x32\x11\x19\xcc\x62\xbf\x48\xfa\x83\x2f\xef\x3f\xa1\xe6\x77\x9b\x15\xa4\xfc\x61\x77\x7c\x25\x13\x60\xc9\x9d\xf8\xfd\x1c\x1b\xce\x57\x9f\x52\xb6\xe0\xa5\x00\x9f\x71\xfb\x22\x93\xc7\x50\x24\x8d\x41\x33\x6b\xa7\x30\x6d\x41\xd6\x9b\x2b\xdd\x16\xa9\x22\x36\xe3\x51\x51\x4d\x95\xf7\xd4\x27\x3a\x7e\xc3\xf9\x9a\x80\x60\x00\x42\x1b\x87\x03\xf5\xd9\xc2\x1a\x0a\x34\x52\x31\xdc\x92\x8f\x9e\x44\xe9\xb6\x3f\xc2\x67\x6d\x18\x85\x51\x50\x4d\x55\x64\x29\xea\x9d\xe2\xe0\x80\x57\xd0\x58\x1e\xe6\xe4\x1a\xbf\xe3\x5b\x9e\x0c\x07\x1a\x7b\x40\xe4\x9c\x89\x68\x93\xf0\x36\xc9\x45\x51\x53\xc1\xfb\x98\xe4\x85\x9e\x5a\x58\xf4\x85\xed\xd2\x36\x25\x79\x49\x53\xc7\x44\x15\xb4\xcb\x07\x37\xda\x18\x2e\xcb\x92\x1a\xd9\x03\x0c\x53\xb3\xb8\x94\x9b\x04\xe9\x89\x99\x5b\x4c\xbb\x08\x86\x9c\xca\x58\xfa\x68\x1b\x60\xc2\xe2\x05\x27\xfd\x07\x2c\xb0\x3c\x88\xa9\x1f\x57\x05\xac\x01\xaa\x2c\x1b\x30\x3d\x43\x87\x46\x16\x86\x3d\xd4\xda\xd3\xc4\x6e\xf7\xda\xa6\xae\x15\x4f\xd1\x54\xf2\xce\xd2\x49\x18\x92\x09\x51\xca\x4d\x27\xd4\xe5\x58\x49\xc8\x32\xd6\x0b\x78\x9c\xa1\xa7\xce\x92\x1a\x6d\x0b\xa7\x58\xb4\x6c\x2e\xc0\xed\xc1\x10\xa4\x73\x58\xb3\xc6\x37\x3c\x1b\xdc\x6f\xb2\x36\x2f\x58\x07\xce\x22\x39\xab\x80\xbf\x43\xce\x07\xb5\x49\xa2\x39\xcf\x82\x25\x1d\x7f\xfa\xf0\xd6\x07\xc0\x92\x60\x29\xb6\x9c\x3f\x81\xae\x00\x16\xc0\x2b\x9d\x38\x02\xaa\x42\x01\x18\x84\x89\xce\xfa\x20\x45\x0c\xce\x9a\xe3\xd0\xd0\xf1\x6b\x95\x26\xd7\x90\xf1\x81\x2e\x64\xb2\x81\x65\xeb\x46\x92\x0f\x9b\xd8\x07\xc0\x9f\x84\xea\x96\xf0\xea\xd3\x22\x91\x9b\x35\x1d\xdf\xb3\x84\x45\x11\xb8\xb7\x1b\xcc\x37\x81\x8e\x25\xea\xc0\xc2\xe9\x88\x2c\x9e\x4f\xba\xaa\x1f\x32\x0e\x22\x11\x3c\x42\x38\x23\x03\x86\x4e\x62\xf4\xc3\x20\x88\x64\xcc\x7f\x83\x1e\xe6\xc1\xcf\x0f\x74\x7c\x89\x9f\x5a\xc7\xc3\xe1\x31\x0a\x6b\x6c\x33\xf9\x5e\x61\x5e\x5d\x8b\x2f\x56\xc2\x39\x7e\x28\x28\xad\xbd\x4c\x2c\x22\xfc\x75\xac\x7b\x08\x42\xe6\x1f\x0d\x02\xf2\x5f\x2b\x33\xf0\x05\xe4\xe4\xcd\xd9\x7f\x2c\xec\x52\x9b\x80\x2f\x4a\x53\x1e\x47\xc3\x1b\x72\x23\x81\xee\xde\xb5\x91\xed\xfe\xa2\x15\xe5\xdb\x6b\x1b\xf3\x5f\x26\x81\x36\x2a\x34\x87\x58\x5d\xed\x50\x4a\x5a\xec\xcb\x6d\x4d\xe9\xcd\xe1\xf3\x7e\xff\x45\x64\x4b\xf2\x39\x95\xf1\x3d\x10\x3b\xf7\xef\xca\x4a\xfa\x4a\x75\xae\xcc\xd5\x2c\x5d\xb1\x84\xf2\x28\xe5\x04\xf1\x26\xa1\x3a\xc4\xb4\x70\xce\x82\x35\x85\x9a\x84\xeb\x10\xda\xc2\x49\x0b\xd6\x14\x5a\x10\xb2\x43\x60\x8d\xaf\x65\xf5\xa6\x20\x9b\xa8\x1d\xe2\x5a\xd9\x5c\x83\x76\x8a\xd6\x64\x76\x4b\xb6\x08\x6f\x03\x9b\x72\x2b\xba\x77\x08\x6d\xd8\x83\x01\x69\x8a\x53\xa6\xd0\x45\x1a\xc3\x4c\x74\x45\x0b\xdf\x81\x32\xcc\xa5\x8f\xe9\x0a\xa3\x88\x5d\xff\x37\x88\x8e\xe8\x4b\x19\x67\x89\x8c\xd4\x56\xd4\x74\xe0\xca\x2d\x06\x45\xa1\xde\xd3\x5a\x1b\x26\x55\x41\x6f\x91\xda\xb6\x47\x6d\xb5\x57\x72\xcb\x71\x7d\xba\x83\x7f\xf2\x69\xed\x53\x3d\x94\x5f\xe2\x1c\x30\x85\x64\x05\xc9\x0d\xba\xa3\x57\x96\xdd\xb4\xf4\x8c\xe0\xfa\x44\x94\x16\x3b\xc4\x79\xd5\x1c\x5f\x73\x84\x9c\xa1\x98\x9a\x70\x53\x2b\xd6\x56\xee\x43\xc4\x21\x7f\x22\x7d\x72\xd1\x32\x85\xb0\xfb\x0b\x1e\xad\xd0\xf5\x41\x92\x34\x82\x8d\x2d\x44\x93\x0f\xf2\x23\xa6\xda\x66\x1e\x62\x9e\xda\x66\x30\x85\xe5\x15\x32\xd9\x52\x6d\x05\x31\x77\x0f\x19\x1f\xac\x52\x52\x40\x55\xa6\x44\x76\x52\xcf\xd5\xee\x39\xeb\xa0\xab\xb3\xd1\x94\x1e\xdb\x56\x4a\x2d\xaa\x37\xe7\xee\xbd\xda\x6a\x40\x04\x52\xcc\xc0\x8f\x48\x06\x27\x75\x2c\xef\xe8\xa6\x8e\x1d\xe4\x9e\x42\x1d\x54\xea\xcd\x98\xc6\x90\x20\x9a\x2c\x59\x8a\xb3\x77\x0b\x7f\x2f\x35\xe5\x2e\xc5\x7f\x8f\xc9\x2b\x57\x22\xf7\xc4\x55\xfb\x8c\x93\xec\x5d\xad\x71\xa0\xf2\xf9\x33\x87\x60\xb5\x5e\x9e\x38\x65\x87\x90\xb7\x93\xde\x4f\xbf\xfc\x8a\xb0\xdb\x09\x24\x5a\x30\x1d\xc7\x4b\xa9\xf8\x1f\x27\x67\xb3\x1d\x84\xc6\xe7\xfa\x84\xe9\x23\x7c\xf1\xc3\xa3\xc3\x8e\x24\x0b\x49\x02\x0e\x56\xa8\x13\xaa\x0f\x3a\x75\x24\xcd\xd4\x28\x3d\x9b\x60\xbe\x63\xd3\x5a\xdd\x6b\x58\x2e\x9e\x3b\x1e\xff\xfa\xf6\x16\x50\x0b\x9e\xdc\x76\x50\xdf\x3e\x9f\x62\x0d\x45\x60\x96\x37\xe0\xf9\x36\x51\x08\x28\x23\x33\x1f\xd0\x94\xa7\x99\x88\xd5\x9e\x11\x80\x98\xf3\x41\x5d\xcb\x64\xc5\x20\x78\xea\xeb\xc4\x91\xb4\x2c\x1b\xea\x47\x34\xab\x89\x7e\x90\xa2\x7d\x2f\xe1\x2a\xed\x58\xd8\x8b\x27\xc5\x59\xc9\x29\x34\xc9\xc3\xec\xff\x0f\x4b\xda\xe6\xbb\x09\x99\x40\x13\xed\x13\x72\x96\x41\x94\x82\xc3\x7b\xf6\x59\x0a\x08\xca\x8b\x0f\x84\xfe\x27\xce\xcf\x47\x5e\x8c\x36\x35\x0e\x1c\xd5\xdc\x97\xe0\x8d\xb1\xdd\x71\x93\xc6\x3c\x26\x3b\x85\x31\xc6\x11\xdb\x01\xba\x7c\xbf\x92\x21\x27\xff\x1e\x11\x7a\xf1\xeb\xcf\x3f\x57\xe3\x85\xfb\xae\x3b\x28\x2a\x0f\x06\x8a\xaa\x23\x22\x83\x8c\x45\x56\xa9\x79\x6a\xfb\x42\xcb\x31\x6a\xa3\x44\x35\xc1\x2f\xb0\xce\xcb\x70\x43\x90\x27\x7d\xb9\x80\xa3\xcc\x63\xa4\x7c\x9e\x3a\x92\xac\xc7\xaf\xa7\xba\x73\xb5\x19\xf0\xeb\x9d\x6b\xdb\xd0\xdd\xb3\x97\x20\xb9\xde\x89\xbb\xf9\x9d\x9f\xe5\x9e\x42\x6d\x7d\x0e\xfc\x7c\x27\x78\xf3\xfe\x7e\xf2\x70\x4b\xf1\xb0\xc0\x3b\x14\x94\x9b\x24\xe0\x78\xbf\xa5\x60\x3a\x3b\x15\x89\x0f\xf6\x9e\x05\x8f\xd0\x7a\xbc\x28\xd4\x29\xaf\x3d\xc2\x46\x44\x21\x8c\xd8\xa2\x72\x9f\x0f\x90\x21\xf4\x9f\xe5\xc9\xb2\x13\xff\x76\x4a\xe6\x91\x42\xf7\xdf\x4e\xaf\x31\xe5\xc7\xaa\x44\xac\x0a\xe3\xc4\x74\xc7\xf8\x34\x81\x53\x91\xb2\x19\x6c\xe4\x83\x85\xc4\x48\x42\xe7\x2e\x6f\xde\xfb\xf2\x52\xf7\x97\xc7\xdb\xb2\xbb\xea\xcb\x55\xbc\x35\x56\x0b\x0f\x39\x57\x86\x84\x43\x60\x7b\x86\xb3\x10\x30\xca\xef\x82\x5f\xc2\x4c\xd7\xe1\x55\x9b\x5a\x05\x28\x80\x0f\x50\xc3\x17\x38\x49\x32\x31\x87\x00\xb0\x9a\xe6\xf2\x4b\xad\xed\xf9\x95\xda\x24\x59\x6c\x56\x60\xc1\xa9\xe1\x96\x2b\x1d\xea\x91\x81\xa5\x00\xb5\xea\x9b\x5c\xa2\x4c\x0f\xa2\x92\x05\xe8\xd2\x85\xd0\xe2\xf3\x86\x03\xf7\x77\x73\x85\x21\x79\x6e\x19\x0c\x1b\xf2\x43\x94\x96\xe3\x57\xdd\xb4\x19\x3f\x44\x65\x25\x94\x1e\x6b\x1f\x5d\x6e\xf7\x80\x6d\x1c\xe5\xad\x0d\xab\xf0\x0f\x64\xae\x9c\xd5\x3b\x49\xef\xaf\x20\x27\xbb\x3f\xc0\x20\x79\x63\xd9\x39\xfe\xfa\xcb\xf9\x04\x65\xdc\x83\x20\x79\x61\xdf\x72\xd5\x00\xea\xa2\x35\xaf\xfd\x8f\x7a\xed\xf2\xc6\xe3\x1b\x2c\x87\xea\x88\xd9\xbd\x1a\xea\x8b\xca\xd3\x36\x90\x5a\xc9\xb7\x59\xc0\xd5\xfd\xcb\x81\x05\x5c\xdf\x96\x9e\xd2\x66\xfb\xa6\xf5\x84\x33\xdf\x6b\x26\x22\xb0\xa8\x54\x6d\x4e\x20\x7d\xcd\xd2\xec\xd8\xf3\x57\x43\x46\x65\x9b\xdd\x61\x11\x44\x1e\xaf\xe7\xf6\x98\xda\x24\x56\xe3\xd3\x33\x9f\x44\x74\x74\xcd\xf1\x4e\xe2\xd0\x2b\x09\x3b\xda\x2e\x4d\xa9\xbc\xad\x3b\xde\xa6\xf4\x3b\x12\xbb\x0f\xea\x91\xc5\x6e\x5d\x5d\x3b\xd7\x9f\xcc\xb4\x3f\x73\xb0\x1f\xaa\xd4\x30\x6d\x2f\x1c\x9c\x80\xda\xe3\x06\x67\xdd\xce\x77\x0d\x3e\xa8\xc6\x93\x06\x27\xa8\xf3\x35\x83\x13\x55\x7b\xc8\xe0\xac\x6b\xbe\x61\x70\x0b\x6d\x7f\xbe\x60\x62\xec\x77\x42\x35\x7f\x58\x4d\x76\xe5\x15\x91\x70\x2d\x6e\xd1\xed\x3d\x0a\x87\xd3\x76\x27\x26\xf3\xb2\xba\xdf\x50\x91\xc9\x47\xbe\x86\xc6\x67\x32\xa1\x2e\x93\x2b\x2e\xe8\xbe\xc7\x83\xc0\x9d\xda\xb0\x5a\xae\xa3\x5f\x1a\x84\xce\xe2\xa9\xe9\xce\xdc\xbc\x6a\xdc\xc8\xa8\x68\x84\x3c\x5d\xe6\x8e\x18\xd8\x75\x26\xb9\x30\xf7\x08\xe8\x96\x96\xed\xc4\x9c\x47\x3b\xb1\x9a\xd5\x4e\x85\x73\xb7\xb3\xeb\xb9\x9e\xf6\xd1\x20\x41\xcc\x0b\xd3\xee\x7e\xb3\x87\x57\xa5\x62\x6e\xbd\xd5\x3b\xf4\x4a\xaf\xf1\x3e\xaf\xf3\x65\x5e\xde\x8d\xd6\x77\x79\x52\x35\x53\xbd\x9d\xe3\x61\xf3\x69\xdb\xa1\xa7\x79\x85\xe8\xee\x87\x79\x93\xa3\x14\xd4\x5f\xe5\x15\xf2\x8f\x7c\x93\x57\xb3\xb2\xea\xed\x84\x93\x32\x8a\x60\x6d\x26\xa3\x28\x97\x1b\x4c\x8d\x38\xce\x03\xf3\x0f\xe5\xbd\xc1\x1d\x7b\x32\xaf\x0e\x0e\x04\x83\xaa\x25\x04\xdd\x0e\xc1\x87\xda\xd5\x8e\x04\x7d\xd0\x25\x7e\xe9\xda\x7d\x36\x17\x55\x2d\x2b\x15\x8b\x18\x96\x54\xdc\x17\xe9\x94\x7f\x07\x76\x04\xe2\x3a\xb6\x23\x67\xea\x02\x65\x8a\x69\xf7\xc2\xee\x1a\x88\x8b\xe7\x75\xdf\x73\x4f\x50\xeb\x6b\x57\x80\xef\xd3\xd1\x0b\xcb\xca\x6b\xc4\xf9\x0b\x81\x8f\xa1\x4e\x42\x30\x00\x00")
 
 func assets_job_edit_html_bytes() ([]byte, error) {
 	return bindata_read(
@@ -176,12 +196,52 @@ func assets_job_edit_html() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/job_edit.html", size: 3683, mode: os.FileMode(420), modTime: time.Unix(1478639774, 0)}
+	info := bindata_file_info{name: "assets/job_edit.html", size: 12354, mode: os.FileMode(420), modTime: time.Unix(1792351272, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assets_job_history_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8d\x94\xcd\x92\x94\x30\x10\xc7\xef\xf3\x14\x6d\x1f\xbc\x6c\x01\x0f\x20\x70\x71\xcb\x5a\x3d\xb8\x96\x3b\x2f\x10\x48\xcf\x10\x0d\x09\x26\x81\x72\x8a\x9a\x77\x37\x80\x41\x3e\x56\x74\x0e\x43\x42\xfa\xf7\xef\x4e\x7f\xd0\xf7\x9c\x2e\x42\x11\xe0\x37\x5d\x3c\x09\xeb\xb4\xb9\xe1\xfd\x7e\x4a\xdf\x70\x5d\xba\x5b\x43\x50\xb9\x5a\xe6\xa7\x74\x7a\x00\xa4\x15\x31\x3e\x2c\x00\xfa\xde\x51\xdd\x48\xe6\x3c\x3d\x1c\x3f\xf9\x13\x32\x08\xf8\x49\x17\xb0\xd0\xf2\x50\x12\xa8\xb4\xd0\xfc\xb6\xc7\x15\xeb\x66\xda\x07\x62\x07\x6c\x34\x4a\xb9\xe8\xa0\x94\xcc\xda\x0c\xa5\x97\xc4\x89\x1d\x68\xc3\xd4\x95\x20\x1e\x1d\x4c\xbf\xa5\x71\xc3\x14\xcd\xc6\x13\x20\x2e\x10\x7f\x7c\x5c\xd8\x6f\xa3\xe0\xfe\xff\x83\x20\xc9\x11\x1a\x26\x0c\xe0\x0b\xeb\xc8\x6f\xe2\xb3\xa8\x69\xc5\xf5\x3d\x49\x4b\x07\x52\x35\x59\xcb\xae\x41\x0d\xdf\xb7\xc6\x90\x72\xd0\x91\xb1\x42\x2b\xdc\x8a\x29\xbe\x79\xb3\x08\x4a\x5c\x2e\xbf\x65\xe2\x47\xbf\xde\x18\xbe\x7a\xa9\xf4\xa2\x4d\x0d\xac\x74\xde\x57\x86\xc9\x90\xd1\xc4\x68\x29\x0b\x56\x7e\x47\xa8\xc9\x55\x9a\x67\xf8\xe5\xf9\xe5\xbc\x4a\xd1\x88\x0a\xd5\xb4\x0e\x86\xd2\x67\x58\x09\xce\x49\x21\x28\x56\xfb\x5d\x08\x1e\x3a\x26\x5b\xbf\xef\xfb\xd1\xf3\x5e\x62\x53\x86\xa8\x68\x9d\xd3\xca\x22\xf8\x04\xb3\xa8\xf4\x89\x20\x93\xa1\x33\x2d\xed\xd8\x4d\x00\xb6\x2d\x6a\xe1\x66\x8f\x5f\xfd\x1d\x60\xbc\xc4\xce\x67\xe2\x9d\xae\x5f\xa6\xc9\x90\x85\xfc\x20\xd1\x2b\x68\x57\xd2\x7f\x74\xd3\x5f\x6b\xfd\x59\x83\x1d\xfa\x26\x14\xdb\xc6\x78\xe0\x73\x0e\x68\x3e\x48\x93\x69\x46\xfc\xd0\x8c\x33\x17\x8c\xfc\x22\xcc\xea\x9f\x9e\x18\xe1\xa9\x0b\x82\x8e\x96\x21\xea\xc1\xec\x70\x60\x46\x90\x7e\x40\xfc\xdc\x00\x3e\xac\xbb\x32\x95\x62\xa9\x13\x31\xce\x31\x7f\xf0\x48\x7c\xa6\x9f\xee\x7e\x4f\x13\x29\xf2\xd3\x7a\x20\x60\x21\x17\x1d\xcb\x19\xaa\x75\xe7\x73\x1a\xfd\xbf\x62\x1c\xc7\xc7\x9a\x57\xd6\x60\xee\xad\x5e\x13\xda\x82\xf9\x5b\x55\xd8\xe6\xdd\x81\xf7\x45\xab\xac\xeb\xa4\xc7\x0f\xe1\x4a\xf6\xb8\x1d\xca\x6a\xc8\x7c\xe8\x83\x20\x16\x9e\xbf\x00\x29\xfc\x34\x01\x83\x05\x00\x00")
+
+func assets_job_history_html_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_job_history_html,
+		"assets/job_history.html",
+	)
+}
+
+func assets_job_history_html() (*asset, error) {
+	bytes, err := assets_job_history_html_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/job_history.html", size: 1411, mode: os.FileMode(420), modTime: time.Unix(1792346192, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _assets_jobs_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x53\x4d\x6f\xdb\x30\x0c\xbd\xf7\x57\x70\xc4\x86\x76\x07\xdb\xf7\xc1\xce\xb0\x0f\x0c\xeb\x80\x06\x3d\xac\x3f\x80\xb6\x98\x44\xad\x2c\x19\xb2\x1c\x2c\x30\xf2\xdf\x47\x29\xb1\x9b\x64\x87\x35\xb9\x88\x11\xf9\xde\x23\xe5\xc7\x71\x54\xbc\xd2\x96\x01\x9f\x5d\xdd\xe3\x7e\x7f\x53\xbe\x53\xae\x09\xbb\x8e\x61\x13\x5a\xb3\xb8\x29\x0f\x07\x40\xb9\x61\x52\x31\x00\x18\xc7\xc0\x6d\x67\x28\x08\x2e\xa6\x7f\x4a\x86\x3d\x02\xfe\x3a\x92\x48\x75\x31\x95\x97\xb5\x53\xbb\x7f\x71\x96\xb6\x33\xec\x79\x86\xc5\x1a\xbd\x82\xf7\xf1\x06\x3e\x55\x90\x47\xc6\x63\x46\xa8\x94\xde\x42\x63\xa8\xef\x2b\x5c\x7b\xad\x70\x71\x4c\x44\x98\x27\xbb\xe6\x03\x72\x06\x5c\x82\x3a\xb2\x8c\xa0\x28\x50\xd6\x18\xdd\xbc\x50\x6d\xb8\xc2\xe0\x07\xc6\x13\x44\xfa\x39\x9b\x2a\x2a\x34\xae\xa1\xa0\x9d\xad\x6e\x0b\x56\x3a\x08\xff\x67\xad\xaa\x71\xcc\xef\xbf\xef\xf7\xb7\x27\x2d\x24\x31\x6d\xbb\x21\x40\x7c\xbf\x0a\x37\x5a\x29\xb6\x38\x89\x0b\x32\x93\x9e\x61\x4b\x66\x90\xec\x91\xe1\x82\xe0\xf4\x89\x0c\xd5\x6c\x7e\x68\x36\x02\xea\x48\x7b\xc0\x25\xb5\xd2\x7f\x1e\x8f\xb3\x19\xff\x87\xfb\x4d\xfd\x4b\x8f\x70\x67\xd8\x42\x9e\xfe\x7c\xbc\x0a\xff\x40\x7f\xe0\xde\xf6\x21\x17\x71\x89\x63\x48\xb6\xe1\xfe\x2a\x92\x47\xaf\x9d\xd7\x61\x27\x1c\x53\x78\x15\x7e\x39\xb4\xdf\x1e\x9f\xe2\xf8\x29\xb8\x6e\x00\x6e\x9d\x17\xe5\xbb\xce\x6b\x1b\x56\x80\x1f\x14\x3c\xe8\xaf\x71\x1c\x6e\x9f\x7a\x5a\xf3\xf9\x83\x94\x85\xb8\xe6\xd4\x5d\x6c\xd5\xab\x0d\x5f\x93\x92\x30\x3d\x9f\x1b\x54\xdc\x81\xd6\x65\xc9\xd4\xd3\xb7\x97\xd6\xc2\x2e\x4b\xf6\x5b\x2c\x1d\x44\x57\x9f\xb3\xcc\xf4\x25\xbd\xc1\xac\xb0\xf1\xbc\xaa\xb0\x20\xa5\x44\x66\xf6\x6e\x54\x96\xab\x28\x9d\xd5\x43\x08\xce\xe2\xe2\x8b\x52\x51\xae\x2c\x28\x2d\x63\x71\xd8\x46\x59\xcf\xb4\xd6\x93\xf2\xdf\x00\x00\x00\xff\xff\xff\x3b\x35\x32\x08\x04\x00\x00")
+var _assets_job_import_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x56\x4b\x73\xd3\x30\x10\xbe\xf3\x2b\x16\x5d\xda\x1e\x12\xff\x01\x27\x4c\xa6\xd0\x81\x02\x6d\x67\xca\x85\xa3\x62\x6d\x6a\x15\x59\x32\x92\x9c\x36\xe3\xc9\x7f\x47\x0f\x3b\xd8\x8e\x49\x1f\xa1\x39\xc4\xb2\xb5\xdf\x7e\xbb\xab\x7d\xa8\xae\x19\xae\xb8\x44\x20\xf7\x6a\xf9\xa5\x28\x95\xb6\x64\xbb\x7d\x97\xbe\x67\x2a\xb3\x9b\x12\x21\xb7\x85\x98\xbf\x4b\xe3\x03\x20\xcd\x91\x32\xbf\x00\xa8\x6b\x8b\x45\x29\xa8\x75\x60\xbf\xfd\xd9\xed\xa0\x26\x40\xa2\x1a\xb8\x54\x4b\xe3\x75\x39\x50\xd2\xa2\xd2\xa5\x62\x9b\x7d\xb8\xa4\xeb\x1d\xfa\xbe\x81\x05\xa1\x94\xf1\x35\x64\x82\x1a\x33\x23\x82\x1b\x4b\x22\xb6\xbf\x51\x52\x89\xbb\x8d\xbe\xe2\x02\x8d\xa1\x77\x78\xc1\x51\x30\xa7\xfb\xd3\x63\xb0\xcc\xe6\x08\x8e\x06\x4a\xa5\x44\xb4\x70\x5c\xe9\x64\x59\x59\xab\xa4\x21\xc0\xa8\xa5\x93\x0c\xa5\x45\x3d\x23\x56\x57\x5d\x3e\xef\x55\x90\x03\x25\x33\xc1\xb3\x5f\xce\x54\x95\x51\xcb\x95\x9c\x9d\x24\xde\x9d\x04\x03\xef\x87\x95\xd2\x05\xb5\xb3\x7b\xa3\xe4\x09\x99\x5f\xde\x5e\x5f\xa5\x49\x84\x1e\xa1\x6d\x43\x0b\xe1\xb4\xfd\x5c\x7c\xff\xb6\xaf\x2d\x4d\x9c\x4b\xbb\x98\xc5\x97\xa3\x22\xd8\x9c\xad\xd2\x40\xcb\x52\x6c\x80\x86\x40\xae\xb8\x40\x38\xf5\x0e\xf9\x1d\x6f\xca\x59\x2f\xae\xde\x54\x28\xd0\xe6\x8a\xcd\xc8\xcd\xf5\xed\x0f\x02\x28\x43\x7e\xcd\x48\x51\x09\xcb\x4b\xaa\x6d\xe2\xa5\x26\x3e\xd2\xfd\xe0\x5a\x7c\xb4\x54\x23\x05\x49\x0b\x27\x1f\xf2\xa3\x35\xdc\xbd\x4c\x3c\x39\xe9\x00\xfe\xfe\x9c\x0b\x19\xe6\x4a\x30\x7f\x6a\x37\xd4\xd8\x70\xec\x06\x72\xd4\xe8\x0d\xcd\x72\xa5\x0c\x3a\x1f\x82\xfd\x4b\x14\xea\x81\xcc\xd3\xa4\x25\xec\x59\xd1\x89\x96\xdf\x77\xac\x3e\x20\xf3\x1e\x6f\x2a\xa8\x53\xd2\x8a\x05\x89\x49\xf8\x44\xe6\x17\x8e\x21\x4d\xc2\xcb\x00\xd3\x51\x1c\x11\x6b\x2a\x06\xf9\x15\xe4\xb8\x2c\x2b\x97\xba\x21\x66\xc1\xe5\x26\x1e\x61\x3d\xd0\xd9\x3d\xf5\xf1\x0f\x1d\x56\x83\x02\xb3\x97\x3b\x14\xb2\xef\x38\x97\x22\x73\xeb\x47\x50\xb8\x27\xe4\xc4\x54\xe9\xb3\x1f\x82\x92\x19\x21\xf3\x45\x65\x55\x9a\xc4\xaf\x4f\x8a\xfb\x62\x6b\x6b\xed\x99\x10\x5f\x51\x6d\x41\x8d\x43\xd2\x24\x9a\x7e\x4c\xd8\x5f\xd2\x60\x06\xc7\x6f\xaa\x65\xc1\x2d\x69\xed\x6d\xda\x36\x84\x08\x66\xa1\x53\x90\xd8\x29\x78\xdc\x79\x86\xaa\x78\x06\x4c\x6f\x74\x25\x77\x8a\x6f\x34\xae\x39\x3e\xc4\x52\x1f\x2d\xb1\x7d\xca\x28\x7b\x20\x0a\x69\xa8\xf3\x41\x4f\xea\x2d\x5d\x1b\x0b\x53\xc2\x8d\x8d\x30\x75\xea\x1a\x25\xf3\x13\xa1\xee\x0e\xab\x45\x20\x3a\x76\x56\x05\x2d\x6f\x3b\xaa\xea\x5a\x53\x79\x87\x30\x3d\xcf\xfd\xd3\x1c\x18\x38\xbd\xb8\x75\x59\x43\x95\x35\x3d\xb8\xa4\x5c\x03\xf9\x8a\x1b\x02\x53\xf7\xdf\x51\xf7\x14\xe6\xca\x1d\xb2\x03\xf9\xc7\x0b\x50\x8b\x70\xbe\x0e\x17\x17\x03\x24\x5f\x81\x54\x16\x4e\xf1\x77\x2b\x00\xa4\x92\x59\x70\x95\x91\xb3\x9e\x74\x9f\x89\xf1\xd5\xaa\x21\x9a\x7e\x74\xeb\x81\xe2\x78\xe6\xa3\x49\xe4\x36\x85\xc1\xd7\xc4\x71\x30\xcd\xae\x14\x44\x4b\xcd\x94\x1c\x20\x8b\xd9\xf7\x24\x55\x1c\x73\x63\xe5\xf0\xea\xd9\xd7\x2d\xd5\x9c\x33\x86\x92\xf4\xc6\x60\x53\xa8\x75\x3d\xf5\x29\xbc\xdd\x3e\x17\xdd\x34\xdb\x7e\x93\xfc\x3f\x8d\xaa\xb9\xbd\x44\xd6\xf8\x42\xfe\x7d\x97\x89\x1d\xca\xdd\x5f\xce\xa9\xcc\x50\x8c\xdd\x87\x0e\x37\xbf\xc5\xdb\xf5\x9b\x3f\x43\xe6\x0a\xa4\x1c\x0b\x00\x00")
+
+func assets_job_import_html_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_job_import_html,
+		"assets/job_import.html",
+	)
+}
+
+func assets_job_import_html() (*asset, error) {
+	bytes, err := assets_job_import_html_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/job_import.html", size: 2844, mode: os.FileMode(420), modTime: time.Unix(1792351208, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assets_jobs_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9d\x55\xdb\x6e\xdb\x30\x0c\x7d\xef\x57\x70\x02\x86\x76\x0f\x89\x7f\xc0\xce\xd0\xee\x82\x66\x43\xb3\x60\x4b\x3f\x40\xb1\x98\x58\xad\x2c\x19\xb2\x12\x2c\x30\xf2\xef\xa3\x64\x3b\xf5\x25\xeb\x9a\xbd\xc4\x8c\x45\x1e\x1e\x92\xe2\x71\x55\x09\xdc\x48\x8d\xc0\x9e\xcc\xba\x64\xc7\xe3\x55\xfc\x4e\x98\xd4\x1d\x0a\x84\xcc\xe5\x6a\x76\x15\xd7\x0f\x80\x38\x43\x2e\xbc\x01\x50\x55\x0e\xf3\x42\x71\x47\x71\xfe\xf8\x9e\x4e\xd0\x32\x60\xdf\x1a\x10\xf2\x8e\x5a\xf7\x78\x6d\xc4\x61\x1c\xa7\xf9\xfe\x14\xf6\x74\x0a\xf3\x3e\x72\x03\xc6\xc2\xd4\x63\xc1\xf4\x27\x2a\xc3\xc5\x17\x6b\x8d\x6d\x1c\x08\x51\xc8\x3d\xa4\x8a\x97\x65\xc2\xb6\x56\x0a\x36\x6b\x0e\xc6\x47\x93\x2c\xe4\x00\x25\x4b\xd7\x71\x6b\xd2\x9c\x45\x1f\x03\x15\x5c\x63\x2f\x78\x58\x8b\xa0\xdf\xaf\x12\x95\x60\x50\x70\x69\x81\xd5\xb8\xb0\xe1\x52\x21\xbd\x6c\xf2\xac\x64\x8e\x83\x34\x7d\x1c\xc5\xd7\xa8\x7a\x40\x81\x1a\x7b\x95\x68\x44\x4c\xfb\x95\xa1\x16\x3d\xa7\x6e\x0a\x6a\xf5\xd2\x18\x75\xb7\x73\xce\xe8\x53\xd3\xcf\x00\x55\x95\xe5\x7a\x8b\xf5\x1c\x7a\x70\xa3\xd6\x00\xd5\xcf\x27\xa9\x92\xe9\x33\x5f\x2b\x4c\x98\xb3\x3b\x64\x83\x3a\xc1\xe8\xe0\x91\x30\x65\x52\xee\xa4\xd1\xc9\x75\x84\x42\x3a\x62\xf4\x51\x8a\xa4\xaa\xa6\xf3\xcf\xc7\xe3\xf5\xa0\xd1\xb1\xd4\xc5\xce\x81\xbf\x90\x09\xcb\xa4\x10\xa8\x59\x9b\x9c\x22\x27\x34\x7d\xd8\x73\xb5\xa3\xd3\x06\x61\x00\xf0\x7a\x7f\x17\x3c\x27\xfe\x53\xff\x18\xf4\xb5\xbe\x20\xdf\xf1\x70\xe1\xc4\x28\x82\x9d\x8b\x1b\x8f\xe5\x5f\x48\x2b\x5e\x3e\x97\x0c\x6e\x14\x6a\x98\x86\x3f\x1f\x2e\x8a\x7f\xe0\xbf\x61\xae\x4b\x37\x25\x3e\x64\x7b\x93\xeb\x14\xcb\x8b\x40\x96\x56\x1a\x2b\x9d\xaf\xa9\x35\x2f\x8a\x5f\xec\xf2\x4f\xcb\x47\xdf\xe2\x60\x5c\x56\x00\xe6\xc6\x52\xe6\x9b\xc2\x4a\xed\x36\xc0\xde\x0b\x78\x90\x77\xbe\x1c\xcc\x1f\x4b\xbe\xc5\x7e\x43\x46\x37\xb8\xdb\xf1\xce\x21\x1d\xa8\x12\xfb\x72\x42\x37\x90\x69\x33\x09\x4a\xd4\xde\x2f\xa2\xe6\x0e\x93\x7a\xfb\x17\x06\xfc\x22\xf4\x52\x74\x17\x61\x20\x30\x6f\x59\xb9\x3e\xa3\x13\xd5\x98\xbf\x61\xb9\x20\xb3\xb8\x49\x58\xc4\x85\x20\xf8\xd3\xae\xf9\x2a\xe8\x95\x2f\x63\xb2\x0e\xf9\xd8\xec\x56\x08\x4f\x3d\x8e\x78\x50\xe3\xa8\x96\x63\xd2\xe7\xa0\xeb\x6d\x66\x32\x3a\x5f\x82\x11\xdf\xbf\xc8\x61\xbc\x31\x36\x07\x9e\x86\x75\x66\x91\xef\x5e\x64\x83\x54\x31\xc8\xd1\x65\x86\xf8\x2c\x7f\xfc\x5a\xb1\x73\x2d\xf3\x38\x0d\xcb\xb2\x2d\x13\xb5\x43\xdb\xd4\xd8\x51\xf5\xda\xab\x51\x81\xa6\xb0\xb3\x82\x12\x18\x64\x34\x0b\xba\x38\x24\x25\xf7\xb5\x45\x45\x87\x98\xff\x46\x94\x79\x61\xac\x23\xc0\x79\x30\xce\xe0\x75\x65\xaa\xdc\xad\x73\xe9\x4e\xba\x54\x6b\xf7\x4b\x0b\x5e\xc6\x1e\x47\xbe\x7f\xf5\x58\xc2\xcb\x76\x1a\x7f\x00\x05\x3c\x39\x7d\x97\x07\x00\x00")
 
 func assets_jobs_html_bytes() ([]byte, error) {
 	return bindata_read(
@@ -196,12 +256,12 @@ func assets_jobs_html() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/jobs.html", size: 1032, mode: os.FileMode(420), modTime: time.Unix(1475953716, 0)}
+	info := bindata_file_info{name: "assets/jobs.html", size: 1943, mode: os.FileMode(420), modTime: time.Unix(1792351160, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _assets_live_job_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x54\x4d\x73\xd3\x30\x10\xbd\xf7\x57\x2c\x9a\xd2\xc2\x0c\x49\x38\x83\x5d\x0e\x7c\x0c\x85\x0e\x87\xb6\x7f\x40\xb6\x36\xad\xa8\x22\x79\xac\x4d\xda\x8e\xc7\xff\x9d\x95\x6c\xab\x16\x66\x32\xe4\x62\x69\x57\xef\xe9\xed\x5b\x6d\xba\x4e\xe1\x56\x5b\x04\x61\xf4\x01\x7f\xb8\x4a\xf4\xfd\x49\xf1\x4a\xb9\x9a\x9e\x1b\x84\x7b\xda\x99\x8b\x93\x62\xf8\x00\x14\xf7\x28\x55\x58\x00\x74\x1d\xe1\xae\x31\x92\x18\x1a\xd2\xdf\x39\x83\xad\x00\x71\xc5\x3c\x30\x12\x31\x62\x33\x41\x8a\xca\xa9\xe7\x25\xd6\xca\x43\x82\x7a\x23\x0f\xe8\x03\x30\x1e\x2b\x94\x3e\x40\x6d\xa4\xf7\x25\xab\xf3\x24\x06\x74\x9e\x68\xa4\xc5\x94\xc8\xa9\xc7\x8a\xbe\x69\x34\xca\x0b\x58\x5f\x0d\xfb\xa8\x6b\x3a\xad\xb7\x29\xbe\xbe\xde\x5b\xab\xed\xdd\x2c\xbf\xbc\x6a\x55\xed\x89\x9c\x65\x3a\x25\x49\xae\x6a\xb4\x84\x6d\x29\xa8\xdd\xcf\x55\x44\xe4\x70\x12\x9c\xad\x8d\xae\x1f\xb8\x04\x57\x4b\xd2\xce\x96\xe7\x1b\x4f\xae\xf9\xed\xaa\x4f\xb1\xe0\xb2\xeb\xd6\x37\x61\x71\xf9\xa5\xef\xcf\xe4\xae\xf9\xa8\xd5\x53\x08\xb2\xa6\x4b\xab\xf0\xa9\xef\xcf\x45\x46\x3d\xfd\x46\x5d\x0a\x0d\xd2\xa4\x4c\x5c\xfc\xd4\xc6\x14\x9b\x61\x37\x97\x54\x6c\xb8\x96\xb9\x53\x68\x55\xaa\x35\x4b\x76\xdd\x29\x49\xff\x70\xed\x1c\xc1\x87\x12\x9a\x56\x5b\xda\x82\xd8\x84\xe0\xa8\xf9\xb5\x3f\xe3\x02\xc2\x27\x04\x4b\x76\x77\x2c\x01\x66\xb2\x13\x5d\x2b\xed\x1d\xc2\xa9\x7e\x07\x91\x38\x90\x26\xd7\x6f\x39\xe0\xe1\x7d\x1e\xf8\xec\xf6\x96\x66\x8d\x58\x74\x7c\xb4\x3f\x38\x2b\x2b\x83\x63\x07\x72\x97\x92\xf3\x8f\xda\x2a\xf7\xb8\x7e\x69\xc0\xbc\xc0\x55\xdf\xb3\xc2\x15\xab\x0b\x3e\xcf\x0d\xfb\xfb\x2d\x05\x61\xd3\x63\x8a\xf8\xb9\xc0\xdc\xbf\x17\x6b\x53\x82\x5b\x12\x07\x80\x27\x22\x4e\xd3\x74\xe8\x64\x31\x82\xe3\x1d\x91\x20\xd3\x20\x2b\x34\x31\x29\xa0\x91\xba\x05\xc1\x87\xc1\xca\x1d\xdb\x11\x5c\x5f\xff\xe2\xe5\x02\xc5\x46\x61\x06\xba\x21\xd9\x12\x90\x8e\xb0\xb8\xb9\xd5\x09\x17\xe6\x21\x9f\x83\xe3\x0a\x18\x4f\x7b\xf6\x43\x8c\xa0\x49\x35\x1a\x8f\xff\x20\x58\x88\xf9\x6a\xd5\x24\x85\x97\x49\xc8\x7f\x5f\x7b\xc3\x93\xd4\xa0\x4a\xd7\x8e\xbe\x1f\x47\xb3\x56\x08\xfd\x0b\x7f\x0a\xf9\x6b\x3b\x8e\xbb\x75\x24\xcd\x84\x7c\x63\xd0\x0e\xb6\xc7\x17\xfc\x96\xf1\xd3\xfd\x7f\x02\x00\x00\xff\xff\x1c\x47\xc4\x64\x55\x05\x00\x00")
+var _assets_live_job_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x56\xcd\x72\xdb\x38\x0c\xbe\xe7\x29\x58\x4e\xdb\xb4\x07\xcb\xdb\x6b\x57\x4e\xa7\xdd\x24\xdd\xb4\x9d\x9d\x4c\x93\x17\xa0\x25\xd8\xe6\x86\x26\x35\x24\x6d\x37\xd5\xe8\xdd\x0b\xfe\x48\xa2\xac\x28\xf5\x5e\xd6\x17\x91\x00\x3e\x00\x04\x3e\x82\xae\xeb\x12\x56\x5c\x02\xa1\x82\xef\xe1\x8b\x5a\xd2\xa6\x39\xcb\x5f\x94\xaa\xb0\x8f\x15\x90\x8d\xdd\x8a\x8b\xb3\x3c\x7c\x08\xc9\x37\xc0\x4a\xb7\x20\xa4\xae\x2d\x6c\x2b\xc1\x2c\x42\x9d\xfa\x6f\xd4\x80\xa6\x84\x7e\x43\x3f\x24\x3a\x42\xc4\xbc\x85\xe4\x4b\x55\x3e\x8e\xb1\x92\xed\x3b\xa8\x11\x6c\x0f\xc6\x01\xbd\x59\x5e\xf2\x3d\x29\x04\x33\x66\x81\xd9\x19\x4b\x03\x7a\xa8\xa8\x98\x84\x4e\x31\x74\x1d\x4f\x74\xcd\x41\x94\x86\x92\xec\x5b\xd8\xfb\xbc\x5a\x6b\xbe\xea\xe4\xd9\xf7\x9d\x94\x5c\xae\x13\xfd\x38\xd4\x6c\xb9\xb3\x56\x49\x74\x57\x32\xcb\x66\x05\x48\x0b\x7a\x41\xad\xde\xa5\x59\x78\x64\xb0\x24\x4a\x16\x82\x17\x0f\x78\x04\x55\x30\xcb\x95\x5c\x9c\xcf\x8d\x55\xd5\xbf\x6a\xf9\xc1\x1f\x78\x51\xd7\xd9\x9d\x5b\xdc\x5c\x36\xcd\x6b\xb6\xad\xfe\xe4\xe5\x0f\x27\xc4\x9c\x6e\x64\x09\x3f\x9a\xe6\x9c\x0e\x5c\xb7\xbf\x98\x57\x09\x02\x6c\x9b\x19\xbd\xf8\xca\x85\xc8\xe7\x61\x97\xa6\x94\xcf\xf1\x2c\x69\xa5\x40\x96\xa3\x5a\x7c\x85\xca\x5e\x72\xfd\x3f\xd4\xe0\xa0\xf4\x43\xc9\xf5\x54\x0d\xf4\x4e\x3a\x61\xd2\x1b\xa7\x3a\x3f\x0a\x40\xc8\x27\xad\x0e\x06\x08\x7a\x82\xc2\x2a\xfd\x38\x8c\xff\x5f\xcb\x30\x50\x86\x8a\x7c\xd4\x96\xaf\x58\x61\x4d\x52\x93\x67\x08\x38\xa4\xe0\x16\x8c\x61\x6b\xf0\x14\x44\x82\x77\xbe\xe8\xa0\xc0\x75\xfd\x52\xbb\xf3\x91\xf7\x0b\x72\x7c\xe2\x81\x99\x66\x72\x0d\x4f\xa7\x34\xba\x30\x6c\x09\x62\xb6\x72\x81\x09\x8b\xf6\x61\x3b\x2a\x61\xee\x6d\x5b\xa0\xb7\x99\x79\x11\xbd\xa8\xeb\xe5\xa3\x05\x43\xb2\x3b\xfe\x13\x9a\x26\x9f\x7b\xf9\xc8\x41\x12\x37\xc0\xf7\x4c\x8c\xc8\xe0\x2d\x19\xd9\x68\x58\x2d\xe8\xbc\xcd\xe9\x43\x68\x74\x28\x40\xec\x7d\xc5\xec\xc6\x35\xff\x16\xbf\x4d\xe3\xb2\x88\xcb\x7c\xce\x46\xb1\x87\xed\x7c\x52\x74\x4c\xf5\xa3\x2e\xa7\x4a\xcc\xc4\x32\xf3\xf0\x5d\x29\xeb\xba\x51\x69\x2e\xed\x8a\xd0\xb9\x13\x46\xaa\xbe\x32\xaf\xf1\xee\xba\x8f\x13\x2e\x70\xb0\x44\xe6\x92\xe4\xc6\x9e\x3d\x3d\x8e\xee\x11\x71\x25\xad\xe6\x38\xe6\x48\xc5\xb8\x26\x7d\xb8\xae\xf3\xce\xe8\x5e\x03\x44\x2f\x5d\xb6\xc8\x66\x3f\x42\x71\xa6\xfa\x79\xdc\x66\x8e\x8b\x74\x88\xa7\x31\xbc\x8b\xa3\x33\x71\x97\x21\xc9\xc8\x1f\x51\x19\x48\xd5\x4a\xdf\xc5\xa8\x81\xfc\x9f\xb5\xda\x55\xfd\xed\x38\xa2\x3d\x71\xe1\x66\x6b\x67\x33\x31\x82\x8f\xf8\x7f\xcb\x34\x13\x02\xb9\xe6\xfd\xd2\xc1\xf4\x49\x0a\xe5\x38\x16\x21\xbe\x46\xf4\x9a\x71\x41\x56\x0c\x9f\x80\x98\x52\xe6\x24\xd7\x28\x98\xb8\x94\x7d\x62\x33\x77\x74\x33\x79\x41\x7f\xdb\x94\xa8\x98\x62\x4f\xb2\xc1\x76\x08\x03\x93\xc5\x6a\xa7\xa5\x1b\x84\x6c\x29\x20\x0e\xcc\x94\xb9\xdd\x98\x3c\x60\x37\xd4\x21\xeb\xa7\x65\xda\xc2\x59\xd3\x60\xac\x19\xc9\xba\xc7\x61\xfa\xf9\x73\x47\xeb\xde\x3f\xb7\x19\x94\xfc\xc0\xed\x26\x88\xb3\x5b\xad\xd6\x1a\x9b\x75\x34\x70\x7a\x6f\x2e\x7c\x6b\xd4\x79\x1c\x78\x9b\x98\xa3\xbd\xa2\x5d\x4d\xf0\xb6\x7f\xaa\xa3\xf5\x73\x8c\x40\x63\x22\xd9\x16\xab\xea\x2e\x5d\xf6\x0f\x2e\x4f\x40\xdd\x48\x63\x99\x2c\x1c\xaa\x5d\x9e\x80\xc2\x49\x4c\x6e\x2e\x11\xd3\x8f\xe4\x14\x80\x6d\x85\x81\xfd\x9d\xc5\xe1\x46\x2c\xf7\xd9\xf9\xcd\x3d\xef\xd2\x73\xb7\x6a\xf8\x47\xe3\xf9\xe0\x88\xb7\x3b\xac\x35\x8d\xa0\xb6\x38\x09\xd7\x9e\x4d\xe6\x4a\x96\x6d\x2a\xb8\xec\x12\x39\x39\xec\x1d\xfe\x55\xa9\xa0\xa4\x1d\x2a\x90\xe6\x4a\x6b\xa5\x9f\x1e\x73\x23\x5f\xde\xb6\x67\xcb\x98\x10\xa7\x34\x20\x5c\xe3\xc0\xd6\xbf\xd4\x4e\xda\x13\x70\xf7\xca\x32\xd1\x22\xdf\x08\x90\x81\x2c\xce\x85\x79\x9b\x10\xf1\x17\xd8\xad\xd2\x4c\x05\x0b\x00\x00")
 
 func assets_live_job_html_bytes() ([]byte, error) {
 	return bindata_read(
@@ -216,12 +276,12 @@ func assets_live_job_html() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/live_job.html", size: 1365, mode: os.FileMode(420), modTime: time.Unix(1475346157, 0)}
+	info := bindata_file_info{name: "assets/live_job.html", size: 2821, mode: os.FileMode(420), modTime: time.Unix(1792351624, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _assets_live_task_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x55\xd1\x72\xdb\x2a\x10\x7d\xcf\x57\xec\xe5\x3d\xf6\xfd\x00\x25\x33\x99\xd6\x49\x1e\x92\x36\x13\xfb\x07\x88\xb5\xb6\x69\x10\x68\x00\xbb\x4d\x35\xf9\xf7\x2e\x08\x64\x21\xd9\xb1\x5e\x2c\xd0\xee\x39\x7b\x96\x3d\x58\x4d\x53\xe2\x46\x28\x04\x26\xc5\x01\x57\xdc\xbe\xb3\xcf\xcf\xab\xe2\xbf\x52\xaf\xdd\x47\x8d\xb0\x73\x95\xbc\xbd\x2a\xda\x07\x40\xb1\x43\x5e\xfa\x05\x40\xd3\x38\xac\x6a\xc9\x1d\x61\x7d\xf8\x91\x22\x68\x18\xb0\x27\x22\x82\xc4\x44\x90\x79\xc2\x14\x6f\xba\xfc\x18\x83\x15\x3f\x74\x58\x2b\xf9\x01\xad\x07\x86\xb4\xa2\x14\x07\x58\x4b\x6e\xed\x0d\xe9\xb3\x8e\xb5\xe8\x3c\x50\x73\x85\x5d\x20\xa7\x4e\x3d\xdd\x0b\x94\xa5\x65\x30\x0b\x8a\x02\xc1\x9c\x18\x12\xa8\x69\xc4\x06\x66\x4f\x7a\xbb\x14\x7f\xb1\x4b\xf9\xb2\x0a\x05\xb5\x04\x51\xde\xb0\x37\xbe\x7e\x97\x7a\x9b\xc5\x3c\xa5\xe1\x6a\x8b\x81\x75\xa1\x9c\x11\x68\xe1\xff\x53\x35\x22\x99\x14\xa9\x50\xa6\xbf\x05\x7f\x7c\xf3\xa1\x20\x9f\xdd\x36\xcd\xec\x19\xad\xe5\x5b\x62\x29\xe6\x52\x0c\xeb\xa2\x2a\x33\xfa\x62\xae\xe5\x31\x67\xd0\x37\x4a\x3b\xb9\xe1\xbe\xb0\xaa\x55\x10\xce\x95\xc6\xf6\x43\x03\x49\x05\xbd\x77\xf5\xde\xcd\x58\x9f\x71\x50\xaf\x13\xd7\x05\x8a\x79\x6b\x0b\xf2\x49\x30\x59\x4a\xba\xea\x59\x33\x3b\x86\x40\xd0\x34\xd7\xe0\x87\x76\x6f\x74\xf5\xcc\xad\x43\x03\xd7\x91\x79\x43\xaf\xae\xab\xf0\x2e\x26\xfa\x2e\xf3\x70\xf0\x59\x8a\xaa\x32\x04\x4f\x15\xce\xfd\x13\x2b\xff\x16\x6e\x07\xbf\xac\x56\x2f\x24\x07\x66\x3e\x21\x72\xb7\x46\xba\x17\x12\x57\x34\x7e\xbb\x41\xd3\x1d\xc5\xd0\x96\xfd\x24\x76\x12\xd3\x4e\x27\x74\xf9\xa0\x5f\xf7\xea\x2c\x55\x88\xb2\x3c\x6b\x30\xda\x21\x64\xf1\x47\x38\x42\xf8\xc7\x11\x10\x67\xd3\x5f\x1d\x51\x1b\x7f\x04\x4b\xac\xb9\xe1\x4e\x1b\x36\x8a\x97\xf4\x1b\xed\x50\x73\x61\x80\x2d\x1d\x37\x0e\x56\xa2\x42\x2a\x14\x36\x7e\x1d\x71\xd4\x94\xd2\x0e\x66\x24\x58\x09\xb5\xed\x34\x7c\xc1\xb7\xa0\x31\x45\x36\x5a\x76\x5c\x83\xde\xf8\x1b\xca\xa1\x0c\xb7\xb7\xc1\xa3\x0e\x4c\x5b\x8e\x65\xe3\x5a\x18\xa3\xcf\xcc\x69\xc4\x16\x72\x59\x8e\xc9\x4e\xee\x78\xe8\x13\x65\xbd\xf6\x25\x25\xaa\x33\x56\xcc\x3c\x33\x9a\xc0\xe0\x4e\xfa\x64\x38\x9f\x3d\x96\xb4\xd2\x10\xae\x05\xb5\xb7\xd2\x4b\xbf\x9a\x00\x8a\x77\xaf\xe6\x6e\x47\xb8\x76\xf7\x42\x9b\x09\xd0\x50\x22\x21\xc3\x26\x02\xcf\x74\xdf\xda\xfc\x52\xdb\x0f\x1a\x4e\xa6\x8d\xeb\x3f\xfc\x7c\xb9\x5b\x3d\x86\x7b\x33\x55\xb1\xde\x9b\x35\x42\x29\x4c\x40\xb5\xdb\xef\xc2\x44\x68\xfc\xc7\xbf\x33\xdb\x7d\x85\xca\xd9\x49\x46\x48\x1f\xa5\x0b\xa3\x0f\x17\xf6\x52\xef\x29\x29\x71\xfc\x0b\x00\x00\xff\xff\x27\xf0\xf4\xcd\xdd\x07\x00\x00")
+var _assets_live_task_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x57\x5b\x6f\xdb\x36\x14\x7e\xef\xaf\x38\x23\xba\xb5\x01\x6a\xd9\x2b\xd6\x3e\x74\x76\x86\x2c\xf7\xa2\x59\x83\x24\xc5\x1e\x07\x5a\xa2\x6d\x36\x14\xe9\x92\x74\xda\x40\xc8\x7f\xdf\x21\x29\xd9\xa2\x24\xdb\xc2\xda\xe5\x25\x22\xf9\x9d\xef\x5c\x79\x78\x5c\x14\x19\x9b\x71\xc9\x80\x08\xfe\xc0\xee\xa8\xb9\x27\x4f\x4f\xcf\xc6\x3f\x65\x2a\xb5\x8f\x4b\x06\x0b\x9b\x8b\xc3\x67\xe3\xf0\x0f\x60\xbc\x60\x34\x73\x1f\x00\x45\x61\x59\xbe\x14\xd4\xa2\xac\x3b\xbe\xc0\x13\xa6\x09\x90\x0f\x48\x04\x15\x93\x87\x8e\x4d\xaa\xf9\xd2\x82\xd1\xe9\x84\x50\x63\x98\x35\xc3\xb0\x65\x86\x4e\xed\x3f\x16\xd1\xc3\x9c\x72\x99\x7c\x36\xe4\x70\x5c\x1e\x7a\x85\xc3\x4a\xe3\x78\xaa\xb2\xc7\xb6\x6a\x49\x1f\xd6\x9a\x8d\xa0\x0f\xcc\x6c\xd4\x66\xfc\x01\x52\x81\x0a\x27\xe8\x9d\xb1\x24\x48\xc7\x07\x4b\x2a\xd9\xfa\x20\xa6\xae\x22\x72\xc6\x99\xc8\x0c\x81\x04\x89\x37\xb8\xaf\xdc\x2e\x20\xb9\xd6\x6a\xae\x99\x31\xb5\xa3\x98\xc4\xb9\x56\x81\xba\x89\x98\xcc\xd6\xeb\xf1\x10\x4d\xab\xac\x29\x0a\x3e\x83\xe4\x83\x9a\x9f\xd6\x10\x3b\xad\xaf\xc9\xdc\x5a\xaa\x6d\x64\x55\x6c\x57\x8e\xf6\xd0\x39\xf3\x26\x61\xe8\x3e\x0a\x0c\x21\x30\x69\x35\x67\x06\xa8\xc6\xcc\xf3\x2c\x63\x32\x81\x13\xf5\x55\x0a\x45\x33\xb0\x0b\x06\x42\xcd\xc1\x2a\x30\x8c\xb9\x65\x9e\x90\x86\xdf\x2c\xb2\xb4\x6d\xeb\x60\xba\xb2\x56\x49\x8c\x41\x46\x2d\x1d\xa4\xa8\x90\xe9\x09\xb1\x7a\x15\xbb\xe1\xd2\xed\x91\xa0\x64\x2a\x78\x7a\x8f\x19\x54\x29\xb5\x5c\xc9\xc9\x8b\xa1\x2f\x17\x34\xe5\x0f\x9f\xf0\x49\x51\x24\xb7\xee\xe3\xf2\xe4\xe9\xe9\x17\x9a\x2f\x7f\xff\xac\xa6\x6e\xf3\xbd\x9a\x5e\xca\x8c\x7d\x2b\x77\x9d\x94\xdb\x76\x29\x2d\xf7\x5f\x90\xc3\xca\xbd\xf1\x30\x28\xec\xb6\xa2\x74\xc1\xaa\xf9\x5c\xb0\x81\xb1\x9a\xd1\xbc\xf4\x21\x2c\x26\xc4\x3c\x1a\x8c\x2e\x39\xbc\xe0\x19\x83\xb0\xf8\x7e\x4e\x8b\x59\xd1\x15\xa7\x5f\xfc\x08\x4e\xb5\xb2\x1b\x4e\x5c\x74\x71\x46\x95\xd8\x5e\xee\xaa\xc1\xb1\x12\xc0\xb3\x09\x99\xd2\xf4\x1e\xb3\xd4\x48\x6c\x51\x3c\x37\xae\x36\xe1\xdd\x04\x12\x5f\xa5\x77\x3c\x67\xad\x4a\xd5\x54\xce\x59\x59\xfd\xa1\x28\xd7\x55\xdd\x71\x27\x4a\xc5\x82\x57\x46\x45\xf7\x38\x70\x3c\x1e\xbb\x23\x7f\xfb\x48\x43\xd0\xfd\x59\x6e\x05\x73\x82\x89\xb3\x27\x39\x53\x3a\xa7\x16\xc8\x7b\x2a\xe1\xf5\x2b\x78\x3d\x1a\xbd\x85\x5f\xdf\xbc\x1b\xfd\xf6\x6e\xf4\x26\x19\x8d\x46\xa4\x9b\x25\x84\x9a\x7d\xf1\x44\xb7\xec\x0b\xa2\x0e\xc7\x06\x43\xd4\x81\xad\x5a\x93\x9a\x0f\x2c\xaa\x24\x87\xe8\x35\x13\x4e\x3b\x94\x21\x4a\x42\x68\xb0\x21\x22\x03\x1e\x27\x57\xe1\xd6\xba\x2d\xc1\x9b\x71\x6d\x5d\xbe\xa1\x12\x5b\x32\x88\x60\x61\x58\xff\xa6\xb2\xb5\x6f\xfc\xa5\x7c\x4f\xc0\x22\x5a\xae\x6c\xd4\x0e\x9a\xfa\xd6\xc6\xad\x0f\xb0\xec\x7c\x4b\xc7\x1e\xef\x9f\x97\x0a\x84\x1f\xeb\x47\x29\x4a\x9d\x27\x28\x8a\x01\xb8\x1e\x77\xa6\x55\x7e\x45\xf1\x9a\x69\x18\x94\xcc\x33\xdc\x1a\xe4\x7e\xaf\x04\x3a\x2f\xe3\x63\xdf\x32\xaa\x53\x99\xc1\xd3\x13\x84\x9b\x31\x28\x0a\xa5\x5d\x45\xba\x05\x54\xd7\xd9\x0b\x77\x19\x16\xbf\x0d\xa5\x65\xfe\x4d\xf8\x6c\x94\xbc\x46\x73\xc1\x77\x9a\x52\x77\xe8\xcb\x67\x5c\xb0\x3b\xac\x6c\x33\x63\x7a\x1d\xaa\xe6\x93\x53\x07\x91\x4e\x99\x90\x3d\x1f\x85\x3f\x85\x9a\xee\x65\xac\x83\x48\xa7\x4c\x8d\xf1\xd3\xcd\x87\x33\x66\xd3\xc5\x56\xb6\x0a\x40\x5a\xd8\x1a\xcb\x91\x4e\x17\x88\x3d\xfd\x66\x35\x4d\xed\x56\xae\x18\x46\xb6\xc8\xb5\x79\x8f\x31\x49\x96\xed\xa3\x0d\x28\xd2\x2d\x55\x23\xbd\x94\x02\x93\xea\x02\xbd\x95\x71\x03\x21\x1d\xf8\x1a\xd7\xb9\xba\x59\xc9\xad\x34\xfe\x94\xc4\xa8\xc6\x5d\x6c\x8a\x9c\x7e\xe3\x2e\x30\xee\xdf\x46\x60\x7d\x99\x42\x5d\x21\xd9\xe5\xac\x9b\x81\x4e\x99\x28\x2f\xeb\x92\x72\x0d\x04\xb1\x68\x28\x89\x85\x36\x94\xf5\xaf\x0d\xcd\xcc\x31\xdc\xb2\x25\xd5\xd4\x2a\x4d\x5a\xe7\xd8\xf6\x58\xa4\x25\x34\x6a\xd7\xbe\x48\xb3\xcb\x7b\x93\xa5\xb2\xde\x02\xc9\xe5\x7c\x6d\xc3\x0e\x3e\x6c\xf7\x15\x1b\x7e\xd6\x5e\x8c\xdd\xce\xa2\x66\xbb\x32\xbe\x4f\x59\xd0\x41\x1d\x89\x42\x77\xaa\xb5\xd2\x3d\x43\xe7\xb1\x24\x96\x89\x93\x11\x86\xc2\x1b\x66\x56\xa2\xbb\xe8\xdd\x10\x12\x8e\x9b\xc3\x60\x94\x82\x4d\x41\xf4\xf4\xef\xa6\xee\x5b\x45\xd5\xd1\xb7\x3a\xc6\xd1\x4d\x52\xdc\x83\x7b\xe6\xee\x1d\x8e\x59\x30\x4a\x46\x1d\x26\x2c\xeb\xb2\x04\x5e\x06\x33\x2a\x46\xb2\x91\x3f\x68\x55\x93\x8b\x76\xb0\xb7\x97\x6b\x15\x27\xb6\xe7\xe0\x63\x5d\xb8\xce\x1b\x26\x85\xe7\x92\xe6\xec\x15\x3c\x7f\xa0\x62\xc5\xfc\x6c\x71\xc5\x70\x6e\x48\x7b\x29\xf3\xc2\xe8\x8c\xe6\xd2\xce\x80\xfc\x3c\x27\x25\xd1\x41\x9f\x78\x46\xf9\x6c\x5d\x8d\xb6\x67\x7f\x53\x21\xc0\xbf\xf8\xf0\x32\x5b\x69\x3f\xd5\x42\xe2\x76\x5d\x5d\x1f\xd4\xdf\x12\xf7\xfb\x22\xdd\xfc\xbc\x28\x83\xc8\xe7\x92\x8a\x9e\x35\x1b\xc0\xa4\x21\xb5\xab\xe7\xb4\xcb\x1e\x5b\x0f\xa4\x2a\x63\x65\x1b\x3a\xc6\xcf\xce\xea\xdf\x45\x72\x7c\xfd\xa9\xed\x33\x6e\x6e\x5c\xae\xdc\xbb\x66\xf4\xfe\xe6\xf6\xb6\xa7\x71\x0e\x0d\x39\xcb\x95\x7e\x44\xea\xe9\xa3\x75\x93\x62\x49\x71\xb0\xb5\xbb\x6d\x79\xd1\xa3\xa7\xb7\x95\xc9\xc6\xe8\xe3\xc0\xb0\x1d\xdd\x36\xf5\x0e\x7f\x38\xb9\xe9\x03\xc3\x78\xa7\xfc\x2f\x96\x1e\x42\xe5\x88\xb3\xa4\xd6\x3d\xb8\x61\x75\x8d\x8b\x1e\xa2\x5e\x45\x25\xe9\x17\xa5\xe0\x16\xef\xa3\x31\x61\x9f\xf7\x0e\xdc\xc7\x69\x87\x83\x05\x35\xce\x86\x0b\xfc\xf7\x3f\xd8\xbd\x1e\x48\xf6\x66\xcc\xa1\x00\xe1\x7d\x0c\x77\x30\x3f\xe1\x7c\x8f\xc5\xeb\x2b\x7b\x71\xf4\xfa\xcd\xdb\x7e\x2d\xfd\xe2\x68\x80\x58\x12\x09\xed\xa9\xdb\xc6\x18\xb5\x2f\x0e\x25\x0e\x4a\xb1\x3e\xd1\xa8\xa0\xeb\x59\xaa\xe6\xdc\x09\x33\xb6\x97\x6b\x0e\xc8\xa5\xbf\xfb\xa4\x26\xd6\xcf\xb9\x72\x98\xdb\xe7\x5b\x80\xfd\x08\xd7\xca\x5f\xa0\x98\x49\xbc\x72\xb2\xe7\xa3\x15\xc0\xd5\xab\xbe\xc7\xb3\xda\x50\xb9\xcf\xad\x00\x85\x6e\xec\x7f\x28\xc8\xab\x7a\x0f\xdf\xd9\x7f\x7c\xdf\x7f\xa9\x52\x4b\x45\x10\x3b\xd8\x31\x27\x76\x34\xbd\xf2\xcc\x35\xbd\xf2\x73\x7b\x40\xc2\x78\xbc\x2f\x16\xe7\x0a\x3a\x61\x6d\xe5\xe7\x1f\xaf\x8f\xee\x2e\xfc\xbc\xdd\xb7\x67\xaa\x95\x4e\x19\x64\x5c\x7b\xa9\xb0\x3c\xe1\x3a\xae\x89\x23\x3d\x5f\xe5\x4c\xda\x7e\x45\xd1\xb3\x1a\xfc\xa0\xbf\xff\xea\x06\x50\xc5\xf1\x2f\x8f\xcb\x27\x71\xc0\x15\x00\x00")
 
 func assets_live_task_html_bytes() ([]byte, error) {
 	return bindata_read(
@@ -236,7 +296,27 @@ func assets_live_task_html() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/live_task.html", size: 2013, mode: os.FileMode(420), modTime: time.Unix(1475424089, 0)}
+	info := bindata_file_info{name: "assets/live_task.html", size: 5568, mode: os.FileMode(420), modTime: time.Unix(1792351624, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assets_log_search_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd5\x58\xdb\x72\xdb\x36\x10\x7d\xf7\x57\x6c\x31\x6d\x93\x3c\x48\x6a\x5f\x5b\xca\x19\xb7\x4d\xda\x66\x92\x4c\x27\x71\x3e\x00\x22\x57\x12\x6a\x10\x50\x00\xd0\xb5\x86\xc3\x7f\xef\x02\x20\x25\x5e\x24\xd6\x8a\x14\xcf\xf4\xc9\x26\xb0\xbb\xe7\x2c\x76\x01\x1c\xa8\x2c\x33\x5c\x0a\x85\xc0\xa4\x5e\x7d\x44\x6e\xd2\x35\xab\xaa\xab\xe4\x9b\x4c\xa7\x6e\xbb\x41\x58\xbb\x5c\x5e\x5f\x25\xf1\x0f\x40\xb2\x46\x9e\xf9\x7f\x00\xca\xd2\x61\xbe\x91\xdc\x91\xb3\x9f\xfe\x83\x66\xd0\x30\x60\x6f\xf5\x0a\xf6\xa1\xc8\x67\xd6\x38\x25\x0b\x9d\x6d\x87\xde\x8a\xdf\xef\x9c\x89\x86\xf5\x6e\xc1\x28\xc9\xc4\x3d\xa4\x92\x5b\x3b\x67\x52\x58\xc7\xa2\x6f\x77\x62\xc3\x15\xee\x26\xba\x81\x73\xb4\x96\xaf\xf0\xb5\x40\x99\x51\xec\x48\x0a\xdc\x1a\xc1\xc3\x80\x5e\x82\x29\x94\x12\x6a\x05\x5c\x65\x40\xeb\x20\xec\x1a\x33\x70\xdc\xde\xd9\xc8\xbd\x86\x5b\x6a\x93\x43\x8e\x6e\xad\xb3\x39\xfb\xfd\xd5\x2d\x03\x9e\x3a\xa1\xd5\x9c\xcd\x02\xe1\x3d\x7c\x97\x9b\xc3\x07\x37\x59\x06\xf8\xb6\x09\x19\x49\xbe\x40\xd9\x98\x05\x8b\x49\x18\x62\xd7\x91\x65\x32\x0b\x9f\x3d\xaf\x56\xe8\xe8\x73\xcf\x65\x81\xbd\xd8\x64\x27\xd4\xa6\x70\xa0\x78\x8e\x73\xf6\x99\x41\xb0\x9a\xb3\xb2\x9c\xfe\xc5\x9d\x43\xa3\xaa\x8a\x32\x28\x9c\x5e\xea\xb4\xb0\x3d\x8c\x19\x81\x74\xf2\x19\x0c\xb4\x58\xa4\x6b\x4c\xef\x4e\xcd\xf0\x03\xae\x0a\xc9\x0d\xe0\xc3\xc6\x50\x85\x68\x1d\x2f\x91\xad\x6f\xd7\x9a\xd0\x42\x3f\xb0\x3a\x7b\x83\x2b\x82\x61\xd4\x16\x62\x09\xd3\x0f\xe1\xab\xaa\x20\x98\x51\xa9\xcb\x12\x55\x56\x55\xe7\x2c\x81\x45\x89\xe9\xc9\x55\x7e\xa3\x17\xe7\x25\x1d\x61\xeb\x2c\xff\xd6\x8b\x81\x05\xd9\xe8\x8d\x6f\xd2\xa6\xfc\xec\xfa\x46\x6d\x93\x59\x1c\x1c\x5a\x97\xe5\xb7\x14\xe6\xcf\xdf\xe0\xa7\x39\x4c\xdf\xf8\xff\x5a\x3b\x60\x6f\x64\xb8\x5a\x61\x30\xb0\x07\xe6\x07\xa0\xd4\x73\x3e\x50\x5d\x00\xfc\x0c\xf4\x09\x11\x88\xca\x10\x93\x68\xd5\x81\xcc\xdf\x53\x46\x55\x35\xc6\x33\x98\xf6\xd7\x63\x16\x63\x3d\x7d\x25\x3f\x4a\x7e\x8f\x17\xac\xa5\xf5\xf1\x2e\x51\xcd\x10\x28\x54\x33\x50\x8c\xeb\x7a\xbc\xa2\xc1\xe8\xb1\x35\xed\x54\x14\x22\xd4\x91\x7a\xfe\xaf\x6a\xe9\x0c\xf2\xfc\x92\xc5\x0c\x01\xcf\xae\x66\xcf\xda\xba\x4c\x17\xae\x55\x81\xc8\x1b\x9a\x89\x43\x95\x88\x53\xa7\x40\xa0\x31\x87\x21\xfc\xc4\x11\x08\x9a\x7a\x3c\xc4\xd6\xd2\x55\x7d\x08\x22\x4e\x1c\x84\x08\x53\xc7\x20\x2e\xd1\x38\x5f\x74\x65\x0b\x95\xe2\xe5\xee\xb0\x8c\xc4\x8b\x13\x39\x4e\xa4\x4e\xb9\x64\x40\x29\x6f\xe6\xec\xc7\xe6\x4a\xb3\x1e\xad\x7d\xa9\x07\x78\xda\x91\x4f\x9d\xf6\x27\xe5\x84\x7c\xb2\xb4\x0b\x8f\xd6\x4e\x3b\xc0\x9f\x99\xb6\x2a\xf2\x05\x9a\x53\x13\xff\x55\x2b\xbf\x5e\x20\x49\x39\xdb\xcb\x2d\x40\x24\xc3\x20\x17\x24\x2c\x7f\x68\xf2\x4e\x23\x58\x3b\xf3\x1a\xff\xcc\xdc\xbd\x70\x9e\x2c\x0a\xe7\xb4\xb2\x0c\x68\xf5\xf9\x24\x45\x0a\x6c\xa8\x1b\xcc\x80\x6b\x87\xa9\x2d\x16\xb9\xd8\x53\xaa\xd5\xfe\x08\x72\x32\xf3\x12\x7a\x27\xdf\xe3\xe4\x55\x73\x09\x78\x69\xf6\xca\x18\x6d\xda\x9a\xfb\xb8\xc4\xef\x8a\xfc\xb0\xfa\xb5\xc4\xdf\x70\x61\x80\x85\x50\xec\x40\xc8\x36\x27\x3a\x51\xa4\x45\x88\xaa\xd0\x16\xd2\xb5\x0c\x1b\xb1\xe8\x87\xa7\xb7\xf4\x46\x48\x09\xa9\x7b\x4f\x8d\xd2\x1b\x7b\x85\x3c\xdf\x18\xa1\xdc\x92\x5e\x23\x6b\xfd\x8f\x7f\x7a\xf8\xe7\xc8\x52\x18\xeb\xe0\xbb\x0c\x72\xee\x48\x9a\xda\x29\xd9\x49\x54\x3b\x0e\xef\xe2\xf0\x8b\x17\x5d\x0e\xdd\x35\xee\x5f\xa6\xbb\x9b\xbd\x1b\xa4\x13\x22\x66\x7a\x43\xd5\x13\xf7\x38\x50\x7c\x83\x24\xeb\x2e\x91\x22\xbd\xe3\x0b\x89\x75\xa3\x0c\x15\x83\x56\xc1\x86\x5e\x6d\xb4\x95\xc3\x43\xe9\xd9\xcc\xbf\xaa\xfc\x6b\xe9\x25\x8f\x68\x73\x6a\xe4\x16\xf0\xb3\x5e\x81\x7d\x75\xbe\x36\x9d\x97\x41\xb7\x78\x22\x41\xfc\x78\x1a\xdf\xf3\x7c\xf3\x33\xc9\x53\x3f\xe8\x45\xb0\xca\xf0\xa1\x1e\xf5\x1e\x7e\xf8\x96\xfe\xd6\xe3\x03\xd2\x03\x35\x33\xde\xa9\x01\x96\x1d\x17\x68\xe3\xde\xc4\x8f\x05\x25\x7e\xb2\xa7\x4f\x81\x5c\x5b\x99\xf4\x9c\xc7\xba\x62\xf4\x8d\xfd\xda\xe8\x1c\x16\x48\xbb\x1d\x43\x67\xe7\x9c\x8e\x71\x03\xf4\xce\x73\xdc\xd0\x26\x62\x03\xa8\xf1\x35\xf3\x97\x42\x97\x3a\xdd\x10\x7e\x6f\x2b\x67\xb6\x53\xff\xd1\x6f\x12\x2d\xf7\x1a\xd0\xb7\xda\x24\x9c\xd2\x83\x93\x77\xb7\x3b\x7e\x09\x64\x0f\xe8\xde\x44\x8a\x5e\xa4\xe6\x28\xf6\xb2\xf6\x5d\xcc\xdb\xab\x5b\x29\x86\xc1\x0f\xea\xda\x41\xc0\xb0\xdd\x43\xb8\x98\xcf\x7f\x04\xad\x19\xdf\x2c\x69\x49\xbf\x3e\x61\x52\x59\xf2\x7a\xf4\xb8\xe9\x6f\xd1\x2f\x3e\x14\xd9\x7b\x1d\x8f\x3e\x7f\x20\xd2\x19\x01\x74\x0f\x19\xe1\xcf\xc1\xc7\x1f\x78\xed\xaf\x9d\x61\x32\x8b\x3f\x39\x25\xb3\xf8\x0b\x56\x63\xf4\x2f\x95\xe3\x8c\x96\xf8\x12\x00\x00")
+
+func assets_log_search_html_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_log_search_html,
+		"assets/log_search.html",
+	)
+}
+
+func assets_log_search_html() (*asset, error) {
+	bytes, err := assets_log_search_html_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/log_search.html", size: 4856, mode: os.FileMode(420), modTime: time.Unix(1792354546, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -261,7 +341,7 @@ func assets_login_html() (*asset, error) {
 	return a, nil
 }
 
-var _assets_scripts_job_edit_creator_js = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x56\xcb\x6e\xeb\x36\x10\xdd\xe7\x2b\xb8\x93\x8c\xc4\x42\xb2\xad\x9a\x02\x8d\x13\xa0\x06\x8a\xb4\x40\xbb\x33\x82\x82\x11\xc7\x36\x11\x86\x14\x48\xca\x8e\x11\xf8\xdf\x3b\x43\xea\x41\xd9\x8e\xe3\x7b\xb3\xb1\xa2\x99\x33\xe7\xcc\x83\x1c\xe5\xcb\x46\x57\x5e\x1a\x9d\x4f\xd8\xe7\xd5\x15\x63\xdd\xff\xec\x5f\xee\xde\x66\x16\xb8\x37\x36\x97\x82\xac\x0c\xff\xfc\x5a\xba\xe2\xbf\x57\xee\x80\xdd\x33\x61\xaa\xe6\x1d\xb4\x2f\x56\xe0\x9f\x14\xd0\xa3\x7b\xd8\xcd\x14\x77\xee\x99\xbf\x43\x9e\x79\x8c\x31\xcd\xd8\x35\xc3\x00\x8b\xdb\x97\x12\x43\xec\x89\x24\x89\x5d\xd4\xd6\x78\xe3\x77\x35\x14\x15\xbd\xa1\xb8\x23\x4d\xc4\xba\xe1\x96\x81\x42\xcb\x40\x5f\x54\xca\x68\x78\x36\x02\x72\x6f\x1b\x98\x94\xc1\xd1\x81\x6f\xea\x10\x19\x44\x0e\xaa\x7d\x6b\xf1\xad\xd5\x18\x21\x08\x28\x49\x01\x45\x74\x35\x54\x92\xab\x3f\xb8\x16\x0a\xac\xc3\xf0\x91\x2d\x5b\x19\xdb\xe8\xec\x97\x41\x07\x46\x6a\x6d\x11\x29\x40\x81\x87\xdf\xed\x0a\x31\xa0\xbe\x4c\x3f\xba\x4d\x5f\x1b\xef\x8d\xce\xba\x0a\x74\x41\xb8\x10\xdf\x46\x40\x9f\x53\xf0\x08\x2d\x8c\xae\x94\xac\xde\x4e\x55\xac\x23\x59\x4a\x50\x22\x6d\x55\x2c\x72\x4b\x86\x12\xe5\x26\x9b\x94\x3d\x24\xb8\x63\x69\x5b\x01\x08\xcc\x3c\x7c\xf8\x69\x78\x9f\x95\xa3\xd0\x1b\xae\x66\x46\x7b\x2e\x35\xd8\xcb\x19\x52\xd4\x98\x28\x70\x4c\xd1\xde\xc0\x31\xd3\x19\x02\xa9\xeb\xc6\x7f\x49\xc1\xeb\x1a\xb4\x98\xad\xa5\x12\x39\x1a\x8e\x72\x3d\xb0\xf7\xc0\x49\x18\x93\x41\x03\x7c\x48\xe7\xa5\xfe\xa6\x5f\x49\xb1\x12\x26\x04\x48\xed\xc0\xfa\x07\x58\x1a\x0b\x79\x70\xb8\xe9\x43\x2e\xba\x87\x42\x81\x5e\xf9\x35\x9b\xb2\xbb\x97\x42\x63\xa8\x96\xe3\x1f\xf9\xaa\xd0\xdc\x47\xdc\x77\x0f\xfd\x1c\x5e\x3c\x0a\xee\x67\xf4\xcb\x25\x8b\x9a\x5d\xa7\xf0\x37\x76\x97\x86\x6f\x09\x88\x3d\xb8\x2d\xc6\xde\x94\x4f\x99\x38\x2f\x8b\x9a\x5b\xe4\xa6\xd3\x5b\x58\x78\x37\x1b\x88\x0d\x58\x26\xa4\xfb\x71\xb2\xfb\xfe\xe8\xf6\x37\xd4\xe1\x61\x6f\x05\x9d\x4b\x8f\xee\xa3\x58\xb4\x70\x9e\xce\x97\x0d\x03\x7d\xa1\xb3\xbf\x59\x5a\x71\xdf\x51\x12\xb2\xa9\x2f\xa0\xa4\x32\x22\xd6\xc7\x2e\xd5\x16\x36\xd2\x34\x6e\x3c\x06\x5d\x89\xa8\x2b\xe4\x9b\x36\x62\x2c\x79\x34\x74\xa0\x6e\x42\xe8\x61\x8a\x7e\x34\x03\x61\xb6\xfa\xc2\x1c\x68\x78\x63\x0e\xc7\x63\x9c\xea\x27\xeb\x85\xfa\xc9\x15\xcf\x8c\x3a\xad\x9f\x48\x25\x5d\x74\x5b\xa9\x51\x67\x41\xa2\x5b\xda\xf9\xe3\xd0\x2f\xe2\x3c\xb8\xf6\x8b\x35\x77\x7f\x6d\xf5\xdf\xd6\xd4\x48\xb6\xa3\x5d\x37\x28\x3a\xf0\x5d\x48\xf1\x92\x34\xbf\x5b\x67\xe3\x89\x9c\x6b\xe9\x11\x33\x5a\x5e\x24\xc7\x9d\xde\x98\x0f\xbb\xb9\x88\x45\x76\xdd\x99\xeb\xbc\xc3\xef\xd9\xbe\x74\x08\xac\x10\xcb\x43\x0d\x10\x76\x8b\x8d\x06\xdd\xe3\xe3\x21\x2c\xd1\xf4\x2b\xbd\x2f\xd9\xf5\xb5\x1c\x77\x2b\xae\x56\x72\x5e\xc8\xfe\xa0\x9e\x5e\xa5\x7d\xce\x84\xab\xe2\x0a\x9f\x3f\x92\xd8\x05\x5e\xe0\x78\xb0\x2c\xd7\x6e\x09\x36\xbb\xe9\xd6\x28\x3e\xe0\xfd\xe6\xb3\x10\x38\x41\x11\x46\x37\x2a\xec\xe4\xb6\x69\x89\xe5\x68\xae\xa8\x73\x83\xfd\x3e\x62\x87\x34\x12\xe8\x67\x7f\x3d\x8e\xab\x12\x2b\x30\x68\xee\x0b\x33\xaa\x47\x32\x4a\x83\x6b\x52\x96\x81\x8a\x86\x81\x72\x80\xed\xe1\xb7\xd2\xc1\x84\xa6\xdf\x20\x1d\x78\xf8\x12\x69\x73\xc7\x95\xfe\xb4\xc1\x1e\xff\x89\xab\x00\x70\xff\xe4\x99\x32\x5c\x60\xf5\xd2\x99\xa2\xa5\xb4\x9f\xe4\xf8\xf3\x7f\x00\x00\x00\xff\xff\x50\x7f\x9d\x65\xb8\x09\x00\x00")
+var _assets_scripts_job_edit_creator_js = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x56\xdf\x6f\xd3\x30\x10\x7e\xe7\xaf\xf0\x5b\x52\xad\x0d\xe3\x95\x32\x24\x36\x90\x98\x84\x06\x12\xbc\x55\x13\x72\x93\x4b\x6b\xe1\xd9\x91\xed\xb4\x54\xa8\xff\x3b\x77\xb6\x93\x38\xa1\xed\x46\x5f\x9a\xc4\xf7\xdd\x7d\xf7\xd3\x97\xd7\xad\x2a\x9d\xd0\x2a\x9f\xb1\x3f\xaf\x5e\x31\xd6\xbd\xb3\x1f\xdc\xfe\xba\x33\xc0\x9d\x36\xb9\xa8\xe8\x94\xe1\x6f\xc7\x0d\x73\xf0\xd4\x48\xee\xc0\xb2\x1b\x56\xe9\xb2\x7d\x02\xe5\x8a\x0d\xb8\x4f\x12\xe8\xf1\xf6\x70\x5f\xe5\x99\x43\xf8\xa2\x97\xcc\x66\x4b\x0f\x77\x5b\x61\x8b\x9f\x6b\x6e\x01\xb1\xfd\x69\x02\xb6\xb7\x87\x3b\xc9\xad\x7d\xe0\x4f\x10\x95\x64\xec\x8a\x21\x81\xd5\xf5\x23\xe9\x38\x12\xc9\x84\x5b\xd1\x18\xed\xb4\x3b\x34\x50\x94\xf4\x85\x14\x8f\x7c\xea\x58\x83\x24\x93\xbd\xfd\xa2\x94\x5a\xc1\x83\xae\x20\x77\xa6\x85\xc8\xcf\x82\x6b\x1b\xaf\x19\xaa\x1c\x64\xfc\x6a\xf0\xab\x51\xa8\xc1\x13\x58\x12\x03\xd2\x68\x1b\x28\x05\x97\x9f\xb9\xaa\x24\x18\x8a\x46\xb0\x96\x6d\xb4\x69\x55\xf6\x76\xe0\x81\x9a\xe2\x59\x40\x56\x20\xc1\xc1\x07\xb3\x41\x0c\xc8\xb3\xee\x07\xb1\xc5\xba\x75\x4e\xab\xac\x8b\x40\xa7\x84\x57\xd5\xb3\x1a\x50\xe6\x14\x3c\x40\x0b\xad\x4a\x29\xca\x5f\xa7\x22\xd6\x19\xa9\x05\xc8\x2a\xcd\x73\x08\x72\x34\x86\x14\xc5\xae\xcb\x2d\xfd\xbc\x38\x86\x36\x12\x40\x60\xe6\xe0\xb7\x5b\x04\x35\x3e\x2e\x0b\x6e\x36\xd9\x72\x64\x64\xc7\xe5\x9d\x56\x8e\x0b\x05\xe6\xe5\xb6\x52\xd4\xd8\xa4\xb7\xb6\xc0\xf3\x16\xfe\xb5\x74\xc1\x80\x50\x4d\xeb\xce\x9a\xe0\x4d\x03\xaa\xba\xdb\x0a\x59\xe5\x78\xf0\x8f\xd7\x93\xf3\x1e\x38\xf3\x05\x13\x7e\x98\x2a\xa1\x2c\x18\x77\x0b\xb5\x36\x90\x7b\xe4\xbc\xcb\x47\xc3\x0d\xf2\xa0\x9a\xec\x95\x1f\xbb\x87\xbe\x64\x5e\x9c\x35\x7b\xb9\x34\x86\x64\x24\x9e\x88\x9a\x05\x4e\xb6\x90\xa0\x36\x6e\xcb\xde\xb3\xeb\x54\x7b\xd4\x4f\xc6\xbd\xd8\x6a\x2c\xbd\x60\x6f\x1e\x97\x89\x70\x9d\xf8\x54\x18\x78\xd2\x3b\x08\x01\xaa\x13\xa3\xc7\xb1\xaf\xc7\x79\xec\x22\xa3\xdb\xe6\x5c\x17\xbd\x7e\xcd\x1e\xc0\x62\x97\x32\x2f\x66\x59\xa9\x31\xf7\xb5\x30\xd6\x31\xa1\xb0\xcf\xa1\xcf\xf2\x9c\x59\xcd\xb4\x92\x87\x01\x4a\xc7\x41\xbd\x65\x7a\xaf\x58\x49\x9c\x90\x27\x43\xb2\x38\x03\xb8\x29\xb7\x50\x15\x49\xaf\xd1\x20\xa2\x78\xee\x85\xaa\xf4\xbe\xf0\xf2\x31\x9a\x48\x6b\x1e\xc9\x2e\xbc\xd8\x10\x4f\x42\x86\xee\xbb\x88\x6d\xb8\xea\x9a\x7c\x02\xc6\xba\xf8\x81\x93\x0d\xc1\xf1\xf8\x7c\x36\xbd\x7d\x6a\x77\x1a\x85\xa7\x66\x05\xcd\xcc\xff\x52\x74\x7a\x6e\x90\x9a\xe7\x4a\xd0\x87\x61\xd4\x0f\x9d\xef\x61\x66\xdb\x7c\xb6\x8a\xae\x15\xbe\x4b\x1f\x63\x27\xe6\xb3\x69\xe1\x1f\xfb\x89\xdb\x5f\x4c\xd3\x19\x1d\x0d\x5f\x28\x75\x7f\x8d\x84\x06\xf2\xee\x5c\xe6\x8f\x8a\xce\x14\x6d\x7f\x21\x44\x72\xcf\x99\x24\x24\xd6\xd8\xf3\x26\x29\x45\x88\x75\xa1\x63\x1b\x03\x3b\xa1\x5b\x1b\x15\x7f\x17\x6b\x29\xd4\xa6\x0b\x0c\xb5\x28\xc9\xa6\x01\x1f\x53\x1e\x4d\x18\xaa\x30\x2f\xde\x07\xf6\x7f\x3d\xc0\xc4\xa9\x17\xfa\xa0\x70\xd6\x07\x1f\xe8\xe9\x3c\x7f\x3a\x7d\x21\x7f\x12\x9d\x33\x90\xa7\xf9\x93\x51\x51\x0d\xcd\x45\xa4\xa3\xd9\xfb\x8f\x43\xbe\xc8\xe6\xe4\xb6\x2e\xb6\xdc\x7e\xdd\xab\x6f\x46\x37\x68\xec\x40\x2b\xce\xc0\x68\x22\xbb\x12\xd5\x63\x92\xfc\x6e\x0b\x19\x57\xe4\xbd\x12\x0e\x31\xa3\x9d\xa3\x1b\x1b\x17\xb7\xa4\x61\x39\x8a\xd2\xa1\x7d\x2e\xe5\xa5\x43\x60\x84\x58\xee\x63\x80\xb0\x6b\x4c\x34\x8e\xb0\x0e\x1f\x26\xf2\x12\x8f\xde\xd1\xf7\x25\xbb\xba\x12\xe3\x6c\x85\x8d\x88\x84\x57\xa2\x6f\xf1\xd3\x1b\x50\xef\x33\xe1\x62\x17\xdf\x7f\x24\xb2\x2b\xbc\x6d\xb1\xb1\x0c\x57\xb6\x06\x93\xe1\x38\x5b\x4b\xbd\x4e\xdf\x5b\x23\x6b\x70\xe5\x96\x9e\x69\xb2\x8a\x1d\x60\x4e\x0d\x2f\x5d\x16\x27\x7d\xfc\x1a\x66\x00\x89\x09\x85\x15\x03\xa4\x98\xde\xc2\x3a\x85\x0f\xf0\x5b\xb8\xac\x1b\xb6\x99\xa7\x9c\xf0\x21\x36\xaa\x95\x7e\x49\x9b\xcc\x9b\x53\x15\x4b\x35\x31\x9c\xdf\x04\xec\x10\xa0\x04\xfa\xa7\xbf\x84\xc7\xf1\x0e\xb1\x1d\xa2\xd1\x87\x7c\x14\xe9\xa4\x48\x07\xd1\x24\xe0\x83\x29\x2a\x33\xf2\x01\xf6\xd3\xe5\x7b\x52\xfb\xe9\x52\xda\x81\x87\xd5\x34\xfa\x8e\x03\xf6\xd3\x0e\xab\xe7\x8b\xc0\x6b\x12\xd7\x90\x3c\x93\x9a\x57\x18\xbe\xb4\x5a\x69\x37\x39\xce\x72\xfc\xfb\x0b\x02\x21\xe2\xba\x09\x0c\x00\x00")
 
 func assets_scripts_job_edit_creator_js_bytes() ([]byte, error) {
 	return bindata_read(
@@ -276,12 +356,12 @@ func assets_scripts_job_edit_creator_js() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/scripts/job_edit/creator.js", size: 2488, mode: os.FileMode(420), modTime: time.Unix(1475282918, 0)}
+	info := bindata_file_info{name: "assets/scripts/job_edit/creator.js", size: 3081, mode: os.FileMode(420), modTime: time.Unix(1792349546, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _assets_scripts_job_edit_encoder_js = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x54\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\xb0\x27\x2b\x68\xe6\x66\x05\x76\x89\xb7\xc3\xda\x74\x41\x0e\x1b\x86\x35\xb7\x20\x07\xcd\x66\x62\xa1\xae\x14\x48\x72\xd2\x62\xf0\x7f\x9f\x24\x4b\xb6\x9c\xa6\xfb\x40\x73\x08\x64\xea\x91\x7c\x8f\x14\x49\xb6\x35\xcf\x35\x13\x9c\x8c\xe1\xd7\x68\x04\x10\xbe\x01\x79\x2e\x0a\x5c\x51\xf5\xa0\xdc\x1d\x98\xdf\x81\x4a\xd0\xc6\x72\x2b\xb8\xa6\x8c\xa3\x84\x4f\x50\x88\xbc\x7e\x44\xae\xd3\x1d\xea\xbb\x0a\xed\xf1\xe6\x79\x59\x90\xc4\x02\x55\x32\xce\x06\x9e\xca\x78\x0c\x22\x44\x6e\xea\xe6\xf9\xb6\xa2\x4a\x7d\xa3\x8f\xd8\xba\xc7\xde\x12\xad\xef\x7a\xd3\x5a\xb6\x42\x02\xb1\x66\x66\x8c\xd3\x09\x54\xc8\x7d\x64\x95\x9a\xf3\x4e\x97\x99\xb9\xfa\x68\xed\x19\x5c\x5e\xb2\xa0\x00\x6c\x9c\x74\x5f\xab\x92\xf4\x02\x89\xf3\x5b\xb3\xcd\xd8\xe7\x6b\x46\x2d\x52\xd7\x92\x5b\x07\x6b\x6d\x5e\xa9\x0e\xc1\x2a\x2e\x0f\x2b\x3c\x0f\x2f\x6a\x39\xb7\x80\x2c\x0e\x18\x98\x6c\x59\x85\x5a\x52\xae\xb6\x28\x67\x3e\xe2\x17\x63\x5b\x79\xdb\xc4\xe3\x76\x42\xd6\x3c\x00\x16\xe2\x47\xcd\xc3\x0d\x3e\x31\x1d\x2e\xee\xcc\xb9\x25\xbf\x66\xc5\x26\x24\x3d\xc7\x3a\xce\x71\xca\x9e\xef\x6b\x6d\xeb\x8c\xd5\xb0\x31\x2b\xba\x6b\xdb\xe2\x10\xc9\x79\x41\x71\xe0\x59\x67\x05\x58\x89\xfb\x8a\x1e\x70\x06\x17\x17\x6d\x82\xf5\x74\x93\xe6\x25\xe6\x0f\x58\x4c\x3a\xd4\x57\xaa\x34\xca\xef\x54\x97\x33\xcf\x63\xfd\x7e\x93\x1e\x68\x55\x63\x0f\x72\x81\x06\x98\x6b\x8f\xf1\x90\xa6\xc5\x36\xaf\x89\x77\xf5\x7b\x9b\xea\xfe\x35\x06\x89\x2e\x68\xac\x78\x21\x06\x1c\xa7\x2f\x74\x2c\xc4\xbd\xa8\x65\x8e\x73\x26\xff\xa0\xf6\xb3\xdc\xb9\xd9\x52\x33\xf3\xf0\x83\xc0\x5e\xdf\xc9\x18\x5c\x87\x31\x68\xe3\xfd\xc3\x1c\x38\xde\x69\x97\xa5\x9d\x0b\xcf\x86\x79\x36\xff\x39\x12\xf6\x19\x46\xe5\x0d\x2f\x24\xb1\xf6\xc4\x94\xa8\x39\xd3\x99\x17\xe3\x12\xf5\x26\xb7\x1b\x01\x7d\x73\xf2\xb0\x1e\x52\xb5\xaf\x4c\x9e\x04\x42\x4f\xce\xef\x03\xef\xfc\x97\x4a\xb0\x2d\x90\x2b\xcb\xe1\xdd\x55\x8a\x4f\x98\x13\xef\xe6\x16\x42\xd4\x54\xaf\xa5\xbf\x4d\x55\xfd\x53\x69\x49\x3e\x78\x12\x5d\x73\xdc\xbf\x2e\xa5\x38\x42\x82\xad\x30\xb3\x27\x8d\x0a\x2e\x34\x94\xe6\x05\x3b\xc9\xb0\x9c\x27\x5d\x31\x8e\x8c\x17\xe2\x98\x46\x5b\xd7\x4a\xee\xbf\xb2\x1e\x33\x28\xd7\xe9\xb6\xc9\x46\xa3\x66\x4c\x0c\xa1\xdf\x01\x00\x00\xff\xff\x46\x00\xde\xc3\xda\x05\x00\x00")
+var _assets_scripts_job_edit_encoder_js = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc5\x58\x6d\x4f\xe3\x38\x10\xfe\xce\xaf\x30\xd2\x49\x49\xb5\x25\xc0\xee\xb1\x9c\xda\xe5\x24\xa0\x94\x45\x62\xf7\x56\xc0\x7d\xe2\x38\xc9\xa4\x6e\xb1\x48\x9d\xca\x71\x78\xb9\x83\xff\x7e\x33\x8e\x1d\xbb\x79\xa3\x1c\x48\xdb\x0f\x55\x62\x3f\x33\x9e\x79\x66\x3c\xb1\x27\x9c\xe6\x22\x56\x3c\x15\x61\x8f\xfc\xbb\xb6\x46\x88\x7d\x27\x4c\xc4\xe9\x84\x5d\xd0\xec\x36\xd3\x73\x04\x7e\x92\xa9\x5c\xfa\x53\xa7\x3c\x53\xe1\x24\x8d\xf3\x39\x13\x2a\x9a\x31\x75\x94\x30\x7c\x3c\x78\x3c\x99\x84\x81\x42\xe1\xa0\xd7\x1b\x82\xf0\x73\x8b\x72\xad\x21\x4e\x85\xa2\x5c\x30\x69\x17\xba\xa3\x12\x16\xcb\xc8\x1e\xb9\xbc\x1a\xea\x91\x69\x2a\x49\x88\xc3\x1c\x06\xb7\xfa\x24\x61\x02\x1e\x4a\xc1\x28\xbe\xe1\xc9\x44\x32\x11\xc1\xc4\x4c\xdd\x0c\x01\xf7\x05\x41\x43\xf2\xe1\x03\xb7\x6a\x0b\xc5\x2c\x69\x94\xbc\xe4\x66\x29\x42\xf8\x94\x84\x9b\xe1\xdf\x4f\xa4\x87\x2e\x84\xe4\xe9\x97\xde\x66\xc4\x1e\x58\x1c\xb2\x24\x8a\x13\x9a\x65\xdf\xe9\x9c\xf5\x9c\x5a\xa4\x26\x8b\x16\x79\x76\x13\x3a\xd7\x00\x5c\xf8\x8e\xbf\xe7\x35\xf7\x6f\x68\x04\x91\x2e\x66\x50\xdc\xa3\x83\x4f\xc0\x6a\x34\xc7\x50\x7c\x32\x42\xc0\xb0\x42\x97\x35\x68\xca\x13\xa6\x24\x15\xd9\x94\xc9\x81\x51\x3a\x86\xb1\x0b\x33\xd6\x37\xb8\xeb\x24\xbd\xae\xe2\x0e\x60\xac\x8a\xcb\x65\x32\x65\x2a\xbe\xb1\x98\x3f\xcf\x4e\xc7\xf8\x6e\xe7\xa9\x04\x1a\xef\x18\x7b\x00\x65\xb1\xb2\xa8\xfd\x62\xf4\xa8\x18\xad\x60\x63\xc9\xa8\x62\x15\xe8\xa1\x1e\xb4\x48\x2e\x12\x08\x11\xfa\x62\x61\x27\x7a\x04\x3d\xb1\x98\x59\x2a\x73\x61\xa7\x8f\xd3\xb3\x5c\xd8\x19\xf6\xc0\x4b\x4b\x8e\xe0\xb9\x94\x90\x69\xbe\x28\x25\xf0\xa5\x88\xcd\x25\x9f\x5c\x39\x4e\x31\x07\x80\xf3\xf5\xbd\x3d\x12\x68\x89\xc0\x85\x1b\x83\x7d\x06\xfc\x3e\x02\xe3\x85\x1a\xfd\x86\xd9\xe1\xf6\x40\x76\xf0\x78\x68\x53\xa5\xd8\x0b\x1b\x12\x51\x41\xef\x72\xeb\xca\x2c\xf2\xec\xc2\x97\x8b\x93\x29\xaa\x7b\x49\x45\x2e\xf8\x54\xab\x18\xae\x95\xa6\x18\x59\xad\x63\x59\xfc\x82\xce\x0a\xe1\x8c\x25\x2c\x56\x5a\x30\xba\xa3\x49\xce\x86\x2b\xa6\xa2\xf5\x6c\x29\x17\xc5\x22\x57\x59\x83\xb5\xe5\x72\x1a\x11\x78\xe9\x39\xa7\x0f\xa8\x89\xeb\x2c\x5d\x50\x99\x41\x28\x55\x58\x28\x2a\x6d\xf2\xa9\x07\xa7\xbf\x87\x4e\xca\xdb\x6c\xea\x46\xa6\xf7\x24\xb8\xa6\x13\x62\x26\x03\xc3\x26\xd8\x93\x31\x2d\xee\x2f\x07\x11\xdc\xf2\x63\xa7\x3d\x16\x79\x92\x54\x63\x80\x09\x73\x08\x2e\xa3\x89\xd9\x22\xe1\x4a\x17\x27\x63\xe3\xb6\xb1\xb1\x4f\x82\x7e\xd0\x8b\xe6\x74\x11\x96\xb5\x13\x79\x5a\xae\x31\x22\x9f\xfb\x7e\x6a\x80\x5f\x5d\x0a\xf7\x00\xb5\x54\x44\x2a\x9e\x3d\x6a\x8b\x08\x0a\x5b\x17\xad\xb9\x9e\x1f\x73\xe3\x86\x47\xf6\x84\x25\xf4\xb1\x81\xe7\x4f\x6d\x3c\x6b\x81\x76\x8a\x1f\x0b\x8d\xc1\xb0\x5e\xc4\xac\xc4\xb7\x92\xf0\x81\x17\x6b\xbb\xe5\x8e\x2c\xb3\x03\x47\xb2\x9d\x3b\xe7\x33\x41\x13\x98\x59\x5f\x37\x66\x7e\xbc\x82\xa2\xcc\xe2\x5b\x36\xb1\x98\x11\x2e\x3f\x28\xac\x28\x6c\x68\x4b\x57\xbf\xc8\xbd\x2d\x6b\x2b\x0e\xfa\x8a\x07\x5e\xcc\x2e\xd2\xf3\x84\xde\x31\xcf\xfc\xad\x9a\xf9\x48\x4f\xa6\x98\xfc\x41\x15\x54\xd0\x6a\x42\x95\x20\xad\x68\x09\xf3\xd1\x60\x6c\xec\xfb\xdd\xce\xfb\x95\xfb\x5d\x9d\xf7\x15\xfb\xce\x7f\xa5\x99\x33\xd6\xee\xe2\x08\x42\x3f\x0f\x7b\x5d\x7e\x6d\x57\xfc\xea\x76\xcb\x7e\x6c\xde\xa5\x0a\x9d\xf3\x7f\xd8\x6b\xb6\x86\x11\xe9\x91\xa7\xa7\x52\xfc\x8b\x5f\x50\xbc\xbd\x02\x9b\x80\x64\x00\x08\x6a\xd5\xbd\xb5\xf4\xfd\xda\xb6\xae\x11\xd1\xeb\x5a\xf1\xb6\x75\x47\xe9\xbd\x48\x52\x78\x90\xcb\xf5\xb0\x29\x94\x96\x4c\x3f\x8c\x30\xf6\x96\x28\x7a\x98\xaf\xfb\x1f\x77\x3e\xd7\xd2\xb7\xa6\xeb\x5b\x41\xe4\xc0\x32\xea\x66\xca\x22\x62\x5c\x59\x2d\x43\x96\x0f\x1a\xef\x9a\xfa\xcb\xaa\x7d\xd6\xcc\x4c\x8d\x39\xe7\xcc\x88\x65\xaa\x83\xad\x71\x2a\xe7\x54\xb5\xb1\xf5\x2a\xcf\x8b\x73\xd3\xdb\x37\x88\x82\x13\xdc\x3e\xe8\xea\x94\x42\x10\x05\x50\x37\x63\x87\xe6\x7c\xf7\x1a\xc2\x2a\x8c\x6c\xb7\xe5\x0f\xa4\x21\x94\x53\x01\x69\xe2\xbe\xd3\xa5\xe9\x4e\x2f\x09\xfe\x12\xc1\x8a\x44\xba\x93\xe5\x4f\x62\x51\xd7\x27\x30\xa4\xa1\x48\xb8\xb3\xc7\x6f\xf5\xfa\xa4\xcf\x1e\x58\x9c\x50\x16\x2a\x44\xf9\xfc\x3b\xd9\xda\xdd\xdd\x6d\x2e\x54\x30\xdf\x55\x24\x1c\x19\x7e\xfc\xea\x15\xa0\x1e\x41\xd4\x3c\xd0\xeb\xbb\xb1\x0b\x36\x5f\x24\x3a\x17\x3a\x3e\xf1\x84\x1c\xc2\x95\x0c\x38\x1a\x90\x7a\x24\x57\x0b\xa1\x3e\xfd\xff\xc4\xe8\x2d\x5f\xc1\xb4\x35\x3e\x7d\xc7\xe9\x0b\xdc\x1d\xa7\xe7\x69\x2e\x63\x36\xe2\xb2\xa3\x6a\xfc\xa0\xf1\x2d\x9d\xb1\x5a\xd9\xf0\xf8\xa6\xb3\xa5\x9d\x51\xf9\xc4\x15\x27\x58\x07\x3f\x1d\x8d\x13\x2d\x51\xf9\x24\x79\x0a\x61\xfb\x15\xb6\x97\x01\xdc\x69\x08\xe0\x88\x67\xf4\x3a\x61\x87\xc7\x7f\x78\xc0\xcf\x0d\xc0\x83\x1c\xae\xdc\x47\xe2\x6e\xa5\xed\xeb\xc4\x5a\x25\xb6\xdb\x24\xce\xd5\x84\x8b\x22\x8d\x8d\x39\xbb\xf5\x6f\x16\x62\x2e\x40\x97\x9f\x78\x75\x52\xf7\xa5\xe2\x53\xf8\x02\xb4\xd4\x9c\x4f\x6d\x26\xec\xcb\x99\xee\x8e\x80\xdc\xe5\x55\x3d\x8f\x8b\xcc\xa1\x72\x96\x75\x5f\xfe\xf4\x35\x77\x03\x70\x36\xe1\x9a\x1b\x22\xa8\xe8\x85\x16\x08\xde\x18\x75\x6e\x46\xa5\x69\x45\xe7\x02\x65\x2f\xf9\x55\xf7\x36\xa9\xde\xd5\x5e\xee\x68\x38\xb6\x32\x25\xfb\x24\x63\x8b\xf6\x2e\x0f\x8e\x40\xf1\xd3\x1b\x16\xd0\x91\x96\x0d\x51\xa4\xcb\x69\x2d\xb1\x42\xe3\x07\x71\x16\x8e\x8e\x16\x5f\x15\xff\x5a\xa6\x11\xfa\xca\x1f\x34\x36\x77\x70\xfe\x7f\xb6\x74\xf0\x0a\xe4\xd5\x26\x5b\x70\x03\x1c\x0f\xa0\x4c\x3c\xb7\x97\x35\xec\x3e\xbc\xeb\x99\xe6\xb8\xe8\x80\x38\x07\xc7\x94\x27\x63\x8a\x87\x95\xce\x5b\x8c\xee\x06\x0e\x6a\xdd\x3b\x6c\xa0\x99\x64\x05\x33\xfb\xa6\x5f\xb2\x61\xdb\x7f\x2f\x15\xef\x9a\xbc\xeb\xb0\x19\x13\x9b\xc3\x8e\xbd\xb8\xd5\xba\x7e\x18\x5a\x0f\x8d\xb1\x2f\xd7\xd0\xfd\x81\xda\x8a\x7e\xb7\x33\x69\x6a\x11\x36\x04\xdf\xb6\x14\x96\xbd\xab\xb5\xec\xbc\x28\xea\x65\x99\x09\x63\x69\x83\xc9\xfa\x80\x74\x6f\x75\x23\xbc\x82\xeb\x9b\xba\x73\x64\x3a\x98\x46\x0c\x5c\xe9\x35\x38\xeb\x66\xa3\x2c\xbf\x86\x2d\x18\xee\x34\xe6\xbb\x39\x4b\xb0\xc2\x31\x32\x49\xc1\x0b\x91\x2a\x72\x03\x27\x04\xed\x32\x39\x19\x05\x25\x19\xf7\x5c\x4c\xd2\xfb\xc8\x6b\x29\x97\x8d\x33\xfd\x36\x74\x98\x25\xba\xaa\x1d\x4f\x0f\xe7\x27\x0d\x92\xe1\xbd\x0e\xd7\xd6\x9e\x7b\xb8\xaf\xff\x03\x36\xd3\x0f\x2a\xdd\x16\x00\x00")
 
 func assets_scripts_job_edit_encoder_js_bytes() ([]byte, error) {
 	return bindata_read(
@@ -296,12 +376,12 @@ func assets_scripts_job_edit_encoder_js() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/scripts/job_edit/encoder.js", size: 1498, mode: os.FileMode(420), modTime: time.Unix(1475424023, 0)}
+	info := bindata_file_info{name: "assets/scripts/job_edit/encoder.js", size: 5853, mode: os.FileMode(420), modTime: time.Unix(1792351272, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _assets_scripts_job_edit_main_js = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x55\x5d\x6f\xdb\x36\x14\x7d\xf7\xaf\xb8\xe8\x43\x45\x21\xb6\x96\x6d\x6f\xf1\xbc\x01\xf1\x3a\xc0\xc5\xea\x04\x68\xba\x97\x20\x0f\xb4\x78\x6d\xb3\x91\x48\x83\xa4\x9c\x19\x83\xff\xfb\x2e\xa9\x2f\xca\x6e\x55\x3f\x58\x12\x79\xce\xfd\x3e\x24\xdb\x56\x2a\x77\x52\x2b\x96\xc2\x7f\x93\x09\x40\xfb\x0d\x96\x1f\xf1\xa3\xde\x84\x75\xa0\xdf\x91\x1b\xb0\xf9\x1e\x45\x55\x48\xb5\x83\x05\x08\x9d\x57\x25\x2a\x97\xed\xd0\x7d\x28\xd0\xbf\xde\x9f\x56\x82\x25\x3d\x6a\x76\x30\xb8\xb5\x49\x3a\x0f\x16\x06\xec\xfe\x23\xe2\xdb\xfb\xd3\x13\xdf\xad\x79\x89\x2c\x91\xea\x50\xb9\x96\xea\x9d\x7f\xd5\x9b\x8f\x9f\x1f\xd6\xc4\xad\x03\x02\x58\xfd\x79\xf7\xfd\x28\x08\x3e\x93\x22\x49\xb3\x23\x2f\x2a\x9c\x36\x14\x6f\xfb\x07\x24\x45\x90\x4b\xda\x13\xb7\xaf\xf6\x0e\xde\xa4\x12\xfa\x2d\x43\x95\x6b\x81\x61\x8d\xa5\x2d\xe4\x13\xff\x77\xa5\xac\xe3\x2a\x47\x42\x1e\xb8\xb1\xb8\xae\xca\x7f\xbc\x15\xd6\x27\xfb\x7c\xfb\x32\x85\x84\xb0\x20\x5b\x70\xd2\x99\x78\x34\x52\x1b\xe9\x4e\x23\xf4\x9f\x3d\xbd\xc5\xf5\x4c\x82\x2e\x1f\xbf\x8c\xf0\x7e\xf1\x3c\x82\x44\xde\x3e\x61\xf9\xc5\xf2\x1d\x8e\xb0\x7e\x0d\xc1\x62\xa9\x0d\xf9\x0a\xb4\xf3\x7c\x12\x9e\x72\x0b\xac\x69\x48\xd6\x86\x03\xbf\xc3\x2d\xbc\x7f\xdf\x36\x2a\xab\x83\x82\xc5\x62\x11\xd6\x1b\xb7\xd0\xed\xc7\x15\xeb\x50\xfd\x6e\x13\x5e\xbd\x93\x76\x4d\x77\x7b\xa3\xdf\xe0\x1d\xc1\x12\x1b\x8f\x94\xb4\x50\xa9\x8d\xae\x94\x40\xf1\xae\x9e\x9a\xf3\xa4\x1b\x9e\xad\x36\x65\x3c\xb3\xb9\x41\xee\xb0\xe9\x3d\x4b\xfc\x76\x3c\x6b\x61\xf8\x46\xf0\x83\xe1\x0c\x1f\xf5\xbc\x10\x25\x04\x6f\x9d\xa1\x98\xe4\xf6\xd4\x16\x69\x00\xf5\x13\x46\x48\x3f\x6d\x49\xbd\xee\xfd\x67\xfc\x70\x40\x25\x96\x7b\x59\x08\x16\x80\x69\xb4\x59\xa2\xdb\x6b\xe1\x59\x8f\x0f\x9f\x9f\x06\xb4\x5a\xaf\xb4\xf3\x93\xd7\xec\x85\x51\x5b\x6d\x4a\xe9\x58\x30\x75\x1e\x08\x7c\xd8\xf5\xe0\x70\x0a\x5b\x89\x85\xf0\x22\x89\x65\xaf\x2a\x5f\xbb\x80\x5f\x51\xf6\x51\xbe\x6d\x5a\x34\x0d\xd2\xae\xf9\x9a\x11\x34\xbd\xec\x55\xb2\xe1\x02\x12\xb8\xe9\x8d\xb7\xed\xf1\xff\x06\x5d\x65\x94\xf7\x71\x1d\xa2\xc0\x02\xdd\xd5\x29\x24\xc5\xd8\xe9\x33\xd4\x7d\xed\xa9\xd0\x39\xef\x8a\x54\x1b\x25\xd8\x1f\x52\x2c\x7c\x58\x52\x5c\x7b\x36\xb8\x93\xd6\xa1\x59\xfa\xbe\x6b\x63\x07\x01\x38\xaf\xfc\xb1\x18\x02\x20\x9e\xa7\x57\x3c\x79\xc2\xc3\xe6\x2b\xe6\x2e\xf3\x5f\xac\x39\x4b\xf2\xce\x41\xdf\x6d\x60\x21\x4d\x22\xdc\xce\xe9\xf1\x5b\xa0\x67\x05\xaa\x9d\xdb\xcf\xe1\xe6\x46\xf6\x15\xee\xcf\x6f\x29\xfa\xd5\xa8\x4e\x9e\xfa\x2c\x5f\xe6\x83\x1d\x2e\xc4\x7d\xe5\x5c\x28\xc8\x77\x93\x20\xd0\xac\x2e\x4f\xda\xb3\x3b\x66\xa6\x55\x5e\xc8\xfc\x95\x2c\x0c\xae\x10\x80\xd8\x11\x16\xb4\x7f\x95\xe9\xb3\x14\x2f\x8d\xa2\x58\x64\x1b\xea\xc2\x0e\x74\x80\x45\x04\x38\xb7\xaf\xe7\x94\x35\x79\xa5\xfd\x28\x85\x06\x36\xbe\x28\xcc\x0f\x47\xca\xe4\x6f\xdf\x44\x85\x86\x25\x85\xe6\x22\x99\x5e\xc7\x1a\xae\x35\x92\xcd\x58\x3d\xe8\x5a\x5a\x16\xdc\xda\xfa\x62\xf2\xe8\xd9\x26\xc0\x93\x94\xce\xf3\xe6\x72\xeb\x6c\x7c\xc3\x79\xa8\xd4\xb7\xbc\x53\xca\xe6\x14\x55\xad\xbb\x74\xbb\x4c\x81\x26\x37\xdf\x03\xc3\xb8\xb8\xbc\x40\x43\xa7\xd0\x5f\x5c\x16\x28\xc0\xe9\xc0\xf3\x27\xe7\x5d\xd0\x19\xf6\xf4\xba\x3a\xd1\x24\xd6\xd3\xff\xe3\xee\xd7\xb8\x99\x3f\x4d\x22\x91\xc7\xec\x3e\xa0\x78\x75\x24\xfb\x4e\xcd\xe9\x50\xff\x97\x4a\x0b\x6a\xa4\xff\x09\xb5\x99\x1e\xff\x07\x00\x00\xff\xff\x70\x18\xe4\xae\xa3\x08\x00\x00")
+var _assets_scripts_job_edit_main_js = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x85\x56\xcb\x72\xd3\x30\x14\xdd\xe7\x2b\xee\xb0\xc0\xf2\xb4\x31\x05\x76\x0d\x81\x99\x16\x18\x0a\x34\xed\x0c\x85\x4d\xa7\x0b\xc5\xba\x49\x44\x1d\x29\x23\xc9\x2d\x19\x26\xff\xce\x95\xfc\x92\x13\xea\x76\x91\xc4\xd2\x39\xf7\x71\xee\xc3\x65\x8b\x52\xe5\x4e\x6a\xc5\x52\xf8\x3b\x1a\x01\x34\xcf\x60\xf9\x03\x7e\xd5\xf3\x70\x0e\xf4\xf7\xc0\x0d\xd8\x7c\x85\xa2\x2c\xa4\x5a\xc2\x14\x84\xce\xcb\x35\x2a\x97\x2d\xd1\x7d\x2a\xd0\xff\x3c\xdb\x5e\x08\x96\x74\xa8\xf1\xc6\xe0\xc2\x26\xe9\x24\x58\xe8\xb1\xbb\x87\x88\x6f\xcf\xb6\x37\x7c\x39\xe3\x6b\x64\x89\x54\x9b\xd2\x35\x54\xef\xfc\x51\x9b\xfb\x8f\xd2\x0c\x79\xf6\x10\x21\x4d\xdf\xad\xe7\xfe\xd6\xf3\xaf\x3f\xae\x66\xc4\xad\x92\x01\xb8\xf8\x78\xfa\xb4\x1d\x82\x8f\xa5\x48\xd2\xec\x81\x17\x25\x1e\xd7\x14\x1f\xd7\x33\x24\x45\x90\x7d\xda\x37\xdc\x3e\xc3\xba\xc7\xed\x3e\xe9\x86\xdb\x7b\x7b\x0a\x8f\x52\x09\xfd\x98\xa1\xca\xb5\xc0\x70\xc6\xd2\x06\x72\xc9\xff\x5c\x28\xeb\xb8\xca\x91\x90\x1b\x6e\x2c\xce\xca\xf5\x2f\x6f\x85\x75\xea\xde\x9e\xdc\x1d\x43\x42\x58\x90\x0d\x38\x69\x4d\x5c\x1b\xa9\x8d\x74\xdb\x01\xfa\x6b\x4f\x6f\x70\x1d\x93\xa0\xe7\xd7\x3f\x07\x78\x6f\x3c\x8f\x20\x91\xb7\x4b\x5c\xff\xb4\x7c\x89\x03\xac\xb7\x21\x58\x5c\x6b\x13\xf9\xfa\x86\xb8\xa1\xc2\x9f\x36\x1d\xf0\x44\xc3\x58\x2c\x30\xa7\x8e\xa1\x8c\xf7\x0b\x80\x9b\x2f\xba\x34\x07\x2a\x0d\xdb\xab\x1b\xb0\x12\xd0\xdb\x80\x95\x37\x92\xa4\xc1\xec\x6e\x32\x0a\xdf\x72\x01\xac\xee\xae\xac\x91\x09\xde\xc3\x09\xbc\x7c\xd9\x74\x5d\x56\x89\x05\xd3\xe9\x34\x9c\xd7\x61\x41\x7b\x1f\x57\xb2\x45\x75\xb7\xb5\x6c\xd5\x4d\xda\x76\xb0\x5b\x19\xfd\x08\x2f\x08\x96\xd8\x78\xb6\xa4\x85\x52\xcd\x75\xa9\x04\x8a\x17\xd5\x08\xec\x46\xed\x24\x2c\xb4\x59\xc7\x23\x94\x1b\xe4\x0e\xeb\xf4\x59\xe2\xaf\xe3\xc1\x09\x22\x0c\xe0\x7b\x53\x1a\x1e\x2a\xed\x89\x12\x82\xb7\xce\x50\x4c\x72\xb1\x6d\x44\xea\x41\xfd\xb8\x10\xd2\x0f\x41\x52\x9d\x7b\xff\x19\xdf\x6c\x50\x89\xf3\x95\x2c\x04\x0b\xc0\x34\xba\x5c\xa3\x5b\x69\xe1\x59\xd7\x57\x3f\x6e\x7a\xb4\x6a\x71\xd1\xcd\x2b\xbf\xbc\xf6\x8c\xda\x72\xbe\x96\x8e\x05\x53\xbb\xde\xa6\xeb\x37\x45\x70\x78\x0c\x0b\x89\x85\xf0\x8d\x10\xef\x3f\x55\x7a\xed\x02\xfe\x82\xb2\x8f\xf2\x6d\xd2\xa2\x6e\x90\x76\xc6\x67\x8c\xa0\xe9\x7e\xad\x92\x39\x17\x90\xc0\x51\x67\xbc\x29\x8f\xff\x34\xe8\x4a\xa3\xbc\x8f\xc3\x10\x05\xf5\xb6\x3b\x58\xc7\x52\x0c\x2d\xc3\xfe\x12\xab\x3c\x15\x3a\xe7\xad\x48\x95\x51\x82\x7d\x90\x62\xea\xc3\x92\xe2\xd0\xb3\xc1\xa5\xb4\x0e\xcd\xb9\xaf\xbb\x36\xb6\x17\x80\xf3\x1b\x69\x28\x86\x00\x88\xfb\x89\x56\x9d\x27\x5c\xcd\x7f\xd3\xac\x66\xfe\x89\xd5\x3b\x2e\x6f\x1d\x74\xd5\x06\x16\xd2\x24\xc2\xc9\x84\xbe\xde\x05\x7a\x56\xa0\x5a\xba\xd5\x04\x8e\x8e\x64\xa7\x30\x6b\x5f\x64\x52\x74\xa7\x91\x4e\x9e\x7a\x2b\xef\x26\xbd\x1b\x2e\xc4\x59\xe9\x5c\x10\xe4\xc9\x24\x08\x34\xae\xe4\x49\x3b\x76\xcb\xcc\xb4\xca\x0b\x99\xdf\x93\x85\xde\xbb\x14\x20\x76\x84\x05\xdd\x1f\x64\x7a\x2b\xc5\x5d\x3d\x51\x2c\xb2\x0d\x95\xb0\xbd\x39\xc0\x22\x02\xec\x9a\x9f\xbb\x94\xd5\x79\xa5\x5d\x2b\x85\x02\xd6\xbe\x28\xcc\x4f\x0f\x94\xc9\x77\x5f\x44\x85\x86\x25\x85\xe6\x22\x39\x3e\x8c\x35\xbc\xdf\x69\x6c\x86\xf4\xa0\xf5\x78\x5e\x70\x6b\xeb\x85\x4b\xe8\xf1\x3c\xc0\xc3\x9a\xac\xdf\xf2\xad\x8d\xff\x38\x0f\x4a\xfd\xcf\x3b\xa5\x6c\xb6\x91\x6a\xed\x7f\x1f\x6d\xa6\x40\x9d\x9b\xaf\x80\x61\x2c\x2e\x2f\xd0\xd0\x16\xfa\xcc\x65\x81\x02\x9c\x0e\x3c\xbf\x39\x4f\xc3\x9c\x61\x47\xaf\xd4\x89\x3a\xb1\xea\xfe\xe7\xab\x5f\xe1\xc6\x7e\x9b\x44\x43\x1e\xb3\xbb\x80\xe2\xd3\x81\xec\xdb\x69\x4e\xfb\xf3\xbf\x3f\x69\x61\x1a\xe9\x73\x44\x65\xa6\xaf\x7f\x87\xb3\x79\x8a\xac\x09\x00\x00")
 
 func assets_scripts_job_edit_main_js_bytes() ([]byte, error) {
 	return bindata_read(
@@ -316,7 +396,27 @@ func assets_scripts_job_edit_main_js() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/scripts/job_edit/main.js", size: 2211, mode: os.FileMode(420), modTime: time.Unix(1478639774, 0)}
+	info := bindata_file_info{name: "assets/scripts/job_edit/main.js", size: 2476, mode: os.FileMode(420), modTime: time.Unix(1792350170, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assets_scripts_live_task_main_js = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x75\x52\xbb\x6e\xc3\x30\x0c\xdc\xf3\x15\xdc\x2c\x23\x89\x90\xce\x69\x0a\xb4\x41\x80\x0e\x45\x3b\x64\x2c\x3a\x28\x16\x6d\xab\x55\xa4\xc0\x92\xf3\x40\xe1\x7f\x2f\xe5\x57\xdc\x3c\x3c\xd8\x92\x79\x47\xf2\x78\x64\x69\x69\x12\xaf\xac\x61\x31\xfc\x8e\x46\x00\xdd\x1d\xbc\xcd\x32\x8d\x6b\x5f\xa0\xd8\xb2\x4d\xe9\xbd\x35\x01\x02\xf4\xec\x45\x01\xae\x0e\xc0\x02\x9a\x10\xcf\xd0\x3f\x7b\x5f\x28\xba\x22\x8b\xa4\xf0\x62\xda\x40\xa2\x78\xde\x93\x9a\x9c\x4b\x2d\x9c\x23\x66\x94\x2b\x89\x2d\x6a\x1a\xc1\xb8\xcd\x79\x86\x27\x01\x88\x01\x2a\x6d\x52\x6e\xd1\x78\xbe\xb1\xf2\xc4\xeb\xff\xef\x62\x8b\xdc\xed\xb4\xf2\x2c\x82\x61\x11\x25\x8f\xc4\x68\xb9\x5c\x19\x89\xc7\x8f\x94\x0d\x4a\xb7\x58\x95\x02\x0b\xd8\xa7\x05\xcc\x3a\x65\xd0\xf3\x42\xe6\x04\x03\x60\x02\x0f\x2d\x03\x3a\xb1\x1e\x8f\x7e\x69\x8d\xa7\x8e\x82\x8e\x57\xd2\x01\x97\x02\x2a\x40\xed\xf0\x2a\xef\xae\x74\xf9\x8d\x66\xee\xa5\x5e\xe7\xf6\x70\x9d\xba\x7e\xdf\x99\xc9\x40\xfb\xb7\x55\xa6\x1f\x4e\x15\xdc\x3d\xd0\x38\xec\x81\x0b\x29\x57\x7b\xa2\xbe\x29\x47\x85\xb0\x60\x91\xb6\x42\x46\x13\xf8\xb7\x0d\xdd\x40\x9b\xce\xfe\xd9\x40\x6e\xaf\x34\x86\xa3\x7b\x39\x2d\xbb\xd2\x2c\x6a\x84\x5d\x38\x9f\xda\x02\x58\xed\x0c\xa5\x98\x4d\x40\xa3\xe9\xf7\xc6\x71\xba\x65\x3e\x9f\x53\xf0\x31\x44\xe6\x30\x1e\xab\xb3\x1d\x2d\xea\x53\x7d\xdd\x68\x3a\x21\x8b\x7e\xa8\xeb\xe1\xaa\xf2\x0d\x49\x64\xa6\xd4\x7a\x32\x20\xc7\xf1\x79\x72\x15\x9d\x47\x55\xcc\xe8\xf3\x07\xe1\x5c\xe4\x0d\xfe\x02\x00\x00")
+
+func assets_scripts_live_task_main_js_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_scripts_live_task_main_js,
+		"assets/scripts/live_task/main.js",
+	)
+}
+
+func assets_scripts_live_task_main_js() (*asset, error) {
+	bytes, err := assets_scripts_live_task_main_js_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/scripts/live_task/main.js", size: 766, mode: os.FileMode(420), modTime: time.Unix(1792347267, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -361,7 +461,27 @@ func assets_scripts_slaves_main_js() (*asset, error) {
 	return a, nil
 }
 
-var _assets_slave_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x56\x5d\x4f\xdb\x3c\x14\xbe\xe7\x57\xf8\xb5\xd0\xcb\x26\xad\x64\xd7\x5b\x52\x84\x80\x89\x22\xb6\x8b\xb1\x3f\xe0\xd8\xa7\xad\x87\x63\x47\xb1\xd3\x81\xa2\xfc\xf7\x1d\x3b\x1f\xcd\x17\x30\xed\x62\x5c\x94\xfa\x7c\x3e\x7e\xce\xc9\xd3\x54\x95\x80\xad\xd4\x40\xa8\x55\xec\x00\xb4\xae\x4f\xe2\xff\x84\xe1\xee\x39\x07\xb2\x77\x99\x5a\x9f\xc4\xcd\x3f\x42\xe2\x3d\x30\xe1\xbf\x10\x52\x55\x0e\xb2\x5c\x31\x87\x89\xde\x7d\x8b\x1e\x28\x28\xa1\x0f\x5d\x15\x0c\x8f\xba\xf8\x38\x35\xe2\x79\x9e\xa8\xd9\xa1\xcf\x0b\xdd\xad\x4f\x0c\x61\xb1\x90\x07\xc2\x15\xb3\x36\xa1\x4a\x5a\x47\x9b\xec\xb1\x23\x67\x1a\x7a\xc7\xb8\xb4\xc0\xcf\x2f\x12\x94\xa0\x24\x67\xb2\x20\xf4\xca\x68\x0d\xdc\x01\x1a\xce\xbf\x32\xeb\xa0\x38\x7f\x70\xac\x70\x3f\x64\x06\x01\x6d\x57\x43\x6e\x89\x36\xae\x0f\xfa\x5e\x6a\x2d\xf5\x6e\x10\xf2\x46\xa3\x6b\x69\xf9\xbc\xd7\x8d\x16\xb3\x4e\xa0\xc5\xe8\x7c\x2c\x1a\xc8\xd8\xe8\xad\x09\x95\x2d\x96\x99\x42\xec\xea\x5e\x72\x0e\xb9\x9b\x02\x9c\x92\xb4\x4a\x4b\xe7\x8c\xc6\x42\x08\x97\xad\x38\x68\xcc\x4d\xa8\x2b\xca\x21\x7f\x21\xb3\x89\x24\x46\x73\x25\xf9\x23\x92\x6f\x38\x73\xd2\xe8\xe4\x2c\xb2\xfb\xd2\x09\xf3\x4b\x5f\x48\x91\x54\xd5\xf9\xe6\xba\xae\xcf\xe8\x28\xbb\xfb\x6b\x5b\x0b\x50\xe0\xba\xe6\x74\x7d\xcb\x94\x8b\xa3\xe6\x34\xec\x1a\x47\x08\x77\xfd\x02\x31\x23\xe7\x9b\x57\x7f\x65\x3b\xc6\x0c\x67\x60\x2d\xdb\x75\x93\xa3\x97\xa5\x33\x19\xde\x93\x13\xcb\xf7\x20\x4a\x85\x65\xe9\xbf\xa0\x14\x1c\xc3\xd6\x17\xfe\x03\x39\x0d\x8b\xe7\xb1\xd4\xf5\xff\x2c\xcb\x3f\x0f\x89\x5e\x4f\x98\xae\xaa\x15\xf1\x6c\xf8\x70\xb2\xaa\x6b\x82\x8b\xc7\x52\x05\xc1\x01\xca\x42\x30\xde\xe8\xa3\x4d\x0b\x6f\x1a\x63\x7b\x7b\x1c\xed\xf1\xcf\x28\x8e\xb7\xa6\xc8\x08\xe3\xe1\x7a\x34\x52\xac\xd4\x7c\x3f\xe5\x43\xea\xbc\x74\xc4\x0b\x4c\x42\xf7\x52\x08\xd0\x94\x68\x96\xe1\xa9\x91\x20\x72\x60\xaa\xc4\x53\x7b\xf5\x69\xfa\xa0\xbf\xc5\x05\xe3\x6e\xb5\x0d\x53\x9c\x12\x14\x2b\x96\x82\xea\x42\x43\xcc\x2a\x98\xe8\xfa\x3e\xe0\x22\x8e\xd9\xc7\x38\x0a\xb6\x59\xf2\xa0\x4b\x93\x1a\x40\xcd\x9a\x60\x64\x03\xa2\xbd\xc1\x4f\x93\x2e\xc4\xf8\x69\x15\x4c\xef\x00\xc7\xa5\xd4\x9d\x49\xed\x64\x0e\x7d\x31\x93\x7b\xea\x66\x0c\xe0\x97\x6f\xcc\xcb\x47\x1c\x35\x11\xcb\x3d\xc6\x8a\xd2\x17\x8d\x1a\x88\xb3\x3b\x8e\x07\xfd\x92\xe9\xaf\x17\x7f\x32\x6a\x5b\xa6\x99\x74\xfd\x74\xef\x17\x77\x63\xda\x3f\x8e\xfc\x46\xcd\xb6\x71\xe9\xc2\x55\x75\xda\xe8\xe6\x35\xf9\x94\x90\x40\xdc\xd1\x83\x73\xb9\x32\xa5\x76\xc1\xd5\x0a\xc8\x5d\x6b\x1b\xc4\xe1\x03\xd5\x87\x1e\x25\x68\xca\xc0\x8e\xe5\x74\xfd\x2a\x92\x66\xd8\xa7\x05\x1c\x36\x1f\x42\x45\xdf\x17\x4f\x50\xe0\x73\xf9\x6e\x00\xc0\x92\x8f\xc7\x8e\xef\x47\x2a\x7f\x2a\x07\x49\x1b\x2d\xe0\xa9\x29\xb8\x80\x70\xe1\xa9\x6c\xa7\xe3\x15\xc7\x2b\x40\x3b\xa0\xf1\x7c\x96\x14\x09\x6b\x5f\x04\x1a\x93\x23\x9f\xbd\x1a\x3d\x79\xa3\x9c\xaa\xd1\x50\x57\x95\x3c\x00\x5e\xab\xfb\xdd\xf2\x50\x87\x28\x5f\xe2\xac\x77\xa0\x20\x85\x57\x05\x7c\x77\x08\x2f\x1d\x5d\xd0\xef\x00\x00\x00\xff\xff\xb9\x82\x16\x23\xa7\x08\x00\x00")
+var _assets_settings_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x55\xdb\x8e\xda\x30\x10\x7d\xe7\x2b\xa6\x7e\x6a\x1f\x20\x3f\x10\x90\xaa\x56\x55\x57\x6d\xb7\xab\x5d\x7e\xc0\xe0\x81\x58\xeb\xd8\x51\x3c\x81\xa2\x88\x7f\xef\xd8\x21\xd4\x09\x0b\xdb\x8b\xfa\x92\xcb\xcc\x9c\xb9\x9d\x13\xa7\x6d\x15\x6e\xb4\x45\x10\x1e\x89\xb4\xdd\x7a\x71\x3c\x4e\xf2\x37\xca\xad\xe9\x50\x21\x14\x54\x9a\xc5\x24\xef\x6e\x00\x79\x81\x52\x85\x07\x80\xb6\x25\x2c\x2b\x23\x89\xb1\xc1\xfd\x99\x3d\x58\x0b\x10\x4f\x49\x22\x46\x64\x3d\x24\x5f\x39\x75\xb8\xc4\x5a\xb9\x3b\x43\xd3\x1e\x62\x60\xae\xf4\x0e\xd6\x46\x7a\x3f\x17\x46\x7b\x12\x1d\x7e\xe8\xa8\xa4\xc5\xb3\x63\x98\xbc\x44\xef\xe5\x16\x3f\x69\x34\x2a\xb6\xe6\xbd\x76\x16\x9e\xf1\x70\x6a\xef\x12\xa2\xf8\x7a\x8a\xaf\xa4\xae\x41\x7c\x95\x9e\xa0\x76\xc4\x76\xb6\xcd\xbe\x30\xf6\x9b\x53\x4b\x5d\x62\x92\x21\xdf\xb8\xba\x04\xb9\x26\x4e\x3f\x17\x59\x17\x1e\xcb\x40\x89\x54\x38\x35\x17\x0f\xdf\x9f\x96\x49\x9b\x97\x33\x4c\x57\x0d\x91\xb3\x0c\xe1\x1e\xe4\x74\x8d\x96\xb0\x9e\x0b\xaa\x1b\x1c\xe0\x18\xa9\x6d\xd5\x10\x04\x86\xe6\xc2\x37\xab\x52\x93\x80\x9d\x34\x0d\xbf\x3e\xc6\xd2\xc3\x42\x19\x57\xfa\x65\xc8\xb3\xd0\xec\x79\x93\x9d\x73\xd2\x6f\x42\x6f\x60\x76\x8f\xfb\xa5\x7b\x46\x9b\x0e\x78\x7d\xe1\x37\x57\xce\xa9\xe0\xfd\xc3\x1d\x50\xc8\x07\x6f\x35\xc1\x5e\x1b\x03\xd6\x11\xac\x10\x7c\xe1\xf6\x16\xe4\x56\x6a\xfb\x2e\x25\x84\xeb\x55\x35\xf6\xf5\x64\xa5\xa7\x11\x2f\x16\x6d\x9b\x34\x97\x67\x1c\x94\xce\x95\x8c\xd9\xb6\x68\x55\x2f\xa3\x9b\xed\x8f\xa8\x93\x4a\x75\xb5\x7e\x8f\x38\xc2\x1f\x34\xdd\xc4\x61\x47\x1c\x19\xb9\x42\xd3\x87\xc5\x88\x69\x34\x89\xc5\x60\x27\x79\x16\xad\x23\x70\x52\xa1\x83\x46\x76\x47\x25\xce\x42\xb0\xb2\x64\xe6\xc3\x95\x45\x6b\xe4\x1a\x0b\x67\x54\xd0\xce\x7d\x30\x8d\x52\x0f\xc5\xf0\x92\xe1\xbf\xe8\xf2\x43\x8d\xff\xa4\xcb\x5a\xda\x2d\xc2\x2c\x52\xef\xff\x46\x98\x71\xcd\x83\x2f\x7b\xd9\xf1\x3c\x0b\x5b\x1a\x88\xef\xe6\x79\xd0\x0d\x12\x8e\x82\xd3\xd3\x50\xb7\xa3\x83\x00\x77\x5c\xe4\x35\x41\x8d\x36\x57\x68\xa5\x42\x7c\x47\xab\x56\xe7\x1d\xb2\xfa\xef\x3e\x1e\x8f\xe2\xba\x58\xfe\x8c\xaf\x70\x28\xc7\xd8\x11\x65\xa7\x64\x0a\x0d\x52\x9f\x4e\x2c\x1e\xe3\x2c\x79\xd6\xbd\xbf\xaa\xaa\x94\xcc\x2b\x1f\xe7\xc0\xc1\x89\xe3\x0f\x82\xff\x18\xf1\x6f\xd3\x07\xfd\x04\x2d\x78\xf0\x04\xa3\x06\x00\x00")
+
+func assets_settings_html_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_settings_html,
+		"assets/settings.html",
+	)
+}
+
+func assets_settings_html() (*asset, error) {
+	bytes, err := assets_settings_html_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/settings.html", size: 1699, mode: os.FileMode(420), modTime: time.Unix(1792353754, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assets_slave_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbd\x56\x5b\x4f\xdb\x30\x14\x7e\xe7\x57\x78\x16\x1a\x9b\xb4\x92\x3d\x6f\x69\x11\x03\x26\xca\x60\x0f\x63\x7f\xc0\xb5\x4f\x5b\x0f\xc7\x8e\x62\xa7\x80\xa2\xfc\xf7\x1d\x3b\x97\xe6\xc6\x45\x48\x5b\x1f\xd2\xf8\xdc\xcf\xf7\x9d\x9c\xa4\x28\x04\xac\xa5\x06\x42\xad\x62\x3b\xa0\x65\x79\x10\xbf\x13\x86\xbb\xc7\x14\xc8\xd6\x25\x6a\x71\x10\x57\x7f\x84\xc4\x5b\x60\xc2\xdf\x10\x52\x14\x0e\x92\x54\x31\x87\x8e\x5e\x7d\x89\x1a\xc8\x28\xa1\xb7\x4d\x14\x34\x8f\x1a\xfb\x78\x65\xc4\xe3\xd8\x51\xb3\x5d\xeb\x17\xb2\x5b\xef\x18\xcc\x62\x21\x77\x84\x2b\x66\xed\x9c\x2a\x69\x1d\xad\xbc\xfb\x8a\x94\x69\x68\x15\xfd\xd0\x02\xaf\xdf\x25\x28\x41\x49\xca\x64\x46\xe8\x99\xd1\x1a\xb8\x03\x14\x1c\xdf\x30\xeb\x20\x3b\xbe\x75\x2c\x73\xbf\x65\x02\xa1\xda\x26\x86\x5c\x13\x6d\x5c\x6b\xf4\x2b\xd7\x5a\xea\x4d\xc7\xe4\x85\x44\xe7\xd2\xf2\x71\xae\x0b\x2d\x46\x99\x40\x8b\xde\x79\x1f\x34\x80\xb1\xd4\x6b\x13\x22\x5b\x0c\x33\x2c\xb1\x89\x7b\xca\x39\xa4\x6e\x58\xe0\x10\xa4\xd9\x2a\x77\xce\x68\x0c\x84\xe5\xb2\x19\x07\x8d\xbe\x73\xea\xb2\xbc\x8b\x5f\xf0\xac\x2c\x89\xd1\x5c\x49\x7e\x87\xe0\x1b\xce\x9c\x34\x7a\x7e\x14\xd9\x6d\xee\x84\xb9\xd7\x27\x52\xcc\x8b\xe2\x78\x79\x5e\x96\x47\xb4\xe7\xdd\xfc\xea\xd4\x02\x14\xb8\x26\x39\x5d\x5c\x32\xe5\xe2\xa8\x3a\x75\xb3\xc6\x11\x96\xbb\x78\x02\x98\x9e\xf2\xc5\xd6\x9f\x99\x8e\x3e\xc2\x09\x58\xcb\x36\x0d\x73\xf4\x34\x77\x26\xc1\x3e\x39\xb1\x7c\x0b\x22\x57\x18\x96\xfe\x0f\x48\xc1\x31\x4c\x7d\xe2\x2f\x88\x69\x18\x3c\x5f\x4b\x59\xbe\x67\x49\xfa\xb5\x0b\xf4\x62\x80\x74\x51\xcc\x88\x47\xc3\x9b\x93\x59\x59\x12\x1c\x3c\xb6\x52\x10\x14\xa0\x2c\x04\xe1\x85\xde\xcb\xb4\xf0\xa2\x7e\x6d\x2f\xd3\x51\x1f\x5f\x07\x71\xbc\x36\x59\x42\x18\x0f\xed\xd1\x48\xb1\x5c\xf3\xed\x10\x0f\xa9\xd3\xdc\x11\xbf\x60\xe6\x74\x2b\x85\x00\x4d\x89\x66\x09\x9e\xaa\x15\x44\x76\x4c\xe5\x78\xaa\x5b\x1f\xba\x77\xf2\x5b\x1c\x30\xee\x66\xeb\xc0\xe2\x10\xa0\x58\xb1\x15\xa8\xc6\x34\xd8\xcc\x82\x88\x2e\xae\x43\x5d\xc4\x31\x7b\x17\x47\x41\x36\x72\xee\x64\xa9\x5c\x43\x51\xa3\x24\x68\x59\x15\x51\x77\xf0\xc7\xac\x26\x6c\x3c\x5b\x19\xd3\x1b\x40\xba\x94\xba\x32\x2b\x3b\xe0\xa1\x0d\x66\x52\x0f\xdd\x08\x01\xbc\xf9\xc9\xfc\xfa\x88\xa3\xca\x62\x3a\x47\x7f\xa3\xec\x19\xac\x4a\x1c\xf5\xd8\x27\xfa\x29\xd1\x9b\x07\x7f\x40\xb5\xcd\x57\x89\x74\x2d\xbb\xd7\x93\xb3\x31\xcc\x1f\x47\x7e\xa2\x46\xd3\x38\xd5\x70\x6f\x39\x8c\xd7\xf6\x5b\x57\xc3\x0f\x5c\x32\x04\x69\x25\x42\x66\x08\xa2\xc9\x64\xf5\x9a\xfa\xd7\xab\xe1\xde\x64\x77\x98\xd2\x9e\x84\x87\xa2\xb3\x07\xbe\x65\xe6\xde\xc2\xeb\x9f\xdc\x69\xac\x0e\xab\x77\xcc\x39\xf9\x32\x27\x21\xf2\x5e\x83\xcd\x9e\x99\x5c\xbb\xa0\xaa\xf1\xbc\xaa\x65\x7d\xb4\x5b\xd3\xfd\xba\x1e\x62\xb1\x61\x29\x5d\x3c\x5b\x49\xf5\x60\x1c\x66\xb0\x5b\x7e\x0a\x11\x7d\x5e\x3c\x41\x86\x3b\xec\x43\xa7\x00\x4b\x3e\xef\x33\x7e\xec\xbd\x11\x0f\x65\xc7\x69\xa9\x05\x3c\x54\x01\x27\x2a\x9c\x98\x84\x9a\x27\x4f\x81\xdf\x96\x35\x55\xfd\x59\x9e\xa2\x08\x63\xb7\xec\x34\x78\xb6\x9b\xfb\xc1\x0b\xe5\x70\x73\x77\x07\x4d\xc9\x1d\x60\x5b\xcd\x3b\xde\x97\xda\xad\xf2\x29\xcc\x5a\x05\x8e\x40\xf8\xac\xc2\xef\xac\xf0\x81\xd6\x18\xfd\x05\x1b\xa1\x1f\x99\xd3\x09\x00\x00")
 
 func assets_slave_html_bytes() ([]byte, error) {
 	return bindata_read(
@@ -376,12 +496,12 @@ func assets_slave_html() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/slave.html", size: 2215, mode: os.FileMode(420), modTime: time.Unix(1475799492, 0)}
+	info := bindata_file_info{name: "assets/slave.html", size: 2515, mode: os.FileMode(420), modTime: time.Unix(1792350158, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _assets_slaves_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x54\xed\x6e\xe2\x3a\x10\xfd\xdf\xa7\x98\x6b\x51\xb5\x95\x6e\xe0\xff\x15\x70\xc5\xe5\x6a\x97\xae\x44\xa9\xca\xee\x03\x98\x78\x20\xee\x3a\x76\x64\x3b\xb4\x28\xe2\xdd\x77\xe2\x7c\x40\x42\x57\x4b\x7f\x25\x64\xce\x99\x39\x73\x66\x86\xa2\x10\xb8\x95\x1a\x81\x39\xc5\xf7\xe8\xd8\xf1\x78\x33\xfe\x4b\x98\xd8\x1f\x32\x84\xc4\xa7\x6a\x7a\x33\xae\x1e\x00\xe3\x04\xb9\x28\x5f\x00\x8a\xc2\x63\x9a\x29\xee\x89\x59\x86\x17\x14\x41\xcb\x80\xad\xdb\x34\x01\x37\x76\xb1\x95\x99\x07\x67\xe3\x09\xe3\xce\xa1\x77\xa3\xea\x13\x3d\x03\x74\x94\x72\xa9\x87\xaf\x8e\x4d\xc7\x75\x24\x94\x1a\x35\xb5\xc6\x1b\x23\x0e\x97\x45\x35\xdf\xb7\x35\x4f\xd2\x2b\x94\xdc\xc2\x20\xe5\xce\xa3\x75\xf0\xcf\x04\x9a\xd7\x61\x0d\xa0\x9c\x42\xee\x21\x56\xa4\x67\xc2\x76\x56\x0a\x36\xad\x03\x97\xa1\x28\x09\x55\x40\x49\xe7\xcf\x60\x5d\x60\xc6\x35\x76\x82\x97\xe1\x68\x93\x7b\x6f\xb4\x63\x20\xb8\xe7\x51\x8c\x9a\x34\x4d\x98\xb7\x79\x9f\x59\xb6\x1c\xb0\x20\x05\x01\xcc\x6e\xa7\x30\x72\x89\x79\xe3\x4a\xb1\xe9\x42\x0a\x84\xff\x8d\xc6\xf1\xa8\x42\xf5\xca\x8e\xa8\x6e\x47\x66\xf7\x43\xef\x67\x51\x58\xae\x77\x08\x03\x8b\xfb\xc7\xbf\xc9\xb5\xd2\x2f\x7a\x27\xbb\xb0\xf5\xb0\xb5\xad\x62\x0c\xe4\x19\xe8\x51\x0b\x7c\xaf\xe8\x70\xaf\x50\xb7\xa4\x87\x0e\xab\xef\x46\x67\x94\x61\x7c\x2f\xb9\xd6\x52\xef\xe6\x25\x86\x95\xa3\x62\x3d\x53\x6a\xdf\x94\x8c\x7f\xf2\x8d\xc2\xda\x3a\x30\x3a\x7c\x9a\x30\x65\x62\xee\xa5\xd1\x93\xbb\x6a\xb1\xfe\x25\xf7\x4a\xb1\xc7\xe3\x5d\xcf\xe0\x8b\xda\x8f\x7a\x6b\xbe\x48\x54\x82\x2a\x0f\xd2\xae\xf0\xbe\x5f\xa8\xc5\x69\x8d\x4e\x41\x0a\x28\x87\xdd\x05\x2b\xc7\xa7\x4d\x54\x6f\x67\xd3\x3e\x95\xf6\x87\xa8\xda\x98\x27\x03\xd5\xbd\x74\x33\xd5\x25\x68\xc2\x61\xf5\xe9\x16\xc2\x01\x36\x91\x9b\xde\xd1\x76\xac\x0b\xcc\xa2\x88\x80\x8e\x60\xb8\x0c\xb3\x18\xd6\x00\x88\x6a\x7d\x81\x15\x24\x44\xb6\x0a\xd5\x9c\xb2\x87\x8f\x50\xda\xf8\x3e\x52\x8b\x00\xfc\x9d\xa6\x33\x4b\x6b\x45\x6f\xd2\x27\xad\xa2\x75\x83\x69\x6f\xf6\x34\x12\xc5\x37\xa8\x02\x97\x41\xc6\xa5\x05\x36\x7f\xfe\x41\xfe\xdd\x67\x56\x6a\xbf\x05\x76\x2b\xe0\xde\x6c\xe1\x56\x3c\xb0\x32\xe1\xfb\xb3\x35\x31\x5d\xf7\x53\x9e\x12\xf0\xe1\xaa\x8c\x4b\x4c\x8d\x3d\x74\x73\x2e\xe5\x7f\x94\xef\xbb\xf1\x5c\x51\xf8\xba\x3c\x5f\x57\xab\x35\x91\x56\xeb\x2b\xd1\xb3\x97\xf9\x82\xf0\x33\x1b\x27\xb5\x2d\xb5\x7d\x7f\xa2\x06\x59\xf0\x6a\x36\x8e\xb5\x26\x7e\x33\x9b\xb9\xc9\xb5\x6f\xf8\xe5\xc4\x67\xb9\xbf\xce\xd3\x75\x9c\xa0\xc8\x15\x4d\x94\xfe\x40\x4b\x56\x33\xa7\xb3\x3d\xfe\x44\x86\x25\xd7\x39\x57\xec\xb2\xa9\xb3\x35\x9c\xc5\x31\x66\x9e\xf0\xd7\xa5\xf7\xdc\xe7\xd4\x2d\x5b\x69\xaa\x81\xe7\xf2\x3e\xd8\xed\xcf\xa5\x5c\x70\x55\xea\xf8\x7c\xcb\x4d\x82\x75\x92\x7b\x61\xde\x74\xb7\xe1\xe6\xf9\x2b\x00\x00\xff\xff\x00\x10\x90\x2a\x55\x07\x00\x00")
+var _assets_slaves_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x55\x61\x6f\xda\x30\x10\xfd\xde\x5f\x71\xb3\x5a\xb5\x95\x16\xf8\x3e\x05\x26\x46\xb5\xd1\x69\x14\x54\xba\x1f\x60\xe2\x83\xb8\x35\x76\x64\x3b\x74\x28\xe2\xbf\xcf\x76\x12\x48\xa0\xdd\xd2\x4f\x49\x73\xef\xde\xbd\x7b\x77\x47\x8b\x82\xe1\x8a\x4b\x04\x62\x04\xdd\xa2\x21\xfb\xfd\x45\xfc\x89\xa9\xc4\xee\x32\x84\xd4\x6e\xc4\xf0\x22\x2e\x1f\x00\x71\x8a\x94\xf9\x17\x80\xa2\xb0\xb8\xc9\x04\xb5\x2e\xd3\x87\x27\x2e\x82\x9a\x00\x59\x1c\x68\x02\x2e\x36\x89\xe6\x99\x05\xa3\x93\x01\xa1\xc6\xa0\x35\xfd\xf2\x93\x7b\x06\x68\x7f\x43\xb9\xec\x3d\x1b\x32\x8c\xab\x48\x28\xd5\xaf\x6b\xc5\x4b\xc5\x76\xe7\x45\x25\xdd\x1e\x6a\x1e\xa5\x97\x28\xbe\x82\xcb\x0d\x35\x16\xb5\x81\x2f\x03\xa8\x5f\x7b\x15\xc0\x71\x32\xbe\x85\x44\x38\x3d\x03\xb2\xd6\x9c\x91\x61\x15\x38\x0f\x45\x69\xa8\x02\x82\x1b\xdb\x80\xb5\x81\x19\x95\xd8\x0a\x9e\x87\xa3\x65\x6e\xad\x92\x86\x00\xa3\x96\x46\x09\x4a\xa7\x69\x40\xac\xce\x4f\x33\x7d\xcb\x01\x0b\x9c\x39\x80\x5a\xaf\x05\x46\x26\x55\xaf\x54\x08\x32\x9c\x70\x86\x70\xa7\x24\xc6\xfd\x12\x75\x52\xb6\xef\xea\xb6\x64\xb6\x3f\x9c\xfc\x59\x14\x9a\xca\x35\x82\xc6\xad\x73\x08\x0f\xb6\x1d\x9c\x7a\xab\x95\xd6\x1c\x82\xf7\x8f\xb9\x94\x5c\xae\xc7\x1e\x43\xbc\xcf\xe4\xa4\xa3\xaa\x69\xc1\x93\x17\xba\x14\x58\xf5\x0d\x4a\x86\x4f\x03\x22\x54\x42\x2d\x57\x72\x70\x5d\x6e\xc5\x57\xd7\x7a\x51\xf4\xa6\x41\x4d\xef\xfe\x6e\xbf\xbf\x3e\x71\xe9\x4c\xc3\xbd\x5c\xa9\xef\x1c\x05\x2b\x15\xbc\x6f\x41\x51\xa0\x64\xc7\x55\x38\x06\x5d\x40\x18\x6c\x2f\x89\x1f\x81\x54\x51\xb5\x61\xb5\x0b\xae\xb2\xdd\x45\xe5\xd4\x1f\x14\x94\x3b\xdf\x66\xaa\x4a\xb8\x29\x85\xf5\x75\xfb\x1c\x8e\xa8\x8e\xb8\x97\xd6\xe1\xb5\x1c\x0c\x99\x45\x11\x81\x5b\xe4\xda\x83\x0a\x00\x51\xa5\x2f\x64\x05\x09\x91\x2e\x43\x55\x8e\xef\xe1\x2d\x94\x54\xf6\x14\x29\x59\x00\xbe\xa7\xa9\xe1\x68\xa5\xe8\x95\xdb\xf4\xa0\x68\x51\x63\x9a\x77\xd7\x7b\xa0\x9b\xa3\x85\xcd\x21\x09\xba\x44\x11\xe8\x08\x64\x94\x6b\x20\x1e\x4a\x5a\x19\xcd\xd1\xfc\x3b\x77\x3c\xff\xed\xc6\x71\x93\x69\x2e\xed\x0a\xc8\x15\x83\x1b\xb5\x82\x2b\x76\x4b\xbc\xbe\x3f\x73\xad\x12\x77\xf0\x0f\xf9\xc6\x01\x6f\x3b\x31\x4e\x71\xa3\xf4\xae\xcd\x39\xe5\xdf\x1c\xdf\x93\xb2\x54\xb8\x70\x37\x9e\x1f\xb3\xd9\xc2\x25\xcd\x16\x1d\xd1\xa3\xc7\xf1\xc4\xe1\x47\x3a\x49\x5b\x46\xce\x11\xf5\x5c\x69\xdb\xd1\x4c\x0f\x87\xcc\xe1\xc9\x59\x6a\xdb\xd5\xf2\xe0\x2f\x5f\x70\xf7\x19\x2e\xb7\x54\xe4\xe8\x7f\x23\x7b\xbf\x3c\xa3\xe9\x56\xcc\x27\x57\xb9\x67\x25\x0e\xcb\xf4\x3f\xc1\xc1\x55\x78\x56\x4b\x43\x0e\x2b\xf5\x53\x2d\xc7\x2a\x97\xb6\xce\xf7\x3e\x8c\x72\xab\x3a\x59\xb9\x48\x52\x64\xb9\x70\xfb\xed\xfe\x25\xf8\xac\x7a\x6b\x1b\x57\xfd\x01\x86\x29\x95\x39\x15\xe4\xbc\xa9\xc6\x51\x8e\x92\x04\x33\xeb\xf0\xdd\xe8\x2d\xb5\xb9\xeb\x96\xcc\xa4\xab\x81\x4d\x79\x6f\x5c\xfa\xc7\x28\x27\x54\x78\x1d\x1f\x6f\xb9\x26\x58\xa4\xb9\x65\xea\x55\xb6\x1b\xae\x9f\x7f\x01\xea\xfa\x6d\xd0\x27\x08\x00\x00")
 
 func assets_slaves_html_bytes() ([]byte, error) {
 	return bindata_read(
@@ -396,7 +516,7 @@ func assets_slaves_html() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/slaves.html", size: 1877, mode: os.FileMode(420), modTime: time.Unix(1475953436, 0)}
+	info := bindata_file_info{name: "assets/slaves.html", size: 2087, mode: os.FileMode(420), modTime: time.Unix(1792354359, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -441,7 +561,7 @@ func assets_styles_src_document_less() (*asset, error) {
 	return a, nil
 }
 
-var _assets_styles_src_fields_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x54\x5d\x8f\xda\x30\x10\x7c\xe7\x57\x58\x48\x27\x1d\x0f\xae\x02\xd5\xa9\x55\x50\x25\xfe\x47\xd5\x07\x63\x6f\x12\x0b\x63\x47\xb6\x73\x40\x2b\xfa\xdb\xbb\xfe\xe0\xec\x1c\x47\xa5\x7b\x41\x64\x77\x32\x33\x3b\xf6\x66\x27\xf5\x38\x79\x3a\x80\xec\x07\xdf\x92\xaf\xcd\x78\xde\x2e\x76\x9d\x04\x25\xa8\x83\x91\x59\xe6\xa5\xd1\xd8\x08\xf5\x45\x04\x93\x3f\x0b\x42\xf6\xc6\x0a\xb0\x2d\x59\x8f\x67\xe2\x8c\x92\x82\xec\xfc\x00\x47\xa0\xdc\x28\x63\xb7\x88\xb8\x51\xce\x14\x42\xa3\x33\xda\x53\x27\x7f\x03\xbe\xfd\x3d\xd0\x06\xb6\x73\xa8\x48\xdd\xb7\x99\x99\x62\x29\x74\x46\x26\x44\x2c\x37\xe4\x25\x60\xaf\xd9\xc4\x4f\x7f\x19\xe1\xc7\x92\x0f\xc0\x0f\x08\x5d\xfe\x8a\xae\x6e\x9a\x6c\xf2\xa6\x60\xdb\xce\xf0\xc9\x45\x80\x99\xbc\x92\x1a\x95\x9b\xd8\xfe\x32\x1b\xd4\xd8\x19\xc9\x3a\x7b\x63\xfc\xd0\x5b\x33\x69\x91\x66\x6b\xef\x26\x3d\x32\xdb\x4b\xcc\xe8\x1b\x66\x51\xf3\x3e\xef\x12\xd5\x2a\xd2\x9e\xa4\xf0\x03\xb2\x36\xcd\xd3\x2c\x9e\x5b\x30\x58\x53\x6c\x0f\x2a\x82\xf1\x3f\xda\xa4\x77\xa0\xd0\xf9\xa0\x78\x8d\xa2\x47\x70\x8e\xf5\x40\xa3\x78\xa4\xc9\x3e\x36\x18\xf3\x2a\x2a\xe4\x81\x5f\x99\x9a\x20\x0b\x75\xca\x30\x64\xd3\x46\x43\xe2\xf7\x70\xf6\x94\x29\xd9\xe3\x48\x1c\xb4\x07\x9b\xea\xef\x06\x20\x44\x48\x37\x2a\x76\x69\x89\xd4\xd1\xed\x5e\x19\x7e\x48\xad\x74\xc6\xfe\xa2\x30\x6a\xe9\x91\x8c\x17\x9b\x0e\x14\x70\x7f\xef\x32\xdc\xbd\x87\x2e\x67\x71\xa4\x5b\x1a\xf8\xf0\x27\xd1\x65\xd8\xed\x28\x9a\x64\xa3\xdc\x9e\x22\x1f\x43\xfe\x64\x46\xe6\x15\x2c\xe6\x74\x6a\xc9\x20\x85\x00\x5d\x05\x55\x5a\xa0\x94\x1c\x9d\x74\x39\xad\x41\x7a\xa0\x6e\x64\x1c\x42\xb8\x27\xcb\xc6\xe2\x21\xad\x44\xbe\x24\xe9\x21\xa7\x1b\x6e\xee\xea\x83\x54\xca\x68\xeb\x26\x5d\x33\x2c\x95\x75\x7c\x3b\x9d\x9a\x6d\x9b\x23\x39\xd3\x77\x47\x97\x4c\xe8\xe9\xb8\xc7\x45\xab\x92\xa8\x6d\xad\x37\x49\x38\x20\xe3\xa0\x0f\x70\x9b\xa6\xe0\xe2\x36\x3e\x02\x7e\xee\x68\xab\x35\x9c\x99\x4e\xaf\x0f\x4c\x75\x69\xa8\x7a\xb5\x38\x53\xfc\xf9\xef\xf2\xa5\x79\x22\x94\x2c\xef\x3e\x62\xab\x7a\xe5\xcb\xaa\xdd\x51\x46\x9f\x79\x2b\x14\x74\x71\xc1\xea\x9d\xb0\xb3\x0f\xd9\x29\xdb\xdc\x1b\x25\x6a\x81\x32\xe1\x7f\x05\xde\xc8\x6a\x85\xa4\x7a\x5d\xfc\x0b\x00\x00\xff\xff\xce\x73\x51\x3b\x9d\x05\x00\x00")
+var _assets_styles_src_fields_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x55\xdb\x8e\x9b\x30\x10\x7d\xcf\x57\x58\x91\x56\xda\x3c\x50\x91\x54\xdb\xae\x88\x2a\xe5\x3f\xaa\x3e\x38\x66\x00\x2b\xc6\x46\xc6\x6c\x92\x56\xe9\xb7\x77\x7c\x61\xb1\x97\x25\x6a\x5e\x22\x32\x33\x9c\x39\x73\xe6\xc2\x81\xcb\x6e\x30\x59\x03\xbc\x6e\x4c\x41\xbe\xe6\xdd\x65\xbf\x3a\x54\x1c\x44\x99\xf5\xd0\x51\x4d\x0d\x57\x12\x1d\xd6\xbe\x72\xc1\xe4\xcf\x8a\x90\xa3\xd2\x25\xe8\x82\x6c\xbb\x0b\xe9\x95\xe0\x25\x39\x98\x06\x5a\xc8\x98\x12\x4a\xef\x31\x62\x84\x3c\xc4\x19\xac\xa3\x52\xd2\x64\x3d\xff\x0d\xf8\xf6\xab\x85\xb5\x68\x17\x6b\xe1\xb2\x2e\x02\x72\x86\x26\xeb\xe9\x68\x59\x3a\x73\x4e\x5e\x6c\xec\x2d\x90\xf8\x69\xae\x1d\xfc\x58\xb3\x06\xd8\x09\x43\xd7\xbf\x1c\xab\x31\x27\x1d\x8c\x9a\x62\x8b\x4a\xb1\xa1\x77\x01\x6a\x30\x82\x4b\xcc\x9c\x3b\xf7\x97\xa4\x50\xa5\x13\x90\x6d\xe0\x46\xd9\xa9\xd6\x6a\x90\xa5\xaf\xad\x98\x55\xda\x52\x5d\x73\xd4\xe8\x3b\x6a\x11\xe3\x3e\x1f\x3c\xd4\xc6\xc1\x9e\x79\x69\x1a\x44\xcd\xf3\xa7\x44\x9e\x51\x18\xb4\x09\x7a\x04\xe1\x82\xf1\x19\x69\x66\xb3\x20\xeb\xf9\xc4\x78\x73\x49\x5b\xe8\x7b\x5a\x43\xe6\x92\x3b\x98\xc0\x63\x87\x32\x6f\x5c\x86\x50\xf0\x1b\x15\x03\x84\x44\x95\x50\x14\xd1\xa4\x92\xe0\xf1\x0d\x5c\x4c\x46\x05\xaf\xb1\x24\x06\xd2\x80\xf6\xf6\x0f\x05\x10\x52\xf2\xbe\x13\xf4\x5a\x10\x2e\x1d\xdb\xa3\x50\xec\xe4\x5d\xbe\xc7\xe6\x2a\x50\x6a\x6e\x10\x8c\x4d\x34\x7b\x10\xc0\xcc\x9c\xa5\x9d\xbd\x45\x96\x89\x1c\x7e\x4a\x2d\x1e\xfe\x78\xb8\x10\x36\xb6\x22\xf7\x34\xa6\xe9\x99\xd2\x3b\x91\x1f\xd4\x48\xbd\x81\x46\x9d\xce\x05\x69\x78\x59\x82\x8c\x84\x9a\x5c\x20\x04\xef\x7a\xde\x07\xb5\x1a\x6e\x20\xeb\x3b\xca\xc0\x8a\x7b\xd6\xb4\x9b\x38\xf8\x95\x08\x43\xe2\xff\x04\x75\xed\xe4\x6e\x3e\x51\x65\x2a\x6d\x9b\xfb\x31\x43\xd3\xb4\x8e\xef\xdd\x89\xd1\xf6\x41\x92\x4b\xf6\xa1\x75\x9e\x44\xa7\x55\xad\x71\x64\x1e\xd4\x22\xe9\xc4\x2e\x2c\xf0\x9d\x7a\xed\x12\x87\x4c\x29\xd5\x6f\xe3\x1c\xa1\x82\x86\x33\x2a\xc6\xa1\x6b\x51\x63\x01\x13\x51\x39\xb4\x47\xbc\x08\x11\xcd\x58\xbf\xed\xce\x2b\x64\x23\x5d\x47\x16\xe2\x76\x79\x1a\x47\x35\xd0\x79\xe9\xaf\x77\xe4\x5e\x5c\xcf\x64\x1e\x47\xec\xb4\x58\x97\x7d\xb1\x21\xd1\xd1\x79\xb7\x2c\xdf\xc4\xff\xb9\xbe\x61\x05\x2b\xda\x72\x81\x1b\xda\x2a\xa9\x5c\x6f\xbc\x0f\x9b\xe1\xee\xef\xb8\xf4\x09\xf1\xe8\x5c\xa6\x07\x73\xec\x87\x3b\xba\x4b\x32\x3f\xb6\xc1\xf3\xc2\x6f\xd1\x5d\x6e\xa8\xa8\xbc\x54\xf1\x05\xc5\x49\x61\xcf\x7f\xd7\x2f\xf9\x13\xc9\xc8\x7a\xf6\xad\xda\xc4\x97\x7d\x6a\xd9\x0c\xd2\xf1\x0c\xc7\x4f\x40\xe5\xee\x68\x7c\xfa\x74\xf2\xbd\x3a\x07\x9a\x47\x25\xca\x38\xc1\x54\xe1\xdd\x04\xef\x60\x71\x06\x9f\xf5\xb6\xfa\x07\xa1\xbb\xc3\x5a\x84\x07\x00\x00")
 
 func assets_styles_src_fields_less_bytes() ([]byte, error) {
 	return bindata_read(
@@ -456,7 +576,7 @@ func assets_styles_src_fields_less() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/styles/src/fields.less", size: 1437, mode: os.FileMode(420), modTime: time.Unix(1475675607, 0)}
+	info := bindata_file_info{name: "assets/styles/src/fields.less", size: 1924, mode: os.FileMode(420), modTime: time.Unix(1792347720, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -481,7 +601,7 @@ func assets_styles_src_header_less() (*asset, error) {
 	return a, nil
 }
 
-var _assets_styles_src_index_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\xce\x31\x0e\xc2\x40\x0c\x44\xd1\x9e\x53\xd0\xa5\xe4\x00\x34\xdc\x24\x72\xd8\x61\x31\x38\x76\x14\x4f\x72\x7e\x24\x0a\x94\x15\xdb\xbe\xf9\x96\x7c\xd3\x79\x89\x95\xe7\xa1\xc4\x7d\x9b\xe1\x1c\xae\xa7\x9f\x4d\x1b\x19\x9e\x47\x7a\x42\x0a\xd6\xa3\x2c\xe2\x68\x92\x87\xc2\x4a\xb6\x49\x45\x5e\x5e\x31\x8d\xa6\xc9\xfe\x92\x20\xd5\x6b\xe7\xce\xa2\xaa\x77\x58\x77\x8c\x94\x7c\xff\x4f\x69\xb2\x7f\x7f\xfa\x04\x00\x00\xff\xff\xa5\xb1\xef\x10\xde\x00\x00\x00")
+var _assets_styles_src_index_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x75\x90\x31\x0e\x83\x30\x0c\x45\xf7\x9e\xa2\x1b\x23\x07\x60\xe1\x26\x51\x42\xdc\xc4\x25\xc4\x28\x36\x54\xbd\x7d\x53\x55\x95\x40\x38\xeb\x7b\xfe\xd6\xb7\x47\x5c\x56\x2a\x72\xef\x3c\x4d\xdb\x02\x59\xba\xe1\x36\xfe\x99\xdb\x44\x28\xf3\x11\x45\xb0\x1e\xca\x91\xac\x36\xc3\x69\xe4\x81\x90\x3c\x9f\x47\x02\x70\xff\x24\x67\x12\xb2\xe8\x86\x41\x04\x73\x68\xe4\x62\xcd\x51\x79\xeb\xf2\x47\xae\x2e\x51\xc0\xac\x60\xdc\xc1\xd4\x5c\xc3\x88\xe5\x59\xdd\x55\x2b\xda\x32\xc5\xab\xe3\x64\x77\x50\x8a\xb7\x4f\x72\x89\x9c\x82\x5f\x54\x66\xe3\xf1\xfb\xde\x0f\x4f\x02\x6a\xe0\x98\x01\x00\x00")
 
 func assets_styles_src_index_less_bytes() ([]byte, error) {
 	return bindata_read(
@@ -496,7 +616,67 @@ func assets_styles_src_index_less() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/styles/src/index.less", size: 222, mode: os.FileMode(420), modTime: time.Unix(1475540944, 0)}
+	info := bindata_file_info{name: "assets/styles/src/index.less", size: 408, mode: os.FileMode(420), modTime: time.Unix(1792350179, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assets_styles_src_pages_blobs_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd3\x4b\xca\xc9\x4f\xd2\xcd\x48\x2c\xce\x50\xa8\xe6\x52\x50\x48\xcb\xcf\x2b\xd1\x4d\x4b\xcc\xcd\xcc\xa9\xb4\x52\xc8\xcd\xcf\xcb\x2f\x2e\x48\x4c\x4e\xb5\x06\xca\x94\xe7\x17\xa5\xe8\x26\x15\xa5\x26\x66\x5b\x29\x80\x29\xdd\xc4\x9c\x1c\x6b\xae\x5a\x2e\x00\x68\x62\xbb\x88\x42\x00\x00\x00")
+
+func assets_styles_src_pages_blobs_less_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_styles_src_pages_blobs_less,
+		"assets/styles/src/pages/blobs.less",
+	)
+}
+
+func assets_styles_src_pages_blobs_less() (*asset, error) {
+	bytes, err := assets_styles_src_pages_blobs_less_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/styles/src/pages/blobs.less", size: 66, mode: os.FileMode(420), modTime: time.Unix(1792348505, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assets_styles_src_pages_job_history_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x85\x4e\xdb\x6a\xc4\x20\x10\x7d\xcf\x57\x0c\x94\xc2\x16\x6a\xb0\x0b\x21\xe0\x7e\xcd\x24\x1a\x57\x62\x1c\x99\xb8\x37\xca\xfe\x7b\xd5\x84\x7d\x29\xa5\x2f\xe2\x39\x73\x6e\xad\x76\xd3\x04\xdf\x0d\x80\x76\x6b\xf4\xf8\x50\x30\x78\x1a\xe7\x53\x66\xbc\x5b\x93\x58\xd3\xc3\x1b\x05\x81\x82\x29\xdc\x82\x6c\x5d\x50\xf0\x25\xe3\x1d\x64\x61\x22\x6a\xed\x82\x55\x1b\x9a\x28\x24\x31\xe1\xe2\x7c\x4e\x5a\x28\xd0\x1a\x71\xac\xce\xdb\xd9\x25\x23\x2a\x54\x10\xd9\x88\x1b\x63\xac\x07\x62\x5d\x41\xae\x66\x83\xb3\x28\xc4\xa9\xa9\x03\xea\xb2\x2c\x71\x3a\x9d\x4b\xa9\x7c\x2f\x8e\xe7\x76\x6c\xcb\x76\x91\xeb\x77\xd5\x80\xe3\x6c\x99\x2e\x41\x8b\x91\x3c\xb1\x02\xb6\x03\x1e\x8e\x52\x7e\xc2\xb1\xeb\xf2\x53\x7e\xb2\xed\x3e\x7e\x85\xb0\x59\xe8\x6a\xfe\xc9\x79\x45\xfc\x9d\x63\x31\xee\x21\xbb\xf3\xad\xef\xfb\x4d\xf5\x6c\x7e\x00\xc4\x5c\x4d\x74\x6c\x01\x00\x00")
+
+func assets_styles_src_pages_job_history_less_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_styles_src_pages_job_history_less,
+		"assets/styles/src/pages/job_history.less",
+	)
+}
+
+func assets_styles_src_pages_job_history_less() (*asset, error) {
+	bytes, err := assets_styles_src_pages_job_history_less_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/styles/src/pages/job_history.less", size: 364, mode: os.FileMode(420), modTime: time.Unix(1792346192, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assets_styles_src_pages_job_import_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x15\x8d\x41\x12\xc2\x20\x10\x04\xef\xbc\x62\x2f\x1e\xb1\xd0\x23\x79\x0d\x81\x0d\xac\x12\x96\x02\x4a\x13\x2d\xff\x2e\x7b\x9c\x9e\xea\x99\xeb\x83\x57\xbd\x51\x46\xf8\x2a\x80\x40\xbd\x66\x77\x5a\x58\x33\xfb\xe7\x32\xc9\x9b\xc2\x48\x16\x6e\xc6\x5c\x24\x26\xa4\x98\x86\x85\xbb\x31\xf5\x10\xb0\xf2\xa1\x3b\x7d\xa8\xc4\x29\x71\x0b\xd8\xf4\x44\xd2\xec\xae\x45\x2a\xa2\xd6\x03\x8c\x90\x8d\xcb\xd0\x9b\xdb\x29\xcf\x87\x9d\x0b\xf7\xea\x3c\x4a\xd3\x70\x6e\xa0\x85\x17\xb6\x41\xde\xe5\x45\xfd\xd4\x1f\x7d\x15\x1d\x14\x9a\x00\x00\x00")
+
+func assets_styles_src_pages_job_import_less_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_styles_src_pages_job_import_less,
+		"assets/styles/src/pages/job_import.less",
+	)
+}
+
+func assets_styles_src_pages_job_import_less() (*asset, error) {
+	bytes, err := assets_styles_src_pages_job_import_less_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/styles/src/pages/job_import.less", size: 154, mode: os.FileMode(420), modTime: time.Unix(1792346457, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -521,7 +701,7 @@ func assets_styles_src_pages_job_list_less() (*asset, error) {
 	return a, nil
 }
 
-var _assets_styles_src_pages_job_settings_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x53\xd1\x6e\xd4\x30\x10\x7c\xbf\xaf\xb0\x84\xa0\xf0\xe0\x34\x50\x55\x42\xe9\x0b\x7f\x82\x9c\x78\x93\x98\x3a\x5e\xcb\xde\x5c\x03\xe8\xfe\x9d\xb5\x1d\x9a\xdc\x41\x75\xba\xb7\xec\x7a\xc6\x33\xb3\xeb\xbc\xfb\x81\xad\x74\x6a\x02\xf1\xfb\x20\x44\x8b\x41\x43\x68\xc4\x67\xbf\x88\x88\xd6\x68\xf1\x8d\x46\x98\x40\x76\x68\x31\x3c\x31\xe2\xc5\x68\x1a\x19\x50\xd7\xef\x53\x39\x82\x19\x46\x6a\xc4\x43\xed\x97\x54\xf7\xe8\x48\x46\xf3\x0b\x18\xf2\xb5\xb4\x5a\x5c\x52\xc7\xb8\xa1\x59\xef\x97\xdc\x4a\x27\x5e\x69\x9d\xdb\xb5\x78\x4c\xd8\xd3\xe1\xf0\x6a\xa7\xe9\xb1\x9b\x63\x36\x85\x33\x59\xe3\xf8\xc6\x3a\x43\x2a\x52\xf1\x39\x1f\x78\x8c\x86\x0c\xba\x46\x04\xb0\x8a\xcc\x11\x36\x00\x3b\x76\x14\xd0\xc6\x0b\xa4\x6a\x39\xd7\x4c\x90\xf4\x09\x3d\x3b\x2f\x2e\x2d\xf4\xb4\x16\xc9\xf3\x4c\x84\x2e\x53\x5f\x23\x7f\x59\x23\x6e\xa1\xb7\xce\x96\xa4\xd4\xbd\x45\xc5\x80\x74\x69\x69\x4c\x2a\x0c\xc6\xc9\x50\x88\x8f\x7f\x79\x55\x11\x92\x0e\x25\xc1\x42\x59\x9b\xd5\x55\xf7\x3c\x04\x9c\x9d\x96\x66\x52\x03\x27\x9f\x83\xfd\x78\x57\x55\xf7\xb9\x8c\xf7\x29\xe0\xf7\x42\x8d\x55\x3c\x0e\x77\x9f\x9e\x2e\x89\x65\x07\x0f\xbc\x26\xb6\x59\x76\x75\x76\x1e\xc0\x43\xb2\xc8\xca\xe5\xf3\x1f\x44\x5e\x79\x23\x28\x28\x17\xbd\x0a\xe0\x32\xe4\x94\x2c\x96\x09\x6b\xb0\x40\xb0\x0e\x69\x47\xdc\x86\xcd\x03\xde\xcd\xe0\x43\x33\xe2\x11\xc2\x4a\x78\x83\xd2\x22\xa7\x9a\x76\xac\xd3\xb9\xe8\xc4\x57\xcc\xfe\x9a\x68\xc7\x6e\x21\xdc\x2e\xbb\xe7\xfd\x47\x58\xe3\x8b\xbb\x26\x9d\x57\x7c\xbb\xf2\x8e\x56\x84\xd3\xcf\x90\x85\x09\x26\xcf\xaf\x1b\xca\x4b\xd6\x26\x72\xf5\x33\x2d\xce\xe5\xe7\xfe\x27\x00\x00\xff\xff\xc6\x5e\x06\x67\xc1\x03\x00\x00")
+var _assets_styles_src_pages_job_settings_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x95\x54\xd1\x6e\xdb\x30\x0c\x7c\xcf\x57\x08\x28\xba\x6e\xc0\xe4\xba\x49\x0b\x0c\xee\x4b\xff\x64\x90\x63\xda\xd6\x22\x8b\x82\x44\xa7\xe9\x86\xfc\xfb\x28\xc9\xa9\x9d\xa6\x45\xd1\x37\x93\xba\xd3\x91\x47\xca\x57\x7f\xb0\x96\x56\x0d\xf0\x53\x5c\xc5\xcf\x1d\xbc\x88\x7f\x2b\x21\x6a\xf4\x0d\xf8\x4a\xdc\xb9\x83\x08\x68\x74\x23\x9e\xa8\x87\x01\xe4\x16\x0d\xfa\x47\x46\x3c\xeb\x86\x7a\x06\x94\xe5\x75\x0c\x7b\xd0\x5d\x4f\x95\xd8\x94\xee\x10\xe3\x16\x2d\xc9\xa0\xff\x02\x43\x7e\xe5\x54\x8d\x87\x98\xd1\xb6\xab\xa6\xfb\x25\xa7\xe2\x89\x53\x4d\x93\xd2\xa5\x78\x88\xd8\xe3\x6a\x75\x56\xcd\xa0\x7c\xa7\xad\x24\x74\x55\x06\xcc\x7a\xeb\xfb\x4b\xbd\xfb\xe5\x1d\xb1\xb9\xaa\xc5\xed\x18\xe6\x16\x73\x9c\xae\xc6\x91\x8c\xb6\xcc\x2a\x13\xa5\x20\x15\x76\xe9\xc0\x61\xd0\xa4\xd1\x56\xc2\x83\x51\xa4\xf7\x30\x03\xd8\x05\x4b\x1e\x4d\x78\x83\x54\x35\x7b\x35\x12\xc4\x82\x52\xb1\x9b\x5c\x9c\x81\x96\xa6\x20\xfa\x30\x12\xa1\x4d\xd4\x57\x1b\xd7\x93\x6d\x8b\xc6\x5e\x33\xb3\x3b\x39\x6e\x0d\x2a\x06\xc4\x4b\x73\x62\xb2\xc7\x67\xe2\xc3\x89\x57\x64\x21\x69\x51\x12\x1c\x28\x69\xb3\xba\xda\xee\x3a\x8f\xa3\x6d\xa4\x1e\x54\xc7\x9d\x8f\xde\x7c\xbf\x29\x8a\xdb\x14\x86\xdb\xd8\xe0\xef\x4c\x0d\x45\xd8\x77\x37\x3f\x1e\xdf\x12\xb3\xcf\x1b\x1e\x3d\x97\x99\xe7\x7f\x76\xee\xc1\x41\x2c\x91\x95\xf3\xe7\x05\x22\xad\x51\x25\xc8\x2b\x1b\x9c\xf2\x60\x13\xe4\x18\x4b\xcc\x0e\x37\x60\x80\x60\x32\x69\x41\x9c\xcd\x66\x83\x17\x1e\x7c\xab\x7a\xdc\x83\x9f\x08\x1f\x50\x6a\xe4\xae\x86\x05\xeb\x78\x2e\x3a\xf0\x15\xa3\xfb\x4c\x74\xcb\xd5\x82\xff\xba\xec\x92\xf7\x8e\x70\x83\xcf\xf6\x33\xe9\x34\xe2\xaf\x2b\x2f\x68\x59\x38\x3e\x8e\x24\x4c\x30\x38\xde\x6e\xc8\x9b\xdc\xe8\xc0\xd1\x4b\x1c\x9c\x5d\xae\x3b\xba\x78\x5d\x10\x45\xab\xc1\xf0\xf8\x81\x47\xa6\x08\xfd\xc5\xe3\xbc\x3b\x3d\xdf\x22\x96\xe2\x64\x64\x87\x4b\xd4\xb4\xda\xd3\x62\xcb\xfc\x3c\x4e\xd9\xe9\xd7\x90\x93\xeb\x0f\xfe\x3f\xc7\xd5\x7f\xdb\x96\xe5\x04\xba\x04\x00\x00")
 
 func assets_styles_src_pages_job_settings_less_bytes() ([]byte, error) {
 	return bindata_read(
@@ -536,12 +716,32 @@ func assets_styles_src_pages_job_settings_less() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/styles/src/pages/job_settings.less", size: 961, mode: os.FileMode(420), modTime: time.Unix(1475278606, 0)}
+	info := bindata_file_info{name: "assets/styles/src/pages/job_settings.less", size: 1210, mode: os.FileMode(420), modTime: time.Unix(1792349766, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
 
-var _assets_styles_src_pages_live_task_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x3c\x8f\xdd\x6a\xc4\x20\x10\x85\xef\xf3\x14\x03\xa5\xd0\xc2\xba\xd8\x85\xdc\x98\xa7\x99\x44\x63\x25\xea\xc8\x68\xd9\x2e\x65\xdf\xbd\xfe\x84\xbd\x11\xce\x87\xe7\x3b\xcc\xdb\x8a\xdb\xe1\xc9\xc2\xdf\x04\xa0\x5d\x4e\x1e\x1f\x0a\x56\x4f\xdb\xb1\x54\xe2\x5d\x2e\x22\x97\x87\x37\x0a\x22\x45\xd3\x58\x40\xb6\x2e\x2a\x90\x2d\x24\xd4\xda\x45\x7b\xa6\x9d\x62\x11\x3b\x06\xe7\xab\x24\x50\xa4\x9c\x70\xeb\xa5\x3b\xb1\x16\x77\xc6\x54\xe5\x6c\xf0\x10\x0d\x2c\x53\x9f\xe8\xdb\xf5\x8b\xd3\xe5\x5b\xc1\x97\x94\xef\x4b\x07\x2f\xf7\x2d\xfd\x0e\xff\x73\x14\xae\x3b\x53\x10\x01\x73\x31\x7c\x96\xdb\x19\x96\xe9\x27\x6a\xb1\x91\x27\x56\xc0\x76\xc5\x8f\xdb\x3c\x5f\x60\x3c\x52\x5e\x40\x5e\xe7\xcf\xe1\x79\x4e\xff\x01\x00\x00\xff\xff\x20\xfa\xbe\x5c\xfa\x00\x00\x00")
+var _assets_styles_src_pages_live_job_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5d\x8f\x5d\x0a\x83\x30\x0c\x80\xdf\x3d\x45\x2e\x10\x71\x7b\x1a\x1d\x8c\x5d\x25\xda\x58\xc3\xaa\x2d\x6d\x14\x61\xec\xee\x93\xea\x36\xd8\x4b\x48\x3e\xbe\xfc\xd5\x94\x54\x7a\xea\x14\x7b\x61\x6f\xe1\x59\x01\xd4\x25\xc5\x85\xfc\xcc\x05\x00\x78\x99\x18\x07\x16\x37\xa8\x81\xf3\x25\xae\xd7\x0d\xbf\xaa\x2d\xd0\x61\x74\xc1\x87\x64\xe0\xae\x03\x8f\x8c\xa5\xda\x9d\xcd\xaa\xbd\x2c\x8c\x2e\x85\x39\xa2\x52\x7e\xe4\xd2\x33\x52\x72\x32\xa1\x86\x68\xe0\xd4\xec\x23\x23\x59\x2b\x93\x43\xcf\xbd\xfe\x68\x1b\x92\xe5\x74\xc0\x73\x5c\x21\x07\x2f\xf6\x6f\xd7\xe6\xdd\xa0\x8e\x34\xb1\xf1\x94\x15\xbb\x41\x8e\x7f\xbe\xab\xda\xa0\x1a\x46\x03\xcd\xe7\xb2\x37\x07\x36\x7b\xf2\xfe\x00\x00\x00")
+
+func assets_styles_src_pages_live_job_less_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_styles_src_pages_live_job_less,
+		"assets/styles/src/pages/live_job.less",
+	)
+}
+
+func assets_styles_src_pages_live_job_less() (*asset, error) {
+	bytes, err := assets_styles_src_pages_live_job_less_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/styles/src/pages/live_job.less", size: 254, mode: os.FileMode(420), modTime: time.Unix(1792349556, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assets_styles_src_pages_live_task_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6d\x52\xef\x8a\x84\x20\x1c\xfc\xde\x53\x08\xb1\x70\x07\x19\xde\x42\x41\xf5\x34\x96\x66\x92\xff\x50\x97\xdd\x38\xf6\xdd\xcf\xd2\xda\xbb\xbd\xbe\x08\x8e\xf3\x9b\x71\x46\xf3\x1e\x0f\xb3\xd0\x0c\x7c\x67\x00\x10\xee\x8c\xc0\x4b\x0b\x7a\xa1\x87\xb9\x0b\x88\xe0\xce\x43\xe7\x17\x41\x5b\xa0\xb4\xa2\x2b\x26\xb1\x65\x5c\xb5\x00\xad\x1b\x83\x09\xe1\x8a\xa5\xdd\xa8\x95\x87\x23\x96\x5c\x04\x11\xa9\x95\x76\x06\x0f\xdb\xd0\x5d\x5b\x02\xef\x16\x9b\x20\x6e\x29\x9e\xe1\x0a\x74\xd9\x66\xb1\x79\x07\x0a\x27\x7e\x6a\xc1\x17\x42\x97\x6e\x03\x0e\xed\xab\x79\x44\xfd\x67\x1c\x28\x47\xab\x25\x94\xd8\x79\x6a\xd3\xf0\x1a\x83\x59\x7d\x53\x04\x0e\x5a\x68\xdb\x02\xcb\x7a\xfc\x71\xad\xaa\x02\xc4\x05\xa1\x02\xa0\xb2\xfa\xfc\xad\xe3\x7c\xb8\x8b\x0c\x01\x09\xb5\xbb\x52\x1a\xcf\x31\x42\x67\xd4\x25\x98\xca\x37\x6a\x5d\xd7\x07\xb5\x0c\x65\x42\xcf\x25\x4d\x9c\xa3\x53\xae\x04\x57\x14\x1e\xd5\x86\x22\xb9\x82\x29\x74\x83\xcc\x23\x81\x5b\xbb\xd0\x72\x36\xf9\xb5\x8c\x1d\xdf\xbd\x9a\xa6\x89\x5e\xc1\xad\x9c\x38\xa1\xf0\x15\x42\xdf\x3c\xc8\xf7\x07\xfd\x13\x2f\x9c\x14\xff\xe8\x6b\xe6\x73\x7a\x38\x79\xa7\xc7\xdc\x67\xf4\x57\x23\x47\xd6\xf8\x55\x9e\xd9\x0f\xbc\x59\x4a\x89\x5e\x02\x00\x00")
 
 func assets_styles_src_pages_live_task_less_bytes() ([]byte, error) {
 	return bindata_read(
@@ -556,7 +756,27 @@ func assets_styles_src_pages_live_task_less() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/styles/src/pages/live_task.less", size: 250, mode: os.FileMode(420), modTime: time.Unix(1475338636, 0)}
+	info := bindata_file_info{name: "assets/styles/src/pages/live_task.less", size: 606, mode: os.FileMode(420), modTime: time.Unix(1792347267, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assets_styles_src_pages_log_search_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6d\x8f\xdd\x0a\xc2\x20\x14\xc7\xef\xf7\x14\x07\x22\x28\xc8\x61\xc1\x2e\xe6\x9e\xe6\x4c\x6d\x93\x39\x8f\xa8\xb1\x22\x7a\xf7\x74\x1b\x5d\xc5\x01\xe1\xfc\xf8\x7f\x1c\xeb\xa8\x31\xc8\x91\x59\xe3\x74\x84\x77\x05\xa0\x4c\xf4\x16\x5f\x02\x7a\x4b\x72\xea\x32\xb1\x26\x26\x16\xd3\xcb\x6a\x01\x8e\x9c\x2e\x6c\xc6\x30\x18\x27\xe0\xca\xfd\x13\x78\x99\x42\x3d\x2a\x65\xdc\x20\xb6\xed\x4e\x2e\xb1\x3b\xce\xc6\xe6\xb4\x99\x1c\x45\x8f\x72\x75\x2f\x14\x14\x5b\x02\xfa\xdc\x12\x34\x4e\xac\x80\xae\x5a\xbb\xd6\x23\xb2\xc4\xa8\x34\x96\x7c\x7e\xec\x56\xf0\xcb\xbe\x95\xc6\xc2\x3e\x9b\xa1\xde\xbf\x20\x73\x9d\x7e\xa6\xdd\x2f\xc9\x52\x10\x70\x68\xdb\xf6\x8f\x76\xc6\x24\xc7\x5d\xd9\xa3\x9c\x86\x40\x0f\xa7\xd8\x6e\x0a\x43\x8f\xa7\x5b\xd3\x5c\x60\x7b\x38\xbf\x00\xaf\x9b\xf3\x16\xf4\xa9\xbe\x84\x74\x4a\x93\x35\x01\x00\x00")
+
+func assets_styles_src_pages_log_search_less_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_styles_src_pages_log_search_less,
+		"assets/styles/src/pages/log_search.less",
+	)
+}
+
+func assets_styles_src_pages_log_search_less() (*asset, error) {
+	bytes, err := assets_styles_src_pages_log_search_less_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/styles/src/pages/log_search.less", size: 309, mode: os.FileMode(420), modTime: time.Unix(1792347520, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -581,6 +801,26 @@ func assets_styles_src_pages_login_less() (*asset, error) {
 	return a, nil
 }
 
+var _assets_styles_src_pages_settings_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x1d\x8b\x5d\x0e\x40\x30\x0c\x80\xdf\x77\x8a\x5e\xa0\x0e\x30\xa7\x29\x3a\x16\xb4\x4b\x35\x19\x11\x77\xc7\x5e\xbf\x9f\x8e\x4a\x46\xd7\x95\x05\xee\x00\x90\x54\x1c\x13\xed\x79\xbb\x22\xec\x2a\x7a\x14\x1a\xb9\xff\x8c\xf3\xe9\x48\x5b\x9e\x25\xc2\xc8\xe2\x6c\x3f\xad\x6a\x13\x56\xa3\x12\x61\x30\xa6\x15\x7f\xd0\xc4\x92\x9d\xb1\xdd\x11\x8a\x71\x8b\xfa\xf0\x84\x17\x38\x35\x85\x8f\x71\x00\x00\x00")
+
+func assets_styles_src_pages_settings_less_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_styles_src_pages_settings_less,
+		"assets/styles/src/pages/settings.less",
+	)
+}
+
+func assets_styles_src_pages_settings_less() (*asset, error) {
+	bytes, err := assets_styles_src_pages_settings_less_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/styles/src/pages/settings.less", size: 113, mode: os.FileMode(420), modTime: time.Unix(1792346024, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
 var _assets_styles_src_pages_slaves_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd2\xcb\xc8\x4c\x49\xd5\x4d\xc9\xcf\x4b\xd5\x2d\xce\x49\x2c\x4b\x2d\x56\xa8\xe6\x52\x50\xd0\x03\xb3\x75\x0b\x12\x81\xc2\x79\xf9\x25\xba\x45\xa5\x79\x79\x99\x79\xe9\x60\x39\x05\x85\x94\xcc\xe2\x82\x9c\xc4\x4a\x2b\x85\x3c\xa0\x36\x6b\xa0\x50\x2d\x57\x2d\x17\x20\x00\x00\xff\xff\x74\x9b\x94\x2b\x49\x00\x00\x00")
 
 func assets_styles_src_pages_slaves_less_bytes() ([]byte, error) {
@@ -601,6 +841,26 @@ func assets_styles_src_pages_slaves_less() (*asset, error) {
 	return a, nil
 }
 
+var _assets_styles_src_pages_work_dir_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x2d\x4b\x3b\x0e\x80\x20\x0c\xdd\x39\x45\x2f\x50\x07\x27\x83\x8b\x57\x41\xa9\x42\xa8\x62\x88\xbf\xc4\x70\x77\xb1\xba\xbc\xff\xab\xce\x98\x02\x8e\x9e\xa9\x00\xb1\x85\x5b\x01\x54\x22\xf1\x30\xbc\x93\x04\x00\xec\x17\x42\x47\x7e\x72\x9b\x86\xba\x59\xaf\x56\xe2\xf2\xb6\xd8\x27\x32\x41\x83\x10\x1a\xe6\xb7\xca\xaa\x80\xf9\xcf\x43\xe4\x98\x34\x74\x9b\xa3\x99\x50\xdc\xb7\xc9\xea\x01\x7c\x86\x1f\x0c\x80\x00\x00\x00")
+
+func assets_styles_src_pages_work_dir_less_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_styles_src_pages_work_dir_less,
+		"assets/styles/src/pages/work_dir.less",
+	)
+}
+
+func assets_styles_src_pages_work_dir_less() (*asset, error) {
+	bytes, err := assets_styles_src_pages_work_dir_less_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/styles/src/pages/work_dir.less", size: 128, mode: os.FileMode(420), modTime: time.Unix(1792350179, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
 var _assets_styles_src_panes_less = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x55\xdd\x6e\xda\x4a\x10\xbe\xcf\x53\x8c\x90\xce\x09\x90\xb3\x60\x38\x22\x4d\x1d\xb5\xf2\x55\x5f\xa2\xea\xc5\xda\x1e\xcc\x8a\x65\xd7\x5a\x2f\x09\x69\x94\x3c\x7b\x67\x7f\x8c\xb1\x21\x6d\xa4\xf4\x06\xa1\xf9\xdb\xf9\xbe\x6f\x66\x9c\x49\xd1\x58\xf6\x28\x4a\xbb\x49\xe1\x36\x49\xea\xc3\xfd\x55\x56\x73\x85\xac\xa9\x79\x21\x54\x95\xc2\x62\xe9\x8c\x57\x59\x65\x44\xc9\xbc\x2b\x86\x2f\xef\x42\x78\xa5\x65\x89\x2a\xb8\x36\x28\xaa\x8d\x4d\xc1\xe8\xbd\x2a\xc7\xc3\x1c\x98\xc3\x62\x76\xbb\xb8\x9b\x50\xbd\x99\x33\xc3\xf3\x15\x40\xce\x8b\x6d\xe5\x13\x58\xa1\xa5\x36\x94\x5d\xe5\x7c\xbc\x5c\xad\xfe\x83\xee\x27\x99\x7d\xa2\xb4\x97\x98\xf8\xbd\xe4\x96\xb3\x42\x8a\x62\xcb\x73\x89\x5f\x46\xd6\xec\x71\xf4\xc3\xd7\x63\x8f\x98\x6f\x85\x65\xd6\x70\xd5\x08\x2b\xb4\x4a\xcf\xde\xa0\x72\xab\x06\x90\x37\x78\x4f\x19\xef\x8f\x2c\xf6\xa6\x71\x1d\xd6\x5a\x28\x8b\x86\x70\x00\xfc\x9b\x6e\xf4\x03\x1a\xff\xf6\xfb\xd1\x7c\x9e\xb8\x7a\x2f\xae\xc0\x57\x98\xc6\xe4\x58\x96\xe1\x03\x2a\xdb\xa4\xa0\xb4\xc2\x10\xe6\x80\xe3\xae\xb6\x4f\xec\xc8\x9b\x27\xc2\x77\x50\xeb\xb6\x7b\x9e\x37\x5a\xee\x6d\x40\xa5\xeb\x14\xc6\xd9\x06\x79\x49\x25\x1b\xf1\x13\xe1\x06\x7a\xe2\xfa\x16\x24\xae\x49\xb0\x82\xcb\x62\xfc\x3a\x5a\x25\xff\x00\x83\xd1\x25\xe5\x96\x13\x1f\x1e\xc5\x1f\x06\x38\x57\x2b\xfe\x85\x89\xf0\x0f\x89\x93\x01\x79\x23\xc6\xe2\xc1\x32\x2e\x45\x45\x58\x0a\x0c\x14\x13\xeb\x81\xc8\xcc\x6e\x70\x87\x81\x56\x67\x5e\x6b\x45\xb3\x1b\x0b\xfe\x1f\x86\x37\x5a\x1d\x5a\x67\x73\x26\x47\x9d\xeb\xd6\x93\xf6\x17\xa8\x5a\xc4\x87\x22\x13\xaf\x23\xcf\xdd\x22\xf1\xd4\x2d\xc9\x39\x19\xbd\x05\x85\xcc\xf3\x29\x7c\x13\x07\x20\x28\xc0\x95\xd2\x4f\x54\x1b\xdc\x1b\x08\x39\xda\x47\x44\x05\x6b\xa9\xb9\x75\x66\x94\x04\x97\xe6\x60\x06\xd3\x79\x1f\x58\xe2\x4b\x75\x2b\xd4\x73\x66\xc7\xff\xf7\xde\x55\x8a\xa6\x96\xfc\x29\x05\xa1\xbc\x04\xb9\xd4\xc5\x36\xb8\x72\x7d\x70\x81\x7e\xcf\x73\x6d\x1c\x78\x32\x05\xdf\x6f\x84\x06\xd8\x71\x53\x09\x02\x96\x10\x6b\xa7\x34\xcd\x97\x93\x3e\x6f\xe7\xfe\x50\xa0\xe6\x65\x19\xce\xcb\x2a\xb0\xe9\x37\xc1\xeb\xc4\x82\x0c\xc7\x95\x68\x05\x33\x28\x89\x97\x87\x88\xca\x4b\xc6\xf7\x56\x77\xfb\xe1\x6e\xd9\x47\x45\x1e\xd0\xfa\x0e\x82\x9c\xf0\x43\x52\x92\x01\x09\xc9\x10\x74\xd2\x82\xa6\xbe\xb3\x1d\x96\x82\xc3\x78\x27\x54\x7b\x5a\xc7\x27\x77\xf9\xa6\xcf\xe0\x94\xf6\x70\x12\xf6\xff\x88\xb7\x13\xab\x4b\x0b\x2f\x5e\x5e\xee\x2e\xaa\xdb\xeb\x7e\x2b\xfc\xf0\xd1\x56\xe2\x9b\x71\x2d\x46\xe7\x99\xa7\x0d\xf6\x9c\x9d\xa0\xde\x9a\xef\xad\xd5\xaa\xf1\xd5\x03\xc3\xcc\x2b\x19\x38\xf4\xf7\x37\xe5\x6b\x7b\x1c\x98\x82\xa6\x9f\xb6\x26\x85\x6b\xb8\x1e\x2c\xc0\xc9\xe4\x17\x12\xb9\x71\x9a\x06\xa6\xe2\x1d\xce\x9e\xc3\x6b\xac\xa1\xdd\x2b\xac\x36\x2f\xed\x7a\xb9\x9d\xa4\x11\x6c\xef\xd4\xb1\x95\xfe\x49\x38\x1d\x8a\xd6\x72\x14\x3d\xb9\x0c\x2c\x7e\xca\xfc\x85\xe8\x7d\xc7\xde\x38\x20\x7f\xee\xb2\xfd\x6a\x9c\x4e\x64\xb6\x16\x28\x4b\xca\xa8\xb9\xe1\x6e\x37\x7c\xcf\xe7\xe6\x41\x8f\x15\xaf\x7d\xed\xf6\x6c\xb7\x40\xdb\xc2\xbe\x48\xe2\x6e\xec\xaf\x00\x00\x00\xff\xff\xa1\x24\xec\xea\x4a\x08\x00\x00")
 
 func assets_styles_src_panes_less_bytes() ([]byte, error) {
@@ -621,7 +881,7 @@ func assets_styles_src_panes_less() (*asset, error) {
 	return a, nil
 }
 
-var _assets_styles_style_css = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb4\x59\xdb\x72\xdb\x36\x13\xbe\xf7\x53\xe0\x8f\x27\x63\x3b\x13\xc8\xd4\xc9\x07\x65\xfe\x4e\xaf\xfa\x12\x99\x4e\x07\x24\x21\x09\x15\x08\x70\x08\xd0\x92\x93\xc9\xbb\x77\x01\x82\x24\x48\x82\x12\xd5\x34\xbd\x50\x4d\x12\xbb\xfb\x2d\x76\xf7\xdb\x05\xb2\xd7\x19\xff\x7c\x13\xcb\xf4\x1d\x7d\xbf\x41\x28\x23\xc5\x8e\x89\x0d\x8a\xbe\xc0\x43\x4c\x92\xc3\xae\x90\xa5\x48\x71\x22\xb9\x2c\x36\xe8\xf6\x69\x1d\x27\xe9\xca\x7c\xdc\x4a\xa1\xf1\x96\x64\x8c\xbf\x6f\x90\x22\x42\x61\x45\x0b\xb6\x6d\x3e\x29\xf6\x8d\x6e\xd0\xfc\x29\x3f\x7d\xb9\xf9\x71\x13\x97\x5a\x4b\xf1\x19\x31\x91\x97\xfa\xab\x7e\xcf\xe9\xff\x3f\xa8\x32\xce\x98\xfe\xf0\xa7\x35\x1c\xcb\x22\xa5\x60\x41\x48\x41\xad\x6d\x79\x32\x2a\x98\xd8\x6d\xdc\x37\x0c\xaf\xcc\x97\x9c\xa4\xa9\x7d\x1d\xa1\x45\x94\xdb\x57\x1d\xd4\x7b\xca\x76\x7b\xbd\x41\x8b\x97\xea\x23\x67\x82\xe2\xfe\xcb\x01\x44\x84\x92\xb2\x50\xc6\xc7\x5c\x32\xa1\x69\x31\xb2\x01\x84\x10\xbb\xb8\x7a\x3c\xee\x99\xa6\xad\x7f\x9b\xad\x4c\x4a\x15\xf6\xb2\xfa\x66\x7d\x95\xa5\x36\xa0\x2c\xde\x46\x74\x2f\xdf\x68\x31\x22\x6a\xbf\x55\xdb\x34\x44\xf4\x6c\xff\x33\x9a\x66\x29\xe5\x54\x53\x5c\x69\x1c\x5b\x9f\xae\x9e\xe7\x2f\xdb\xe1\xfa\xf3\x56\x48\xbc\x5c\xad\x5f\xac\x54\xb5\x1c\x0b\x89\x35\x3d\x69\x2b\xe0\x56\xe9\x02\xf2\x20\x27\x05\x15\xda\x6c\x92\xd1\xb7\xe5\xf2\xb8\x41\x7b\x96\xa6\x54\x0c\xa2\x31\x47\xff\x63\x59\x2e\x0b\x4d\x2a\x81\x41\x08\x7e\xdc\xdc\xee\x29\x49\x1d\xac\x5c\x2a\xa6\x19\x20\x45\x5b\x76\xa2\xa9\x91\xd0\x32\x77\x61\xe7\x74\xab\xdd\x9f\x47\x96\xea\x3d\x68\x8f\xa2\x8f\x7e\x42\xac\x56\x81\x84\xa8\x5f\x0e\x5d\x76\xb1\x05\x1b\xe0\x25\x26\x9c\xed\xc0\x70\x42\xeb\xdc\xf8\x86\x99\x48\xe9\x09\xcc\xf8\x30\x89\x05\x9a\x32\x95\x73\x02\x75\xc1\x84\x35\x16\x73\x99\x1c\x42\x50\xc6\xb3\x3c\x08\xb2\x93\xe7\x5e\x1d\xcc\x5d\x1d\x58\xa4\x29\x4d\x64\x41\xaa\x7d\xaa\xab\xc9\xcb\x95\x0e\x5a\x2f\xe6\xf5\x92\xd7\xd7\xd7\xce\x92\x19\x04\x05\xe7\x64\x47\x3b\xab\x5a\x1e\x68\x60\x43\x52\x64\x00\x35\x3f\x21\x25\x39\x4b\xdb\x35\x90\x32\x39\x11\x74\x24\xb3\x8a\x5d\x4c\xee\x17\xeb\xf5\x67\xd4\xfe\x44\xb3\xe7\x87\x46\xee\x6b\x4a\x34\xc1\x09\x67\xc9\x81\xc4\x1c\xca\x42\x17\x25\x75\xac\x81\x8f\x34\x3e\x30\x8d\x6d\xe2\xb9\xdc\xe8\x9b\x00\x6d\x6b\x85\x28\x51\x55\x30\x27\xaf\x0c\x24\xe3\x39\x3c\x67\xcb\x27\xec\xe4\xeb\x65\x27\x7f\x43\x9f\x5c\xea\x5b\x10\x98\xbe\x41\x02\xaa\x3a\xb0\x20\x4c\xb3\x5c\xbf\xe3\x7f\xb5\xbf\x7e\x41\x91\x18\x82\x56\xba\x7c\x37\x35\xb5\x76\xac\x58\x95\x55\x42\x78\x72\xbf\x8e\x3e\x22\x8c\xd0\x7c\x05\xd9\xf6\xe0\xd5\xd9\xe2\xc5\xa5\x5f\x53\xd6\xcf\xcb\x40\xa5\x35\x6f\xc3\xf5\x34\xd2\x61\x8e\x4e\x7a\x19\x45\x03\xe6\x5e\x46\x55\x73\x99\xed\x0a\xc8\xb7\xef\xd7\x38\x54\x57\x8c\x73\xc1\xfa\x67\xf8\x02\x1c\x5c\xd4\xee\x85\x71\x3e\x7e\x42\x7f\xb0\x13\xd2\x7b\x8a\x88\x10\xf2\x1d\x4a\x10\x01\xe7\x25\x14\xc5\x54\x1f\x29\x15\x08\x28\x0f\xca\x0f\x5e\x03\xbb\x66\x26\x5c\x33\xf4\xe9\xb1\x8b\x3c\x6a\x61\xb7\xb5\x11\xe8\x49\xa3\x44\x32\x4e\x1c\xfd\xa0\x34\x9c\x81\x40\x27\x9a\x2f\xe0\xc7\x29\x6f\x08\x64\xbe\xf6\xf7\xd1\xfe\xe2\x20\xed\x16\x94\x83\x67\x6f\xed\xa6\x92\x52\x4b\x2b\xc9\x99\xd2\xd3\x22\x50\x2f\xf6\x38\xe1\x92\x2f\x35\x91\xb7\xae\x44\x95\x23\x5d\x1e\x9c\xbb\x74\xf8\x3d\xa3\x29\x23\xe8\x3e\x63\x02\x3b\x0d\x4f\x0b\xe0\xa5\x07\x6b\xad\x85\xda\xe8\x7f\xaa\x73\x2b\x94\xee\x36\xf1\x6c\x3e\xfc\xf0\x75\x93\xd3\x34\xdd\x7e\x66\x21\xbb\xd2\x37\x64\xbc\xa8\x55\xdb\x0d\x71\xbd\x58\x79\xc3\x18\xb6\x9b\x57\x3b\xd7\x59\xb5\xd9\x90\xad\x6e\xf8\x1b\x32\x54\x80\xce\x3b\x74\xd7\x49\x9d\x26\x67\x12\x4e\x49\x61\xb6\x58\xef\x07\x9a\x80\x68\x2e\x8e\x68\x36\xaf\x21\x09\x4c\x41\xb6\xe1\xc0\xc1\x82\x9a\xd7\x5b\xda\x76\xa9\x81\x4d\xc7\x79\xb6\xb2\x3a\xac\x1e\x2a\xbc\x29\xb2\xd3\x7d\xa8\xfb\x62\x9b\x51\x4b\x53\x1c\x80\x19\x2d\xfd\x7d\xde\x91\xdc\x8a\x35\x1c\xd6\x2b\x2a\x2b\x61\x3d\xb3\x16\x3b\x93\xec\x7c\xd8\x09\x5b\x45\xcb\x21\x9b\xcd\x5f\x2e\xcd\x04\x5e\xc7\x77\x15\xeb\xfb\x99\xec\x69\x72\x80\x95\xce\xd3\xda\x52\x5d\xa2\x76\xe9\xe8\x10\x3a\xdb\x32\xca\x53\x18\xe1\x61\x7a\x23\x5a\x16\x5d\xaf\xc7\x46\x24\xcf\xb1\x7a\x47\x9e\xeb\x0d\x99\x65\x54\x29\x18\x1b\xb0\xd5\x6c\xf5\x8d\xcc\x65\xd5\x4c\x3e\x90\xe0\x24\xa6\xdc\xca\x05\x27\xf8\x0b\xd2\xce\xa1\x37\xc2\x4b\x1a\x8a\x7c\x98\xdc\x7b\x08\x47\xe9\xb7\x0a\x9b\x7e\xe7\xb0\x7f\x4c\x83\x9a\xc4\x42\x50\xc0\xf8\x89\xbe\xec\x71\xd3\xb8\x3a\x02\x23\x0e\x2f\x7b\xdd\x35\x2c\x3c\xf0\x37\xa0\xa3\x2f\x53\x3d\x0c\xcf\x7d\xbd\x9a\xb5\xb8\xae\x08\xa3\xbf\xfe\xda\x20\xfa\xb2\x03\x97\x42\xe7\x09\x1b\xc7\xf6\x03\xe5\x9c\xe5\x8a\x29\x1b\x4c\x33\xc0\x63\xdb\x9b\x4d\xdc\x8f\x05\xc9\xad\x0d\x51\x66\x31\x14\xd5\xc4\x28\x85\x8a\xbd\xab\xe2\xda\xb8\x75\x84\x5b\xde\xa8\x51\xb4\x87\xda\xa6\xc5\x54\xc8\x40\xd4\x7a\xfb\x33\xc0\x3d\x05\xd7\xc2\xf6\x44\x07\xa0\x17\xd1\x19\xd0\x96\x98\x7e\x0a\xb5\xaf\xe1\x5a\xd8\xbe\xec\x00\x77\x45\x8e\x97\x61\x4f\xa9\x2e\xbf\x4b\x38\x15\x95\xd4\x9e\xf0\x6d\xa5\xdd\x37\xdd\x99\x31\xec\x58\xd0\x08\xb4\x2e\x8e\xae\x6d\xf8\xcc\x74\xdf\x3e\x9f\x35\x1d\xba\x33\x41\xc7\x92\xa7\x9e\x91\xd6\x97\xcb\x46\x1a\x85\xbe\x95\xca\x32\x9c\x14\x81\x2d\xf0\xdf\x32\xf6\xaf\x1d\x02\x27\xf5\xa2\xdb\x41\xeb\xf3\x62\x6f\x6e\x58\xf5\xb6\xb2\x7e\xf6\x7a\x0f\xcb\x80\xe5\x37\xa8\x2c\xf8\xfd\xdd\x6c\xf6\x68\x1f\xd5\x63\xce\x4b\x35\x53\x6f\xbb\xbb\x87\xde\xf2\xaa\xb9\x9a\xa2\x6a\xae\x8b\xbc\xaf\x05\xcd\x69\xd5\x16\xdc\x9f\xbd\xef\xad\x23\x6d\x93\x18\x5e\x55\xd9\x26\x5d\x90\x94\x95\x6a\xd3\x58\xf9\x85\x77\x22\x66\xb7\x05\xc9\xe8\xe5\x79\xe3\x42\xad\xfd\xe4\xf8\xd1\x00\x19\x9f\x2b\x34\x51\x87\xd1\x53\x84\xfb\x8e\xcd\xec\x5a\x48\xae\x2e\x9d\x20\x96\xfe\x11\xae\x1e\xd4\xba\x1a\xbc\x24\x6c\xb8\xa9\xd7\x6b\x02\x93\xe9\xa0\x9c\xdc\x6c\xeb\x92\x76\xfd\x6b\x23\x3a\x21\xbd\x8d\x93\x7f\xb9\xd9\xf7\x4c\x9a\x2f\xcd\x41\x63\xe1\x82\x7d\x45\x9a\x87\x3c\x1b\x6c\x6d\xf5\x58\xdd\x19\xf6\x2f\x1b\xda\xa0\x41\xa0\x1a\x6a\x38\xa3\x20\x7c\x5d\xd2\xaa\xa9\xf8\xe1\xbc\xa6\x0c\x54\x94\xf9\x79\x28\xde\xf9\x61\x5c\xc5\x44\x30\x13\x74\xa5\xf2\x38\xb8\x78\xed\x02\x72\x5c\x7a\x41\xc7\x44\x44\x8d\xb2\x5b\x2b\xad\x69\x06\xf3\xaa\xa6\xaa\x7b\x0b\x59\x5f\x16\xdd\x72\x09\x49\xfd\xdf\xde\x13\xf9\x5d\xe3\xe5\x69\xb6\x76\x8d\x63\x70\x8e\x9e\x7c\x6b\xd4\xc0\x6c\x9b\xf5\xb9\x3b\x85\x2a\xd5\x87\xe6\xa2\xbe\xb9\x28\x1a\xbf\x7d\x6a\x6c\xb6\x9d\x77\x78\x7c\x3e\xc7\x4b\x91\x4f\x4c\xd1\xf8\xf9\x62\x78\x6e\x1a\x21\xe8\x75\x14\xb8\x41\x5b\xfb\xac\x3d\xe5\x62\xec\xd6\x84\x19\x3c\x1b\xf1\xc8\xdc\x56\xd4\xa7\x98\xc1\x89\x38\x40\x91\xfe\xbf\xf8\x64\x52\x48\x3b\x5a\x5b\x27\xa0\x4f\x60\x33\x5f\x83\xf2\x82\x92\x03\x36\x2f\x3a\x00\x38\x0b\x8d\x7e\x8d\xfe\x45\x3d\xea\x79\x02\xb3\x6d\x21\x33\x9c\x11\xa5\x27\x5f\xab\x46\x91\xc9\xd9\x75\x35\x4b\x01\x1b\x53\x9c\x82\x5b\x58\x71\xf2\x06\x35\x31\xb3\xff\xb7\x77\xa5\x58\x48\x8d\x8b\x52\x08\x73\x4d\x17\xac\x95\x7f\x02\x00\x00\xff\xff\xb3\xa3\x51\x3f\x18\x1b\x00\x00")
+var _assets_styles_style_css = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xb5\x1a\xd9\x6e\xe3\x38\xf2\x3d\x5f\xc1\x9d\x60\xd0\x9d\x46\xe8\x96\xed\x38\x87\x07\x3b\xd8\xa7\xfd\x89\xc1\x62\x41\x49\xb4\xcd\x35\x25\x0a\x12\x95\x63\x06\xfd\xef\x5b\xbc\x24\x4a\x22\x2d\xb9\x7b\x3a\x01\x1c\x4b\x64\x5d\xac\xbb\x98\x93\x2c\xf8\xfd\x4d\x2a\xf2\x0f\xf4\xd7\x0d\x42\x05\xa9\x8f\xac\xdc\xa3\xe4\x37\x78\x48\x49\x76\x3e\xd6\xa2\x2d\x73\x9c\x09\x2e\xea\x3d\xba\x7d\xdc\xa5\x59\xfe\xa0\x16\x0f\xa2\x94\xf8\x40\x0a\xc6\x3f\xf6\xa8\x21\x65\x83\x1b\x5a\xb3\x43\xb7\xd4\xb0\x3f\xe9\x1e\xad\x1f\xab\xf7\xdf\x6e\xbe\xdd\xa4\xad\x94\xa2\xbc\x47\xac\xac\x5a\xf9\x87\xfc\xa8\xe8\x3f\x7f\x69\xda\xb4\x60\xf2\x97\xff\x68\xc2\xa9\xa8\x73\x0a\x14\x4a\x51\x52\x4d\x5b\xbc\x2b\x14\xac\x3c\xee\xed\x1a\x86\x57\x6a\xa5\x22\x79\xae\x5f\x27\x68\x93\x54\xfa\xd5\x80\xeb\x13\x65\xc7\x93\xdc\xa3\xcd\xb3\x59\xe4\xac\xa4\x78\xfc\x72\xc2\x22\x42\x59\x5b\x37\x4a\xc6\x4a\xb0\x52\xd2\x3a\x72\x00\x84\x10\xbd\xd9\x3c\xbe\x9d\x98\xa4\xbd\x7c\xfb\x83\xc8\xda\x26\x2c\xa5\x59\xd3\xb2\x8a\x56\x2a\xa6\x34\xbf\x1d\xe8\x49\xbc\xd2\x3a\x02\xaa\xd7\xcc\x31\x4d\x39\x7a\xd2\x3f\x0a\xd3\x2a\xa7\x9c\x4a\x8a\x0d\xc6\xd8\xfe\xfc\xe1\x69\xfd\x7c\x98\xee\xbf\x4c\x85\xa4\xdb\x87\xdd\xb3\x86\x32\xdb\x71\x29\xb0\xa4\xef\x52\x03\xd8\x5d\xb2\x06\x3b\xa8\x48\x4d\x4b\xa9\x0e\x49\xe1\x3b\x70\xf1\xb6\x47\x27\x96\xe7\xb4\x9c\x68\x63\x8d\xfe\xc1\x8a\x4a\xd4\x92\x18\x80\x89\x0a\xbe\xdd\xdc\x9e\x28\xc9\x2d\x5b\x95\x68\x98\x64\xc0\x29\x3a\xb0\x77\x9a\x2b\x08\x29\x2a\xab\x76\x4e\x0f\xd2\x7e\x7d\x63\xb9\x3c\x01\xf6\x24\xf9\xd5\x37\x88\x87\x87\x80\x41\xb8\x97\x53\x91\xad\x6e\x81\x06\x48\x89\x09\x67\x47\x20\x9c\x51\x67\x1b\x7f\x62\x56\xe6\xf4\x1d\xc8\xf8\x6c\x12\xcd\x68\xce\x9a\x8a\x13\xf0\x0b\x56\x6a\x62\x29\x17\xd9\x39\xc4\x4a\xdc\xca\x83\x4c\x0e\xec\xdc\xf3\x83\xb5\xf5\x03\xcd\x69\x4e\x33\x51\x13\x73\x4e\xce\x9b\x3c\x5b\x19\x70\xeb\xe9\xdc\x6d\x79\x79\x79\x19\x6c\x59\x81\x52\x70\x45\x8e\x74\xb0\xab\x8f\x03\x1d\xdb\x60\x14\x05\xb0\x5a\xbd\xa3\x46\x70\x96\xf7\x7b\xc0\x64\x2a\x52\xd2\x88\x65\xd5\xc7\x94\x7c\xde\xec\x76\xf7\xa8\xff\x48\x56\x4f\x77\x1d\xdc\x1f\x39\x91\x04\x67\x9c\x65\x67\x92\x72\x70\x0b\x59\xb7\xd4\x46\x0d\xfc\x46\xd3\x33\x93\x58\x1b\x9e\xb5\x8d\x31\x09\xc0\xb6\x6b\x10\x25\x8d\x51\xe6\xe2\x9d\x01\x63\xbc\xc4\xcf\x45\xf7\x09\x0b\xf9\x32\x2f\xe4\xef\xe8\x8b\x35\x7d\xcd\x04\xa6\xaf\x60\x80\x8d\x53\x2c\x00\xd3\xa2\x92\x1f\xf8\xbb\xce\xd7\x77\x28\x92\x82\xd2\x5a\x6b\xef\xca\xa7\x76\x36\x2a\x1a\xb7\xca\x08\xcf\x3e\xef\x92\x5f\x11\x46\x68\xfd\x00\xd6\x76\xe7\xf9\xd9\xe6\xd9\x9a\x5f\xe7\xd6\x4f\xdb\x80\xa7\x75\x6f\xc3\xfe\x14\xc9\x30\x6f\x16\x7a\x9b\x24\x93\xc8\xbd\x4d\x4c\x72\x59\x1d\x6b\xb0\xb7\xbf\xae\x11\xc8\x79\x8c\x15\x41\xcb\xa7\xe2\x05\x08\xb8\x71\xe2\x85\xf9\xfc\xfa\x05\xfd\x9b\xbd\x23\x79\xa2\x88\x94\xa5\xf8\x00\x17\x44\x10\xf3\x32\x8a\x52\x2a\xdf\x28\x2d\x11\x84\x3c\x70\x3f\x78\x0d\xd1\xb5\x50\xea\x5a\xa1\x2f\x5f\x87\x9c\x27\x3d\xdb\xbd\x6f\x04\x72\x52\x34\x90\xc4\x03\xc7\x58\x29\x5d\xcc\x40\x80\x13\xad\x37\xf0\x61\x91\x77\x01\x64\xbd\xf3\xcf\x51\x7f\xe2\x60\xd8\xad\x29\x07\xc9\x5e\xfb\x43\x25\xad\x14\x1a\x92\xb3\x46\x2e\xd3\x80\xdb\xec\xc5\x84\x39\x59\x5c\x20\xef\x45\x49\x8c\x20\xc3\x38\xb8\xb6\xe6\xf0\xaf\x82\xe6\x8c\xa0\xcf\x05\x2b\xb1\xc5\xf0\xb8\x81\xb8\x74\xa7\xa9\xf5\xac\x76\xf8\x1f\x9d\x6d\x85\xcc\x5d\x1b\x9e\xb6\x87\x6f\x3e\x6e\xf2\xbe\x0c\xb7\x6f\x59\x48\xef\xf4\x09\x29\x29\x1c\x6a\x7d\x20\x36\x17\x37\x5e\x31\x86\xf5\xe1\x39\xe1\x06\xbb\xf6\x7b\x72\x90\x5d\xfc\x06\x0b\x2d\x01\xe7\x27\xf4\x69\x60\x3a\x9d\xcd\x64\x9c\x92\x5a\x1d\xb1\x3c\x4d\x30\x41\xa0\x99\x2d\xd1\xb4\x5d\x83\x11\x28\x87\xec\xd5\x81\x83\x0e\xb5\x76\x47\xda\x67\xa9\x09\x4d\x1b\xf3\xb4\x67\x0d\xa2\x7a\xc8\xf1\x96\xc0\x2e\x97\xc1\xe5\xc5\xde\xa2\xb6\xca\x39\x80\x67\xb4\xf5\xcf\xf9\x48\x2a\x0d\xd6\xc5\xb0\x91\x53\x69\x08\x2d\x99\xa6\x38\xa8\x64\xd7\xd3\x4c\xd8\x23\xda\x4e\xa3\xd9\xfa\x79\xae\x26\xf0\x32\xbe\xf5\x58\x5f\xce\xec\x44\xb3\x33\xec\xb4\x92\x3a\x4a\xce\x45\xf5\xd6\x68\x11\xba\x3a\x30\xca\x73\x28\xe1\xa1\x7a\x23\x52\xd4\x43\xa9\x63\x25\x92\x27\x98\x3b\x91\x27\x77\x20\xab\x82\x36\x0d\x94\x0d\x58\x63\xd6\xf8\x22\x75\x99\xa9\xc9\x27\x10\x9c\xa4\x94\x6b\xb8\x60\x05\x3f\x03\x6d\x05\x7a\x25\xbc\xa5\x21\xcd\x87\x83\xfb\x88\xc3\x68\xf8\x35\x6a\x93\x1f\x1c\xce\x8f\x49\x40\x93\x69\x16\x1a\x88\xf8\x99\x9c\x97\xb8\x4b\x5c\x03\x80\x88\xc0\xdb\x51\x76\x0d\x03\x4f\xe4\x0d\xe0\x18\xc3\x98\x87\x69\xdf\x37\xf2\x59\xcd\xd7\x15\x6a\xf4\xf7\x5f\xab\x44\x1f\x76\x22\x52\xa8\x9f\xd0\x7a\xec\x17\x28\xe7\xac\x6a\x58\xa3\x95\xa9\x0a\x78\xac\x73\xb3\xd2\xfb\x5b\x4d\x2a\xe3\xd9\xb5\x38\xd6\x60\x2c\x57\x88\x34\x02\xb9\x56\xaa\x11\xf8\x65\x5d\x39\x2c\x0b\xd9\x77\x8f\xbe\x1c\x8f\x46\x0c\x38\x15\xc9\x20\x01\x39\x3b\x2f\xe0\xd4\xb8\x29\x1b\xcb\xb6\x48\x21\xb0\x2c\xb4\xd4\x50\xc0\x1b\xa2\xb8\xd6\x76\x07\xc0\x7d\xec\x74\x5c\xf4\x8d\x7d\x97\x66\x0d\x67\x00\xaa\x35\xfe\x23\x8c\x7b\x08\xae\x65\xdb\x03\x9d\x30\xbd\x49\x66\x98\x86\xbe\x98\xcc\x33\xfe\x7c\x99\x71\x0f\x49\x84\xf9\x71\x35\xfe\xec\x33\x3f\x0f\xbe\x8d\x6c\x77\x8f\xcb\x44\xf6\x53\xa6\x79\x8e\xa7\xb5\x8b\x09\x73\x30\x66\x2a\x44\x29\xb4\x43\xa8\x15\x30\x7a\x9d\x37\xbb\x56\x28\xc2\x70\x3c\xe7\xe9\x8c\xf9\x43\xa6\xe4\x63\xb8\xd6\x96\x7c\xd8\x89\x31\x99\xac\x1d\xb6\x25\x1f\x70\x49\xd8\x1f\xeb\xa2\xcb\xf6\x27\xc2\x0f\x06\xbb\x4f\x7a\x50\xfc\xea\x7a\xb5\x03\xe8\x45\x8c\xee\xed\x12\xad\x2a\x0b\xc7\x89\xb6\x2b\x1d\x07\xad\x5d\x2a\x78\xee\x11\xe9\x65\x99\x27\xd2\x21\xf4\xa9\x18\xca\xdf\x6e\x6e\x21\x8d\xe1\xff\x89\xd4\x9f\x87\x05\x46\x48\xf5\xb0\xb4\x73\x83\x8c\x51\x41\xfb\x30\x3a\x4a\xf7\xec\x15\x45\xac\x80\xf2\x63\x8f\xda\x9a\x7f\xfe\xb4\x5a\x7d\xd5\x8f\xcd\xd7\x8a\xb7\xcd\xaa\x79\x3d\x7e\xba\x1b\x6d\x37\xd6\xab\x22\x5d\x37\xc7\xf4\x56\x6b\x5a\x51\x53\xaf\xd8\xaf\xa3\xf5\x5e\x90\xbe\x7a\x99\xce\x50\xb5\x9b\xd5\x24\x67\x6d\xb3\xef\xa8\xfc\xc4\x61\x9d\x3a\xed\x92\x14\xf4\xde\x7c\x3d\xd3\x8f\xf9\x92\x78\xc6\xeb\x7e\xb0\x42\x1e\xf0\xe1\x37\x54\xbb\x51\xae\x7e\x98\x92\x7b\xf0\x50\x28\xa9\xec\x78\xb7\xc3\x19\x8f\x2c\x92\x34\xe7\x68\xef\x6c\xd7\xb1\xea\xd8\x6a\xc1\x9b\xb9\xbe\x79\xeb\x0f\x2e\x5c\x7b\x32\xc4\xe0\x59\x78\x17\x9a\x47\xf2\x05\xfa\xb1\x89\xaf\xda\xf3\xb1\x1e\xb1\xfb\xb9\xe6\xb2\xc0\x77\x94\x90\xff\xb5\x1d\xdf\x05\x1f\xda\xaa\xf6\x7a\xe3\xf2\xcc\x72\x1f\x0a\x49\x36\x39\x5a\xf3\x68\x26\xe5\xe3\x11\x5b\xaf\x34\x50\x54\x17\x77\x2e\x20\x08\x0f\x09\x7b\x34\x26\xf8\x5c\xc6\x54\x00\x8a\xb6\xba\xcc\x8a\xd7\x35\xc7\x51\x2c\x64\x66\x01\xae\x5c\xbc\x95\x97\x19\xb2\x81\x7a\x06\xc7\x42\x8e\x3a\x64\xb7\x1a\x5a\xd2\x02\xba\x34\x49\x9b\xe1\xec\xbd\xaf\x0b\xd4\x26\x51\x29\x14\x0d\x0a\x36\xba\x83\x41\x4b\x3f\x0c\x13\x6d\x85\x15\x70\x6c\x1a\xd3\x79\xd3\x68\x06\x62\x63\x92\x79\xb9\x09\x0e\xc4\x73\x76\x38\x0c\xd9\xed\x7a\x4b\x35\x43\x72\xbd\xe5\x78\x4e\xe1\x4a\x8f\x89\x1f\x47\xab\xa4\x41\x17\x51\xd5\x14\x9b\x3e\x02\x16\x80\x49\xfd\x00\xa4\xa1\x4a\x3a\x63\xf5\xa2\xe7\x8d\xb3\x69\x49\xd4\xaf\xe9\xbf\x18\x58\xb8\x38\x74\x4e\x12\x37\x74\x56\xdf\x92\xd5\xee\x6e\x8a\xa3\xa6\x4a\xf9\xf3\xb3\x6b\x8d\x2b\x8e\xc6\x0d\x6a\xc6\x17\x1d\x5c\xc0\xb9\xfd\xbd\x93\x71\xbf\x1c\x79\x7e\x5c\xed\x6c\x45\x32\x99\x1c\x2e\x9e\x93\x77\x6c\xf6\x55\xe0\xa5\x29\xaa\x09\x73\x53\x72\xc9\x98\x5c\x92\xc4\xe7\xed\x1d\xcd\xbe\xa4\x9b\x5a\xe2\xa5\x9c\x94\xf8\x49\x29\xb9\x66\xac\x1f\xc9\xf7\xbb\x24\x70\x67\xb0\xf3\x8b\x80\x45\x57\x01\x04\x5a\xde\x03\x59\x38\x16\xe9\x1a\xf4\x11\x14\x09\xde\x75\xe9\xc9\xf5\x2b\xc5\x3f\x35\x30\x4c\x28\xfc\x6e\x26\xe5\x7b\x4e\x20\x2c\x64\x27\x66\x7b\x15\x4b\xd4\x55\xab\xba\xec\xb8\x55\x36\x0e\x6a\xfd\x8e\xc0\x72\x5d\x4c\x89\x85\x8e\x8e\x81\x50\xf4\xf0\xf0\x6f\x5c\x03\xe5\x01\xac\x0e\xb5\x28\x70\x01\x52\x2e\xbe\x45\xf3\xc3\x81\x8f\xa9\x91\xc0\x55\x01\xa2\xc2\x61\x0f\x2f\x37\x49\x92\xc4\x36\x7f\x00\xe1\x62\xa8\xf7\xc7\xc7\xc1\xe6\x15\x7c\x60\xc9\x0a\x7a\xf9\x8e\xd7\xbb\x76\x78\x19\x74\x8e\x78\xd4\x69\x8c\x6e\x5c\x57\x50\x48\x41\xa4\xee\x78\x87\x92\x12\x85\xa5\x82\x95\xfb\xc9\x76\x25\x6a\xf4\x10\xc6\xdb\x8d\xb0\x97\x8f\x61\x9a\x48\x35\x8a\x1c\x9e\x70\xc3\xc9\x2b\xe4\xdb\x95\xfe\xab\x6f\x1f\x71\x29\x24\xae\xdb\xb2\x54\x17\x5f\x61\x70\x52\x31\x70\x92\x33\x2d\xfb\xdb\xae\xa0\x79\x45\x46\xb3\x61\xa3\x8b\x66\x38\x20\xa8\xca\xf4\x03\xe3\x34\xe2\x0f\xb1\xa1\x5f\x92\xcc\xb5\x19\xd3\x74\x3c\x3f\xa6\x70\xc3\x38\x3b\x86\x25\x75\x76\xc2\xca\x70\x9a\xef\x2e\x03\xd4\xef\xdf\xe2\xb6\x43\x76\x96\xfa\xee\x18\xca\x3d\xeb\x8b\xa8\xe1\xff\x85\x74\x26\x1e\x01\x29\x88\xcc\x4e\xdf\xe1\xf4\x2b\x38\xad\x14\x9f\x48\x73\x9a\x31\x29\x2d\xba\x16\xda\xc9\x4e\xb8\xd1\x04\xac\x9c\xb5\x91\x5c\x37\x9b\x5d\x8c\x2f\x96\x4a\xfe\x0f\xb3\xbc\x26\x30\x78\x25\x00\x00")
 
 func assets_styles_style_css_bytes() ([]byte, error) {
 	return bindata_read(
@@ -636,7 +896,27 @@ func assets_styles_style_css() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "assets/styles/style.css", size: 6936, mode: os.FileMode(420), modTime: time.Unix(1478639777, 0)}
+	info := bindata_file_info{name: "assets/styles/style.css", size: 9592, mode: os.FileMode(420), modTime: time.Unix(1792350179, 0)}
+	a := &asset{bytes: bytes, info:  info}
+	return a, nil
+}
+
+var _assets_work_dir_html = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc5\x56\x51\x6f\xd3\x30\x10\x7e\xdf\xaf\x30\x16\x30\xf6\xd0\xe6\x1d\xd2\x21\xa4\x75\xa2\x20\xa6\x89\x81\x90\x78\x73\x93\xeb\x6a\xe6\x3a\x91\xed\x14\x4a\xd4\xff\xce\xd9\x89\x33\x3b\xe9\xba\x16\x0d\x11\xa9\xaa\x63\xfb\xce\xdf\x7d\xf7\xdd\x39\x75\x9d\xc3\x82\x4b\x20\xf4\x67\xa1\xee\x2e\xb8\xd2\x74\xbb\x3d\x49\x9f\xe5\x45\x66\x36\x25\x90\xa5\x59\x89\xf3\x93\xb4\xf9\x23\x24\x5d\x02\xcb\xed\x80\x90\xba\x36\xb0\x2a\x05\x33\x68\x6b\x97\xdf\xe3\x0a\x28\x4a\xe8\x87\x62\x4e\xd0\x11\x64\xa6\x50\x1c\x9c\x3f\x34\x4c\xbc\x65\x3a\x2f\xf2\xcd\xd0\x85\x64\xeb\xce\x83\x16\x6c\xdd\x18\xba\x6d\x69\xce\xd7\x24\x13\x4c\xeb\x09\x15\x5c\x1b\xda\x58\xc7\x0b\x25\x93\xd0\x2d\xc4\xae\x57\xa0\x35\xbb\x85\x4b\x0e\x22\x47\xef\x1f\xa1\x34\xe4\x07\x82\xcc\xfb\x20\xbd\x29\x5f\x10\x59\x18\x32\xb6\x74\x04\x0b\xb1\x57\xc1\xe6\x20\x5a\x9f\x25\xe3\x8a\xd0\x30\x68\x42\xaf\x0a\x04\x14\xb9\x05\x99\x77\xef\x69\x82\xd8\x3d\xdc\xba\x7e\xee\x42\x9e\x5d\x90\xd7\x13\x32\xbe\x69\xc6\xdd\xde\xba\x56\x4c\xde\xc2\x00\xcf\x20\x7c\x92\x33\xc3\x46\x99\xe0\xd9\x1d\x9b\x0b\x98\x50\xa3\x2a\xa0\x01\x7e\x7c\x0a\xe9\xd6\x91\xc9\x22\x63\x86\x17\x72\x72\x9a\xd8\xd4\x23\x19\x6f\x1d\x88\xc9\x3d\x9a\xed\xf6\x25\x5b\x95\x6f\x54\x25\x71\x72\x8c\x79\x1d\x7f\xae\xa4\x9d\x3e\x0d\x98\x8e\x59\x69\x55\xe4\x78\x41\x16\xc6\x21\xdc\x38\xe4\x7b\x36\xba\x85\x34\x69\xb4\x81\x62\x71\x7a\xf3\x9b\x70\xd0\x53\xe9\x93\x8a\x74\xf3\xff\x25\x3a\x14\x13\x32\x4d\x66\x17\xc8\x60\x4b\xf9\x81\x66\xd7\xcc\x2c\x29\x79\x55\x2a\x2e\xcd\x82\xd0\xe4\x85\x4d\x82\x9d\x3c\xdb\x23\x9c\xd1\xbc\x32\xa6\x90\xda\x0b\x08\xa4\x01\xd5\xaa\x27\x4c\x74\xda\xec\xdb\xa7\x21\xdd\x89\xe8\x5e\xc6\xa8\x96\x77\x42\x84\xe5\x86\x89\x76\x9e\x62\x15\x61\xd9\x39\xa8\x51\xc9\x1d\x74\xea\x8e\x43\x43\xe5\xb6\x14\xba\xa9\x12\x0f\xb0\x73\xd7\x4c\x61\x98\x7d\x29\xdb\xe7\x6b\x19\x1f\xbf\x13\x6b\x58\xcb\x3d\x69\xc7\x2f\xfb\xf2\xef\xfb\xcc\x54\x1a\xcb\xca\x83\xad\xa6\xd7\xc0\xa6\xab\xd2\x6c\x3a\x3a\x37\x0f\x37\x99\x47\x3b\x8b\xdb\xa0\x2c\x3b\x6e\x79\x28\xb5\xb6\xf1\xec\x02\x18\x49\xde\xea\x70\xb4\xb0\xf8\x88\x4d\x09\x0e\x05\x34\xef\x3d\x7a\x9b\x2c\xcf\x34\xd6\x5e\x2f\xcd\xe8\xd1\xb9\xf1\x3e\x9d\xf5\xc8\x4d\xd1\xf3\xae\x54\xd3\xc4\xcd\xf4\x73\x16\x82\x69\x0c\xd7\x4c\xf4\xd4\xdb\xee\x64\x64\xa9\x60\x31\xa1\x87\x75\xbd\x86\x9d\x81\x76\xac\x48\x77\x38\xb7\xf1\x8d\xc8\xf8\x8a\xad\x60\xbb\x4d\xd2\x84\x0d\x70\x86\xda\xe8\x52\x26\x34\x10\x4b\xcb\xa7\x22\x07\xe4\xe6\x33\xdc\x56\x82\x1d\xc5\x4f\x5d\xcf\x37\x06\x34\x66\x98\xff\xc6\xa3\x9f\x9e\xa5\xc4\x66\xf4\x9f\x51\x75\x0c\x53\xc7\xd1\xe2\x38\x3d\x9e\x10\x34\xf4\xc8\x76\xc1\x88\x6a\x6c\x80\xf5\xc1\x9b\xfe\x2f\x2e\xba\xf6\x22\x75\xde\xf6\x77\x7e\x7b\xa3\x49\xc4\x8c\xfd\xde\xde\xd4\xf8\x6b\x22\x78\xd4\x70\x26\xb5\x61\x32\xf3\x86\xfe\xf5\x00\xcb\xee\x8e\x0a\x3e\x0d\x4e\x7c\x8d\x5f\x32\x54\x8c\xa7\x61\xbf\x9f\x1b\xc3\x4c\x65\xbf\x99\x1a\x1b\x1f\x6d\x90\xed\x03\xed\x6f\xaa\x2c\x03\xc8\x03\x17\x6d\x22\x1e\xb1\xc7\xa2\xc1\x4b\x33\x28\xa1\xb3\x81\x15\xde\x8c\x10\x19\x4d\x65\x4e\x0c\x77\x7c\xe3\xf0\x0b\xef\xb8\xb6\xc1\x4f\x7f\x95\xd8\xb1\x34\x16\xf3\x77\x50\xc5\x41\x41\xb4\x26\x18\xc5\xb7\x25\x48\xa2\x4b\x96\x61\x57\xd0\x44\xc6\x01\xed\xe6\x64\x88\xce\x7b\xf3\x50\x22\x42\xfc\xff\x1f\x6f\x83\x5d\x9b\xfe\x0b\x00\x00")
+
+func assets_work_dir_html_bytes() ([]byte, error) {
+	return bindata_read(
+		_assets_work_dir_html,
+		"assets/work_dir.html",
+	)
+}
+
+func assets_work_dir_html() (*asset, error) {
+	bytes, err := assets_work_dir_html_bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindata_file_info{name: "assets/work_dir.html", size: 3070, mode: os.FileMode(420), modTime: time.Unix(1792350136, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
@@ -694,20 +974,26 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"assets/.DS_Store": assets_ds_store,
+	"assets/blobs.html": assets_blobs_html,
 	"assets/fields.html": assets_fields_html,
 	"assets/header.html": assets_header_html,
 	"assets/images/plus.svg": assets_images_plus_svg,
 	"assets/images/task_buttons.svg": assets_images_task_buttons_svg,
 	"assets/job_edit.html": assets_job_edit_html,
+	"assets/job_history.html": assets_job_history_html,
+	"assets/job_import.html": assets_job_import_html,
 	"assets/jobs.html": assets_jobs_html,
 	"assets/live_job.html": assets_live_job_html,
 	"assets/live_task.html": assets_live_task_html,
+	"assets/log_search.html": assets_log_search_html,
 	"assets/login.html": assets_login_html,
 	"assets/scripts/job_edit/creator.js": assets_scripts_job_edit_creator_js,
 	"assets/scripts/job_edit/encoder.js": assets_scripts_job_edit_encoder_js,
 	"assets/scripts/job_edit/main.js": assets_scripts_job_edit_main_js,
+	"assets/scripts/live_task/main.js": assets_scripts_live_task_main_js,
 	"assets/scripts/pentagons.js": assets_scripts_pentagons_js,
 	"assets/scripts/slaves/main.js": assets_scripts_slaves_main_js,
+	"assets/settings.html": assets_settings_html,
 	"assets/slave.html": assets_slave_html,
 	"assets/slaves.html": assets_slaves_html,
 	"assets/styles/src/buttons.less": assets_styles_src_buttons_less,
@@ -715,13 +1001,21 @@ var _bindata = map[string]func() (*asset, error){
 	"assets/styles/src/fields.less": assets_styles_src_fields_less,
 	"assets/styles/src/header.less": assets_styles_src_header_less,
 	"assets/styles/src/index.less": assets_styles_src_index_less,
+	"assets/styles/src/pages/blobs.less": assets_styles_src_pages_blobs_less,
+	"assets/styles/src/pages/job_history.less": assets_styles_src_pages_job_history_less,
+	"assets/styles/src/pages/job_import.less": assets_styles_src_pages_job_import_less,
 	"assets/styles/src/pages/job_list.less": assets_styles_src_pages_job_list_less,
 	"assets/styles/src/pages/job_settings.less": assets_styles_src_pages_job_settings_less,
+	"assets/styles/src/pages/live_job.less": assets_styles_src_pages_live_job_less,
 	"assets/styles/src/pages/live_task.less": assets_styles_src_pages_live_task_less,
+	"assets/styles/src/pages/log_search.less": assets_styles_src_pages_log_search_less,
 	"assets/styles/src/pages/login.less": assets_styles_src_pages_login_less,
+	"assets/styles/src/pages/settings.less": assets_styles_src_pages_settings_less,
 	"assets/styles/src/pages/slaves.less": assets_styles_src_pages_slaves_less,
+	"assets/styles/src/pages/work_dir.less": assets_styles_src_pages_work_dir_less,
 	"assets/styles/src/panes.less": assets_styles_src_panes_less,
 	"assets/styles/style.css": assets_styles_style_css,
+	"assets/work_dir.html": assets_work_dir_html,
 }
 
 // AssetDir returns the file names below a certain
@@ -767,6 +1061,8 @@ var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
 	"assets": &_bintree_t{nil, map[string]*_bintree_t{
 		".DS_Store": &_bintree_t{assets_ds_store, map[string]*_bintree_t{
 		}},
+		"blobs.html": &_bintree_t{assets_blobs_html, map[string]*_bintree_t{
+		}},
 		"fields.html": &_bintree_t{assets_fields_html, map[string]*_bintree_t{
 		}},
 		"header.html": &_bintree_t{assets_header_html, map[string]*_bintree_t{
@@ -779,12 +1075,18 @@ var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
 		}},
 		"job_edit.html": &_bintree_t{assets_job_edit_html, map[string]*_bintree_t{
 		}},
+		"job_history.html": &_bintree_t{assets_job_history_html, map[string]*_bintree_t{
+		}},
+		"job_import.html": &_bintree_t{assets_job_import_html, map[string]*_bintree_t{
+		}},
 		"jobs.html": &_bintree_t{assets_jobs_html, map[string]*_bintree_t{
 		}},
 		"live_job.html": &_bintree_t{assets_live_job_html, map[string]*_bintree_t{
 		}},
 		"live_task.html": &_bintree_t{assets_live_task_html, map[string]*_bintree_t{
 		}},
+		"log_search.html": &_bintree_t{assets_log_search_html, map[string]*_bintree_t{
+		}},
 		"login.html": &_bintree_t{assets_login_html, map[string]*_bintree_t{
 		}},
 		"scripts": &_bintree_t{nil, map[string]*_bintree_t{
@@ -796,6 +1098,10 @@ var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
 				"main.js": &_bintree_t{assets_scripts_job_edit_main_js, map[string]*_bintree_t{
 				}},
 			}},
+			"live_task": &_bintree_t{nil, map[string]*_bintree_t{
+				"main.js": &_bintree_t{assets_scripts_live_task_main_js, map[string]*_bintree_t{
+				}},
+			}},
 			"pentagons.js": &_bintree_t{assets_scripts_pentagons_js, map[string]*_bintree_t{
 			}},
 			"slaves": &_bintree_t{nil, map[string]*_bintree_t{
@@ -803,6 +1109,8 @@ var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
 				}},
 			}},
 		}},
+		"settings.html": &_bintree_t{assets_settings_html, map[string]*_bintree_t{
+		}},
 		"slave.html": &_bintree_t{assets_slave_html, map[string]*_bintree_t{
 		}},
 		"slaves.html": &_bintree_t{assets_slaves_html, map[string]*_bintree_t{
@@ -820,16 +1128,30 @@ var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
 				"index.less": &_bintree_t{assets_styles_src_index_less, map[string]*_bintree_t{
 				}},
 				"pages": &_bintree_t{nil, map[string]*_bintree_t{
+					"blobs.less": &_bintree_t{assets_styles_src_pages_blobs_less, map[string]*_bintree_t{
+					}},
+					"job_history.less": &_bintree_t{assets_styles_src_pages_job_history_less, map[string]*_bintree_t{
+					}},
+					"job_import.less": &_bintree_t{assets_styles_src_pages_job_import_less, map[string]*_bintree_t{
+					}},
 					"job_list.less": &_bintree_t{assets_styles_src_pages_job_list_less, map[string]*_bintree_t{
 					}},
 					"job_settings.less": &_bintree_t{assets_styles_src_pages_job_settings_less, map[string]*_bintree_t{
 					}},
+					"live_job.less": &_bintree_t{assets_styles_src_pages_live_job_less, map[string]*_bintree_t{
+					}},
 					"live_task.less": &_bintree_t{assets_styles_src_pages_live_task_less, map[string]*_bintree_t{
 					}},
+					"log_search.less": &_bintree_t{assets_styles_src_pages_log_search_less, map[string]*_bintree_t{
+					}},
 					"login.less": &_bintree_t{assets_styles_src_pages_login_less, map[string]*_bintree_t{
 					}},
+					"settings.less": &_bintree_t{assets_styles_src_pages_settings_less, map[string]*_bintree_t{
+					}},
 					"slaves.less": &_bintree_t{assets_styles_src_pages_slaves_less, map[string]*_bintree_t{
 					}},
+					"work_dir.less": &_bintree_t{assets_styles_src_pages_work_dir_less, map[string]*_bintree_t{
+					}},
 				}},
 				"panes.less": &_bintree_t{assets_styles_src_panes_less, map[string]*_bintree_t{
 				}},
@@ -837,6 +1159,8 @@ var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
 			"style.css": &_bintree_t{assets_styles_style_css, map[string]*_bintree_t{
 			}},
 		}},
+		"work_dir.html": &_bintree_t{assets_work_dir_html, map[string]*_bintree_t{
+		}},
 	}},
 }}
 
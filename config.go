@@ -0,0 +1,497 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/unixpickle/jobempire/jobproto"
+)
+
+// configEnvPrefix is prepended to the upper-cased name of
+// a flag to get the environment variable overriding it.
+// For example, -admin-port becomes JOBEMPIRE_ADMIN_PORT.
+const configEnvPrefix = "JOBEMPIRE_"
+
+// MasterConfig stores the settings for a master.
+//
+// Settings are read from an optional JSON config file,
+// then from environment variables, and finally from
+// command-line flags, each overriding the last.
+type MasterConfig struct {
+	SlaveBind string
+	SlavePort int
+	AdminBind string
+	AdminPort int
+
+	// SlavePassFile and AdminPassFile are paths to files
+	// containing the slave and admin passwords.
+	SlavePassFile string
+	AdminPassFile string
+
+	// DataDir stores persistent state like session keys.
+	DataDir string
+
+	// JobsFile is the path to the job pool.
+	// It defaults to jobs.json inside DataDir.
+	JobsFile string
+
+	// WatchJobs enables reloading JobsFile whenever it is
+	// modified by another program.
+	WatchJobs bool
+
+	// JobHistory is the number of previous versions of the
+	// job pool to keep.
+	JobHistory int
+
+	// BuildCacheDir stores executables compiled for GoRun
+	// tasks. It defaults to build_cache inside DataDir.
+	BuildCacheDir string
+
+	// BuildCacheSize is the maximum size of the build cache,
+	// in MiB. If 0, the cache is unbounded.
+	BuildCacheSize int
+
+	// SlaveHistory is the number of disconnected slaves to
+	// keep, along with their jobs and task logs.
+	// If 0, all slaves are kept.
+	SlaveHistory int
+
+	// TaskLogDir stores task log entries which do not fit
+	// in memory. It defaults to task_logs inside DataDir.
+	TaskLogDir string
+
+	// TaskLogMemory is the amount of each task's log to
+	// keep in memory, in KiB.
+	TaskLogMemory int
+
+	// TaskLogMaxSize is the maximum size of each task's
+	// log, in MiB. If 0, logs are unbounded.
+	TaskLogMaxSize int
+
+	// TaskLogHours is the number of hours to keep the task
+	// logs from previous runs of the master. If 0, they are
+	// kept forever.
+	TaskLogHours int
+
+	// ArtifactDir stores files uploaded by tasks.
+	// It defaults to artifacts inside DataDir.
+	ArtifactDir string
+
+	// ArtifactSize is the maximum size of the artifact
+	// store, in MiB. If 0, the store is unbounded.
+	ArtifactSize int
+
+	// ArtifactHours is the number of hours to keep the
+	// artifacts of a job run. If 0, they are kept forever.
+	ArtifactHours int
+
+	// BlobDir stores the blobs which BlobTransfer tasks
+	// send to slaves. It defaults to blobs inside DataDir.
+	BlobDir string
+
+	// PeerUploads is the number of slaves which may fetch a
+	// blob from one other slave at once. If 0, slaves
+	// always fetch blobs from the master.
+	PeerUploads int
+
+	// Compression is a comma-separated list of compression
+	// algorithms for file and executable transfers, or
+	// "none" to disable compression.
+	Compression string
+
+	// Bandwidth limits the combined rate of file and
+	// executable transfers to and from all slaves, in KiB/s.
+	// If 0, transfers are not limited.
+	Bandwidth int
+
+	// SlaveBandwidth is like Bandwidth, but limits the
+	// transfers for each slave separately.
+	SlaveBandwidth int
+
+	// TLSCert and TLSKey, if set, enable TLS for both the
+	// slave and admin listeners.
+	TLSCert string
+	TLSKey  string
+
+	// SchedulePolicy is the name of a scheduling policy
+	// accepted by jobadmin.ParseSchedulePolicy.
+	SchedulePolicy string
+
+	LogLevel string
+}
+
+// NewMasterConfig creates a MasterConfig with default
+// values.
+func NewMasterConfig() *MasterConfig {
+	return &MasterConfig{
+		SlavePort:      7000,
+		AdminPort:      8080,
+		DataDir:        ".",
+		WatchJobs:      true,
+		JobHistory:     50,
+		BuildCacheSize: 1024,
+		SlaveHistory:   100,
+		TaskLogMemory:  1024,
+		TaskLogMaxSize: 1024,
+		TaskLogHours:   24 * 7,
+		ArtifactSize:   10240,
+		ArtifactHours:  24 * 7,
+		PeerUploads:    4,
+		Compression:    jobproto.CompressionGzip,
+		SchedulePolicy: "random",
+		LogLevel:       "info",
+	}
+}
+
+// ParseMasterConfig parses command-line arguments, an
+// optional config file, and environment variables.
+func ParseMasterConfig(args []string) (*MasterConfig, error) {
+	c := NewMasterConfig()
+	fs := flag.NewFlagSet("master", flag.ContinueOnError)
+	fs.StringVar(&c.SlaveBind, "slave-bind", c.SlaveBind, "address to listen on for slaves")
+	fs.IntVar(&c.SlavePort, "slave-port", c.SlavePort, "port to listen on for slaves")
+	fs.StringVar(&c.AdminBind, "admin-bind", c.AdminBind, "address to listen on for admins")
+	fs.IntVar(&c.AdminPort, "admin-port", c.AdminPort, "port to listen on for admins")
+	fs.StringVar(&c.SlavePassFile, "slave-pass-file", c.SlavePassFile,
+		"file containing the slave password")
+	fs.StringVar(&c.AdminPassFile, "admin-pass-file", c.AdminPassFile,
+		"file containing the admin password")
+	fs.StringVar(&c.DataDir, "data-dir", c.DataDir, "directory for persistent state")
+	fs.StringVar(&c.JobsFile, "jobs", c.JobsFile, "job pool file (default <data-dir>/jobs.json)")
+	fs.BoolVar(&c.WatchJobs, "watch-jobs", c.WatchJobs, "reload the job pool when it changes")
+	fs.IntVar(&c.JobHistory, "job-history", c.JobHistory, "number of job pool versions to keep")
+	fs.StringVar(&c.BuildCacheDir, "build-cache", c.BuildCacheDir,
+		"GoRun build cache directory (default <data-dir>/build_cache)")
+	fs.IntVar(&c.BuildCacheSize, "build-cache-size", c.BuildCacheSize,
+		"maximum build cache size in MiB (0 for unlimited)")
+	fs.IntVar(&c.SlaveHistory, "slave-history", c.SlaveHistory,
+		"number of disconnected slaves to keep (0 for all)")
+	fs.StringVar(&c.TaskLogDir, "task-log-dir", c.TaskLogDir,
+		"directory for task logs which do not fit in memory (default <data-dir>/task_logs)")
+	fs.IntVar(&c.TaskLogMemory, "task-log-memory", c.TaskLogMemory,
+		"KiB of each task log to keep in memory")
+	fs.IntVar(&c.TaskLogMaxSize, "task-log-max-size", c.TaskLogMaxSize,
+		"maximum task log size in MiB (0 for unlimited)")
+	fs.IntVar(&c.TaskLogHours, "task-log-hours", c.TaskLogHours,
+		"hours to keep task logs from previous runs of the master (0 for forever)")
+	fs.StringVar(&c.ArtifactDir, "artifact-dir", c.ArtifactDir,
+		"task artifact directory (default <data-dir>/artifacts)")
+	fs.IntVar(&c.ArtifactSize, "artifact-size", c.ArtifactSize,
+		"maximum artifact store size in MiB (0 for unlimited)")
+	fs.IntVar(&c.ArtifactHours, "artifact-hours", c.ArtifactHours,
+		"hours to keep the artifacts of a job run (0 for forever)")
+	fs.StringVar(&c.BlobDir, "blob-dir", c.BlobDir, "blob store directory (default <data-dir>/blobs)")
+	fs.IntVar(&c.PeerUploads, "peer-uploads", c.PeerUploads,
+		"slaves which may fetch a blob from one other slave at once (0 to disable)")
+	fs.StringVar(&c.Compression, "compression", c.Compression,
+		"comma-separated transfer compression algorithms (gzip or none)")
+	fs.IntVar(&c.Bandwidth, "bandwidth", c.Bandwidth,
+		"total transfer bandwidth in KiB/s (0 for unlimited)")
+	fs.IntVar(&c.SlaveBandwidth, "slave-bandwidth", c.SlaveBandwidth,
+		"transfer bandwidth per slave in KiB/s (0 for unlimited)")
+	fs.StringVar(&c.TLSCert, "tls-cert", c.TLSCert, "TLS certificate file")
+	fs.StringVar(&c.TLSKey, "tls-key", c.TLSKey, "TLS private key file")
+	fs.StringVar(&c.SchedulePolicy, "schedule-policy", c.SchedulePolicy,
+		"scheduling policy (random, pack, or spread)")
+	fs.StringVar(&c.LogLevel, "log-level", c.LogLevel, "log level (debug, info, or error)")
+	if err := parseConfig(fs, args, c); err != nil {
+		return nil, err
+	}
+	if c.JobsFile == "" {
+		c.JobsFile = filepath.Join(c.DataDir, "jobs.json")
+	}
+	if c.BuildCacheDir == "" {
+		c.BuildCacheDir = filepath.Join(c.DataDir, "build_cache")
+	}
+	if c.TaskLogDir == "" {
+		c.TaskLogDir = filepath.Join(c.DataDir, "task_logs")
+	}
+	if c.ArtifactDir == "" {
+		c.ArtifactDir = filepath.Join(c.DataDir, "artifacts")
+	}
+	if c.BlobDir == "" {
+		c.BlobDir = filepath.Join(c.DataDir, "blobs")
+	}
+	if (c.TLSCert == "") != (c.TLSKey == "") {
+		return nil, errors.New("TLS requires both a certificate and a key")
+	}
+	return c, nil
+}
+
+// SlaveConfig stores the settings for a slave.
+// It is loaded like a MasterConfig.
+type SlaveConfig struct {
+	Host string
+	Port int
+
+	// PassFile is the path to a file containing the slave
+	// password.
+	PassFile string
+
+	// TLS enables TLS for the master connection.
+	// If TLSCA is set, it is a file containing the
+	// certificate authorities to trust.
+	TLS   bool
+	TLSCA string
+
+	// Name is shown to the master and passed to jobs.
+	// It defaults to the hostname.
+	Name string
+
+	// Labels are arbitrary key-value pairs describing the
+	// slave, shown to the master.
+	Labels map[string]string
+
+	// MaxMem is the maximum memory to advertise, in MiB.
+	// If 0, the total system memory is used.
+	MaxMem int
+
+	// MaxCPU is the maximum number of CPUs to advertise.
+	// If 0, GOMAXPROCS is used.
+	MaxCPU int
+
+	// ExecCacheDir stores executables received for GoRun
+	// tasks, so that they are not transferred again.
+	// It defaults to a directory inside os.TempDir().
+	ExecCacheDir string
+
+	// ExecCacheSize is the maximum size of the executable
+	// cache, in MiB. If 0, the cache is unbounded.
+	ExecCacheSize int
+
+	// ExecCacheHours is the number of hours an executable
+	// may go unused before it is evicted. If 0, executables
+	// are only evicted to limit the cache size.
+	ExecCacheHours int
+
+	// BlobCacheDir stores the blobs received by
+	// BlobTransfer tasks. Several slaves on one machine may
+	// share it. It defaults to a directory inside
+	// os.TempDir().
+	BlobCacheDir string
+
+	// BlobCacheSize is the maximum size of the blob cache,
+	// in MiB. If 0, the cache is unbounded.
+	BlobCacheSize int
+
+	// BlobCacheHours is like ExecCacheHours, but for the
+	// blob cache.
+	BlobCacheHours int
+
+	// WorkDir stores the directories in which jobs run,
+	// including the ones which are kept after their jobs
+	// are done. It defaults to a directory inside
+	// os.TempDir().
+	WorkDir string
+
+	// WorkDirHours is the number of hours for which a job
+	// directory is kept if the job does not say.
+	// If 0, kept directories are only deleted to limit
+	// their size.
+	WorkDirHours int
+
+	// WorkDirSize is the maximum total size of the kept job
+	// directories, in MiB. If 0, it is unbounded.
+	WorkDirSize int
+
+	// WorkDirMinFree is the amount of free disk space, in
+	// MiB, below which kept job directories are deleted.
+	// If 0, free space is not checked.
+	WorkDirMinFree int
+
+	// PeerPort, if non-zero, is a port on which to serve
+	// cached blobs to other slaves, bound to PeerBind.
+	PeerPort int
+	PeerBind string
+
+	// PeerHost is the host at which other slaves can reach
+	// this one. It defaults to the address the master sees.
+	PeerHost string
+
+	// PeerTLSCert and PeerTLSKey are the certificate and
+	// key with which the slave serves other slaves when TLS
+	// is enabled. Other slaves check them like the master's
+	// certificate, so they must be valid for PeerHost.
+	PeerTLSCert string
+	PeerTLSKey  string
+
+	// Compression is like MasterConfig.Compression.
+	Compression string
+
+	LogLevel string
+}
+
+// NewSlaveConfig creates a SlaveConfig with default
+// values.
+func NewSlaveConfig() *SlaveConfig {
+	return &SlaveConfig{
+		Host:           "localhost",
+		Port:           7000,
+		Labels:         map[string]string{},
+		ExecCacheSize:  1024,
+		ExecCacheHours: 24 * 7,
+		BlobCacheSize:  10240,
+		BlobCacheHours: 24 * 7,
+		WorkDirHours:   24,
+		WorkDirSize:    10240,
+		WorkDirMinFree: 1024,
+		Compression:    jobproto.CompressionGzip,
+		LogLevel:       "info",
+	}
+}
+
+// ParseSlaveConfig is like ParseMasterConfig, but for a
+// slave.
+func ParseSlaveConfig(args []string) (*SlaveConfig, error) {
+	c := NewSlaveConfig()
+	fs := flag.NewFlagSet("slave", flag.ContinueOnError)
+	fs.StringVar(&c.Host, "host", c.Host, "master hostname")
+	fs.IntVar(&c.Port, "port", c.Port, "master port")
+	fs.StringVar(&c.PassFile, "pass-file", c.PassFile, "file containing the slave password")
+	fs.BoolVar(&c.TLS, "tls", c.TLS, "connect to the master with TLS")
+	fs.StringVar(&c.TLSCA, "tls-ca", c.TLSCA, "file with trusted TLS certificate authorities")
+	fs.StringVar(&c.Name, "name", c.Name, "slave name (default hostname)")
+	fs.Var(labelsFlag(c.Labels), "labels", "comma-separated key=value slave labels")
+	fs.IntVar(&c.MaxMem, "max-mem", c.MaxMem, "maximum memory in MiB (0 for system total)")
+	fs.IntVar(&c.MaxCPU, "max-cpu", c.MaxCPU, "maximum CPUs (0 for GOMAXPROCS)")
+	fs.StringVar(&c.ExecCacheDir, "exec-cache", c.ExecCacheDir,
+		"GoRun executable cache directory (default in the temp directory)")
+	fs.IntVar(&c.ExecCacheSize, "exec-cache-size", c.ExecCacheSize,
+		"maximum executable cache size in MiB (0 for unlimited)")
+	fs.IntVar(&c.ExecCacheHours, "exec-cache-hours", c.ExecCacheHours,
+		"hours before an unused executable is evicted (0 for never)")
+	fs.StringVar(&c.BlobCacheDir, "blob-cache", c.BlobCacheDir,
+		"blob cache directory, which may be shared (default in the temp directory)")
+	fs.IntVar(&c.BlobCacheSize, "blob-cache-size", c.BlobCacheSize,
+		"maximum blob cache size in MiB (0 for unlimited)")
+	fs.IntVar(&c.BlobCacheHours, "blob-cache-hours", c.BlobCacheHours,
+		"hours before an unused blob is evicted (0 for never)")
+	fs.StringVar(&c.WorkDir, "work-dir", c.WorkDir,
+		"job directory (default in the temp directory)")
+	fs.IntVar(&c.WorkDirHours, "work-dir-hours", c.WorkDirHours,
+		"default hours to keep job directories for (0 for no limit)")
+	fs.IntVar(&c.WorkDirSize, "work-dir-size", c.WorkDirSize,
+		"maximum size of kept job directories in MiB (0 for unlimited)")
+	fs.IntVar(&c.WorkDirMinFree, "work-dir-min-free", c.WorkDirMinFree,
+		"free disk space in MiB to keep by deleting job directories (0 to disable)")
+	fs.IntVar(&c.PeerPort, "peer-port", c.PeerPort,
+		"port to serve cached blobs to other slaves on (0 to disable)")
+	fs.StringVar(&c.PeerBind, "peer-bind", c.PeerBind, "address to listen on for other slaves")
+	fs.StringVar(&c.PeerHost, "peer-host", c.PeerHost,
+		"host other slaves should connect to (default the address the master sees)")
+	fs.StringVar(&c.PeerTLSCert, "peer-tls-cert", c.PeerTLSCert,
+		"TLS certificate file for serving other slaves")
+	fs.StringVar(&c.PeerTLSKey, "peer-tls-key", c.PeerTLSKey,
+		"TLS private key file for serving other slaves")
+	fs.StringVar(&c.Compression, "compression", c.Compression,
+		"comma-separated transfer compression algorithms (gzip or none)")
+	fs.StringVar(&c.LogLevel, "log-level", c.LogLevel, "log level (debug, info, or error)")
+	if err := parseConfig(fs, args, c); err != nil {
+		return nil, err
+	}
+	if c.ExecCacheDir == "" {
+		c.ExecCacheDir = filepath.Join(os.TempDir(), "jobempire_exec_cache")
+	}
+	if c.BlobCacheDir == "" {
+		c.BlobCacheDir = filepath.Join(os.TempDir(), "jobempire_blob_cache")
+	}
+	if c.WorkDir == "" {
+		c.WorkDir = filepath.Join(os.TempDir(), "jobempire_jobs")
+	}
+	if (c.PeerTLSCert == "") != (c.PeerTLSKey == "") {
+		return nil, errors.New("peer TLS requires both a certificate and a key")
+	}
+	if c.TLS && c.PeerPort != 0 && c.PeerTLSCert == "" {
+		return nil, errors.New("serving peers with TLS requires -peer-tls-cert and -peer-tls-key")
+	}
+	return c, nil
+}
+
+// parseConfig fills in a config struct from a config file,
+// the environment, and flags, in that order of priority.
+func parseConfig(fs *flag.FlagSet, args []string, config interface{}) error {
+	var configPath string
+	fs.StringVar(&configPath, "config", "", "JSON config file")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return fmt.Errorf("unexpected argument: %s", fs.Arg(0))
+	}
+
+	if configPath == "" {
+		configPath = os.Getenv(configEnvPrefix + "CONFIG")
+	}
+	if configPath != "" {
+		data, err := ioutil.ReadFile(configPath)
+		if err != nil {
+			return fmt.Errorf("read config: %s", err)
+		}
+		if err := json.Unmarshal(data, config); err != nil {
+			return fmt.Errorf("parse config: %s", err)
+		}
+	}
+
+	var envErr error
+	fs.VisitAll(func(f *flag.Flag) {
+		name := configEnvPrefix + strings.ToUpper(strings.Replace(f.Name, "-", "_", -1))
+		if val, ok := os.LookupEnv(name); ok && f.Name != "config" && envErr == nil {
+			if err := fs.Set(f.Name, val); err != nil {
+				envErr = fmt.Errorf("invalid %s: %s", name, err)
+			}
+		}
+	})
+	if envErr != nil {
+		return envErr
+	}
+
+	// Flags take priority over everything else.
+	return fs.Parse(args)
+}
+
+// readPassFile reads a password from a file, ignoring
+// surrounding whitespace.
+func readPassFile(path string) (string, error) {
+	if path == "" {
+		return "", errors.New("no password file specified")
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// labelsFlag is a flag.Value which adds comma-separated
+// key=value pairs to a map.
+type labelsFlag map[string]string
+
+func (l labelsFlag) String() string {
+	var pairs []string
+	for k, v := range l {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (l labelsFlag) Set(s string) error {
+	for _, pair := range strings.Split(s, ",") {
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("invalid label: %s", pair)
+		}
+		l[parts[0]] = parts[1]
+	}
+	return nil
+}
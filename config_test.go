@@ -0,0 +1,141 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSlaveConfig(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobempire_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	configPath := filepath.Join(tempDir, "config.json")
+	configData := `{"Host": "file-host", "Port": 1, "Name": "file-name",
+		"Labels": {"gpu": "file", "zone": "file"}}`
+	if err := ioutil.WriteFile(configPath, []byte(configData), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		env    map[string]string
+		args   []string
+		host   string
+		port   int
+		name   string
+		labels map[string]string
+	}{
+		{
+			args:   []string{"-config", configPath},
+			host:   "file-host",
+			port:   1,
+			name:   "file-name",
+			labels: map[string]string{"gpu": "file", "zone": "file"},
+		},
+		{
+			env: map[string]string{
+				"JOBEMPIRE_CONFIG": configPath,
+				"JOBEMPIRE_PORT":   "2",
+				"JOBEMPIRE_LABELS": "zone=env,os=env",
+			},
+			host:   "file-host",
+			port:   2,
+			name:   "file-name",
+			labels: map[string]string{"gpu": "file", "zone": "env", "os": "env"},
+		},
+		{
+			env: map[string]string{
+				"JOBEMPIRE_PORT":   "2",
+				"JOBEMPIRE_NAME":   "env-name",
+				"JOBEMPIRE_LABELS": "zone=env",
+			},
+			args:   []string{"-config", configPath, "-port", "3", "-labels", "zone=flag"},
+			host:   "file-host",
+			port:   3,
+			name:   "env-name",
+			labels: map[string]string{"gpu": "file", "zone": "flag"},
+		},
+		{
+			host:   "localhost",
+			port:   7000,
+			labels: map[string]string{},
+		},
+	}
+	for i, test := range tests {
+		restore := setConfigEnv(test.env)
+		c, err := ParseSlaveConfig(test.args)
+		restore()
+		if err != nil {
+			t.Errorf("case %d: %s", i, err)
+			continue
+		}
+		if c.Host != test.host || c.Port != test.port || c.Name != test.name {
+			t.Errorf("case %d: got host %s, port %d, name %s", i, c.Host, c.Port, c.Name)
+		}
+		if !reflect.DeepEqual(c.Labels, test.labels) {
+			t.Errorf("case %d: expected labels %v but got %v", i, test.labels, c.Labels)
+		}
+	}
+
+	for i, env := range []map[string]string{
+		{"JOBEMPIRE_PORT": "not a number"},
+		{"JOBEMPIRE_CONFIG": filepath.Join(tempDir, "missing.json")},
+		{"JOBEMPIRE_LABELS": "novalue"},
+	} {
+		restore := setConfigEnv(env)
+		_, err := ParseSlaveConfig(nil)
+		restore()
+		if err == nil {
+			t.Errorf("bad environment %d: expected an error", i)
+		}
+	}
+}
+
+func TestSlaveConfigMaxMem(t *testing.T) {
+	restore := setConfigEnv(map[string]string{"JOB_MEM_LIMIT": "123"})
+	defer restore()
+
+	c, err := ParseSlaveConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaultMem := slaveInfo(c).TotalMem
+	if defaultMem == 123 {
+		t.Error("JOB_MEM_LIMIT should be ignored")
+	}
+
+	c, err = ParseSlaveConfig([]string{"-max-mem", "456"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mem := slaveInfo(c).TotalMem; mem != 456 {
+		t.Errorf("expected 456 MiB but got %d", mem)
+	}
+}
+
+// setConfigEnv sets environment variables, clearing any
+// other JOBEMPIRE_ variables which might interfere.
+// It returns a function which restores the environment.
+func setConfigEnv(env map[string]string) func() {
+	old := os.Environ()
+	for _, pair := range old {
+		if strings.HasPrefix(pair, configEnvPrefix) {
+			os.Unsetenv(pair[:strings.IndexByte(pair, '=')])
+		}
+	}
+	for k, v := range env {
+		os.Setenv(k, v)
+	}
+	return func() {
+		os.Clearenv()
+		for _, pair := range old {
+			i := strings.IndexByte(pair, '=')
+			os.Setenv(pair[:i], pair[i+1:])
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import "strings"
+
+const (
+	diffContextLines = 3
+
+	// diffMaxCells bounds the size of the table used to
+	// compute a diff, to avoid using too much memory.
+	diffMaxCells = 1 << 24
+)
+
+// A DiffLine is one line of a line-based diff.
+type DiffLine struct {
+	// Op is "+" for an added line, "-" for a removed line,
+	// " " for an unchanged line, or "..." for a gap of
+	// unchanged lines which are not shown.
+	Op   string
+	Text string
+}
+
+// lineDiff computes a diff between two strings, showing
+// only the changed lines and some context around them.
+func lineDiff(oldStr, newStr string) []DiffLine {
+	oldLines := splitLines(oldStr)
+	newLines := splitLines(newStr)
+
+	var full []DiffLine
+	if (len(oldLines)+1)*(len(newLines)+1) > diffMaxCells {
+		for _, l := range oldLines {
+			full = append(full, DiffLine{Op: "-", Text: l})
+		}
+		for _, l := range newLines {
+			full = append(full, DiffLine{Op: "+", Text: l})
+		}
+	} else {
+		full = lcsDiff(oldLines, newLines)
+	}
+
+	show := make([]bool, len(full))
+	for i, l := range full {
+		if l.Op == " " {
+			continue
+		}
+		for j := i - diffContextLines; j <= i+diffContextLines; j++ {
+			if j >= 0 && j < len(full) {
+				show[j] = true
+			}
+		}
+	}
+
+	var res []DiffLine
+	for i, l := range full {
+		if show[i] {
+			res = append(res, l)
+		} else if len(res) == 0 || res[len(res)-1].Op != "..." {
+			res = append(res, DiffLine{Op: "..."})
+		}
+	}
+	return res
+}
+
+func lcsDiff(oldLines, newLines []string) []DiffLine {
+	// table[i][j] is the LCS length of oldLines[i:] and
+	// newLines[j:].
+	table := make([][]int, len(oldLines)+1)
+	for i := range table {
+		table[i] = make([]int, len(newLines)+1)
+	}
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else if table[i+1][j] > table[i][j+1] {
+				table[i][j] = table[i+1][j]
+			} else {
+				table[i][j] = table[i][j+1]
+			}
+		}
+	}
+
+	var res []DiffLine
+	var i, j int
+	for i < len(oldLines) || j < len(newLines) {
+		if i < len(oldLines) && j < len(newLines) && oldLines[i] == newLines[j] {
+			res = append(res, DiffLine{Op: " ", Text: oldLines[i]})
+			i++
+			j++
+		} else if i < len(oldLines) && (j == len(newLines) || table[i+1][j] >= table[i][j+1]) {
+			res = append(res, DiffLine{Op: "-", Text: oldLines[i]})
+			i++
+		} else {
+			res = append(res, DiffLine{Op: "+", Text: newLines[j]})
+			j++
+		}
+	}
+	return res
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
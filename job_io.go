@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/unixpickle/jobempire/jobadmin"
+	"gopkg.in/yaml.v2"
+)
+
+var errEmptyJobFile = errors.New("empty job file")
+
+// A JobChange describes one change that applying a job
+// file makes to the job pool.
+type JobChange struct {
+	// Action is "create", "update", "delete", or
+	// "unchanged".
+	Action string
+
+	Key  string
+	Name string
+	Diff []DiffLine
+}
+
+// parseJobFormat determines the format of a job file from
+// an explicit format name or, failing that, a file name.
+func parseJobFormat(format, filename string) (string, error) {
+	if format == "" {
+		if strings.HasSuffix(filename, ".yaml") || strings.HasSuffix(filename, ".yml") {
+			format = "yaml"
+		} else {
+			format = "json"
+		}
+	}
+	switch format {
+	case "json":
+		return "json", nil
+	case "yaml", "yml":
+		return "yaml", nil
+	default:
+		return "", fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+// decodeJobFile decodes a list of jobs, or a single job,
+// in the given format.
+//
+// An empty file is an error rather than an empty list, so
+// that a missing upload cannot delete every keyed job.
+// An explicitly empty list, like [], is allowed.
+func decodeJobFile(data []byte, format string) ([]*jobadmin.Job, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, errEmptyJobFile
+	}
+	if format == "yaml" {
+		var obj interface{}
+		if err := yaml.Unmarshal(data, &obj); err != nil {
+			return nil, err
+		} else if obj == nil {
+			return nil, errEmptyJobFile
+		}
+		var err error
+		data, err = json.Marshal(yamlToJSON(obj))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var jobs []*jobadmin.Job
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		var job jobadmin.Job
+		if json.Unmarshal(data, &job) != nil {
+			return nil, err
+		}
+		jobs = []*jobadmin.Job{&job}
+	}
+	if jobs == nil {
+		return nil, errEmptyJobFile
+	}
+	for i, j := range jobs {
+		if j == nil {
+			return nil, fmt.Errorf("job %d is null", i)
+		}
+	}
+	return jobs, nil
+}
+
+// encodeJobFile encodes jobs in the given format.
+// Job IDs are omitted, since they are specific to one
+// master's job pool.
+func encodeJobFile(jobs []*jobadmin.Job, format string) ([]byte, error) {
+	data, err := json.Marshal(jobs)
+	if err != nil {
+		return nil, err
+	}
+	var objs []map[string]interface{}
+	if err := json.Unmarshal(data, &objs); err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		delete(obj, "ID")
+	}
+	if format == "json" {
+		return json.MarshalIndent(objs, "", "  ")
+	}
+	return yaml.Marshal(stripNulls(objs))
+}
+
+// planApply computes the job pool that results from
+// applying a job file to the current pool.
+//
+// Every job in the file must have a unique Key.
+// Jobs in the pool are matched with jobs in the file by
+// their Key; pool jobs with a Key that is not in the file
+// are deleted, while jobs without a Key are left alone.
+func planApply(current, desired []*jobadmin.Job) ([]*JobChange, []*jobadmin.Job, error) {
+	desiredKeys := map[string]*jobadmin.Job{}
+	for i, j := range desired {
+		if j.Key == "" {
+			return nil, nil, fmt.Errorf("job %d has no key", i)
+		} else if desiredKeys[j.Key] != nil {
+			return nil, nil, fmt.Errorf("duplicate key: %s", j.Key)
+		}
+		desiredKeys[j.Key] = j
+	}
+
+	var changes []*JobChange
+	var pool []*jobadmin.Job
+	seen := map[string]bool{}
+	for _, j := range current {
+		if j.Key == "" {
+			pool = append(pool, j)
+			continue
+		}
+		seen[j.Key] = true
+		newJob := desiredKeys[j.Key]
+		if newJob == nil {
+			changes = append(changes, &JobChange{
+				Action: "delete",
+				Key:    j.Key,
+				Name:   j.Name,
+				Diff:   jobDiff(j, nil),
+			})
+			continue
+		}
+		newJob, err := newJob.Copy()
+		if err != nil {
+			return nil, nil, err
+		}
+		newJob.ID = j.ID
+		pool = append(pool, newJob)
+		change := &JobChange{
+			Action: "update",
+			Key:    j.Key,
+			Name:   newJob.Name,
+			Diff:   jobDiff(j, newJob),
+		}
+		if len(change.Diff) == 0 {
+			change.Action = "unchanged"
+		}
+		changes = append(changes, change)
+	}
+
+	for _, j := range desired {
+		if seen[j.Key] {
+			continue
+		}
+		newJob, err := j.Copy()
+		if err != nil {
+			return nil, nil, err
+		}
+		newJob.ID, err = newJobID()
+		if err != nil {
+			return nil, nil, err
+		}
+		pool = append(pool, newJob)
+		changes = append(changes, &JobChange{
+			Action: "create",
+			Key:    j.Key,
+			Name:   j.Name,
+			Diff:   jobDiff(nil, newJob),
+		})
+	}
+
+	return changes, pool, nil
+}
+
+// jobDiff diffs the JSON of two jobs, ignoring their IDs.
+// Either job may be nil.
+func jobDiff(oldJob, newJob *jobadmin.Job) []DiffLine {
+	encode := func(j *jobadmin.Job) string {
+		if j == nil {
+			return ""
+		}
+		c := *j
+		c.ID = ""
+		// Copied jobs have an empty task list rather than a
+		// nil one, which should not count as a change.
+		if c.Tasks == nil {
+			c.Tasks = []*jobadmin.Task{}
+		}
+		data, err := json.MarshalIndent(&c, "", "  ")
+		if err != nil {
+			return err.Error()
+		}
+		return string(data)
+	}
+	oldStr, newStr := encode(oldJob), encode(newJob)
+	if oldStr == newStr {
+		return nil
+	}
+	return lineDiff(oldStr, newStr)
+}
+
+// yamlToJSON converts the maps produced by the YAML
+// decoder into maps which can be encoded as JSON.
+func yamlToJSON(obj interface{}) interface{} {
+	switch obj := obj.(type) {
+	case map[interface{}]interface{}:
+		res := map[string]interface{}{}
+		for k, v := range obj {
+			res[fmt.Sprint(k)] = yamlToJSON(v)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(obj))
+		for i, x := range obj {
+			res[i] = yamlToJSON(x)
+		}
+		return res
+	default:
+		return obj
+	}
+}
+
+// stripNulls removes null fields from decoded JSON, which
+// makes exported YAML easier to read.
+func stripNulls(obj interface{}) interface{} {
+	switch obj := obj.(type) {
+	case []map[string]interface{}:
+		res := make([]interface{}, len(obj))
+		for i, x := range obj {
+			res[i] = stripNulls(x)
+		}
+		return res
+	case map[string]interface{}:
+		res := map[string]interface{}{}
+		for k, v := range obj {
+			if v != nil {
+				res[k] = stripNulls(v)
+			}
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(obj))
+		for i, x := range obj {
+			res[i] = stripNulls(x)
+		}
+		return res
+	default:
+		return obj
+	}
+}
+
+// checkNewKeys makes sure that none of the jobs have a
+// Key which is already used in the pool.
+func checkNewKeys(pool, jobs []*jobadmin.Job) error {
+	keys := map[string]bool{}
+	for _, j := range pool {
+		if j.Key != "" {
+			keys[j.Key] = true
+		}
+	}
+	for _, j := range jobs {
+		if j.Key == "" {
+			continue
+		}
+		if keys[j.Key] {
+			return errors.New("key already in use: " + j.Key)
+		}
+		keys[j.Key] = true
+	}
+	return nil
+}
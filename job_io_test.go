@@ -0,0 +1,153 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/unixpickle/jobempire/jobadmin"
+)
+
+func TestDecodeJobFile(t *testing.T) {
+	valid := []struct {
+		data   string
+		format string
+		names  []string
+	}{
+		{`[{"Name": "a", "Key": "x"}, {"Name": "b"}]`, "json", []string{"a", "b"}},
+		{`{"Name": "single"}`, "json", []string{"single"}},
+		{"- Name: a\n  Key: x\n- Name: b\n", "yaml", []string{"a", "b"}},
+		{"Name: single\n", "yaml", []string{"single"}},
+		{"[]", "json", nil},
+		{"[]\n", "yaml", nil},
+	}
+	for i, test := range valid {
+		jobs, err := decodeJobFile([]byte(test.data), test.format)
+		if err != nil {
+			t.Errorf("case %d: %s", i, err)
+			continue
+		}
+		if len(jobs) != len(test.names) {
+			t.Errorf("case %d: expected %d jobs but got %d", i, len(test.names), len(jobs))
+			continue
+		}
+		for j, name := range test.names {
+			if jobs[j].Name != name {
+				t.Errorf("case %d: job %d should be %s but got %s", i, j, name, jobs[j].Name)
+			}
+		}
+	}
+
+	invalid := []struct {
+		data   string
+		format string
+	}{
+		{"", "json"},
+		{"", "yaml"},
+		{" \n\t", "json"},
+		{"# only a comment\n", "yaml"},
+		{"null", "json"},
+		{"[null]", "json"},
+		{"not json", "json"},
+	}
+	for i, test := range invalid {
+		if _, err := decodeJobFile([]byte(test.data), test.format); err == nil {
+			t.Errorf("case %d: expected an error for %q", i, test.data)
+		}
+	}
+}
+
+func TestPlanApply(t *testing.T) {
+	current := []*jobadmin.Job{
+		{ID: "1", Name: "unkeyed"},
+		{ID: "2", Name: "same", Key: "same", Priority: 1, MaxInstances: 1},
+		{ID: "3", Name: "changed", Key: "changed", Priority: 1, MaxInstances: 1},
+		{ID: "4", Name: "removed", Key: "removed"},
+	}
+	desired := []*jobadmin.Job{
+		{Name: "same", Key: "same", Priority: 1, MaxInstances: 1},
+		{Name: "changed", Key: "changed", Priority: 2, MaxInstances: 1},
+		{Name: "new", Key: "new"},
+	}
+	changes, pool, err := planApply(current, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actions := map[string]string{}
+	for _, c := range changes {
+		actions[c.Key] = c.Action
+	}
+	expected := map[string]string{
+		"same":    "unchanged",
+		"changed": "update",
+		"removed": "delete",
+		"new":     "create",
+	}
+	if len(actions) != len(expected) {
+		t.Errorf("unexpected changes: %v", actions)
+	}
+	for key, action := range expected {
+		if actions[key] != action {
+			t.Errorf("key %s: expected %s but got %s", key, action, actions[key])
+		}
+	}
+
+	byName := map[string]*jobadmin.Job{}
+	for _, j := range pool {
+		byName[j.Name] = j
+	}
+	if len(pool) != 4 || byName["removed"] != nil {
+		t.Fatalf("unexpected pool: %v", pool)
+	}
+	if byName["unkeyed"] != current[0] {
+		t.Error("unkeyed job should be kept as is")
+	}
+	if j := byName["changed"]; j.ID != "3" || j.Priority != 2 {
+		t.Errorf("bad updated job: %+v", j)
+	}
+	if j := byName["new"]; j.ID == "" {
+		t.Error("created job should have an ID")
+	}
+	if desired[2].ID != "" {
+		t.Error("desired jobs should not be modified")
+	}
+
+	for i, bad := range [][]*jobadmin.Job{
+		{{Name: "no key"}},
+		{{Name: "a", Key: "dup"}, {Name: "b", Key: "dup"}},
+	} {
+		if _, _, err := planApply(current, bad); err == nil {
+			t.Errorf("case %d: expected an error", i)
+		}
+	}
+}
+
+func TestReadJobFile(t *testing.T) {
+	body := "- Name: a\n  Key: x\n"
+	for _, contentType := range []string{"application/yaml", "application/x-www-form-urlencoded"} {
+		r, err := http.NewRequest("POST", "/jobs/apply?format=yaml&dryrun=1",
+			strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Header.Set("Content-Type", contentType)
+		jobs, err := (&MasterHandler{}).readJobFile(r)
+		if err != nil {
+			t.Errorf("%s: %s", contentType, err)
+		} else if len(jobs) != 1 || jobs[0].Key != "x" {
+			t.Errorf("%s: unexpected jobs: %v", contentType, jobs)
+		}
+		if r.FormValue("dryrun") == "" {
+			t.Errorf("%s: lost query parameters", contentType)
+		}
+	}
+
+	r, err := http.NewRequest("POST", "/jobs/apply", strings.NewReader(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&MasterHandler{}).readJobFile(r); err == nil {
+		t.Error("expected an error for an empty body")
+	}
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/unixpickle/jobempire/jobadmin"
+)
+
+const (
+	jobVersionPrefix = "jobs-"
+	jobVersionSuffix = ".json"
+)
+
+// A JobStore persists the job pool to a file.
+//
+// Writes are atomic, so a crash never leaves a partially
+// written file behind.
+// Before every write, the previous version of the file is
+// saved in a history directory so it can be restored.
+type JobStore struct {
+	Path       string
+	HistoryDir string
+
+	// MaxBackups is the maximum number of old versions to
+	// keep in HistoryDir.
+	MaxBackups int
+
+	// lastHash is the hash of the file as of the last time
+	// it was loaded or saved.
+	lastHash [sha256.Size]byte
+}
+
+// A JobVersion identifies a saved version of the job pool.
+type JobVersion struct {
+	ID   string
+	Time time.Time
+}
+
+// Load reads the current job pool.
+// If the file does not exist, it returns an empty pool.
+func (j *JobStore) Load() ([]*jobadmin.Job, error) {
+	contents, err := ioutil.ReadFile(j.Path)
+	if os.IsNotExist(err) {
+		j.lastHash = sha256.Sum256(nil)
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	jobs, err := decodeJobs(contents)
+	if err != nil {
+		return nil, err
+	}
+	j.lastHash = sha256.Sum256(contents)
+	return jobs, nil
+}
+
+// Reload is like Load, but it fails if the file is
+// missing or does not contain a list of jobs.
+// This way, a file which is briefly removed or replaced
+// while it is being deployed does not clear the pool.
+//
+// Until a reload succeeds, Changed keeps reporting that
+// the file has changed.
+func (j *JobStore) Reload() ([]*jobadmin.Job, error) {
+	contents, err := ioutil.ReadFile(j.Path)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := decodeJobs(contents)
+	if err != nil {
+		return nil, err
+	} else if jobs == nil {
+		return nil, errors.New("jobs file does not contain a list of jobs")
+	}
+	j.lastHash = sha256.Sum256(contents)
+	return jobs, nil
+}
+
+// Changed returns true if the file has been modified by
+// somebody else since it was last loaded or saved.
+func (j *JobStore) Changed() (bool, error) {
+	contents, err := ioutil.ReadFile(j.Path)
+	if os.IsNotExist(err) {
+		contents = nil
+	} else if err != nil {
+		return false, err
+	}
+	return sha256.Sum256(contents) != j.lastHash, nil
+}
+
+// Save atomically replaces the job pool file, backing up
+// the previous version first.
+func (j *JobStore) Save(jobs []*jobadmin.Job) error {
+	encoded, err := json.MarshalIndent(jobs, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := j.backup(); err != nil {
+		return err
+	}
+	if err := writeFileAtomic(j.Path, encoded); err != nil {
+		return err
+	}
+	j.lastHash = sha256.Sum256(encoded)
+	return j.prune()
+}
+
+// Versions returns the backed up versions of the job
+// pool, newest first.
+func (j *JobStore) Versions() ([]*JobVersion, error) {
+	listing, err := ioutil.ReadDir(j.HistoryDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var res []*JobVersion
+	for _, info := range listing {
+		name := info.Name()
+		if !strings.HasPrefix(name, jobVersionPrefix) ||
+			!strings.HasSuffix(name, jobVersionSuffix) {
+			continue
+		}
+		id := name[len(jobVersionPrefix) : len(name)-len(jobVersionSuffix)]
+		nanos, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			continue
+		}
+		res = append(res, &JobVersion{ID: id, Time: time.Unix(0, nanos)})
+	}
+	sort.Slice(res, func(i, k int) bool {
+		return res[i].Time.After(res[k].Time)
+	})
+	return res, nil
+}
+
+// ReadVersion reads the raw contents of a backed up
+// version.
+// If id is "", it reads the current version.
+func (j *JobStore) ReadVersion(id string) ([]byte, error) {
+	if id == "" {
+		data, err := ioutil.ReadFile(j.Path)
+		if os.IsNotExist(err) {
+			return []byte{}, nil
+		}
+		return data, err
+	}
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		return nil, errors.New("invalid version: " + id)
+	}
+	return ioutil.ReadFile(j.versionPath(id))
+}
+
+// LoadVersion reads the job pool from a backed up
+// version.
+func (j *JobStore) LoadVersion(id string) ([]*jobadmin.Job, error) {
+	data, err := j.ReadVersion(id)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJobs(data)
+}
+
+func (j *JobStore) backup() error {
+	current, err := ioutil.ReadFile(j.Path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(j.HistoryDir, 0755); err != nil {
+		return err
+	}
+	id := strconv.FormatInt(time.Now().UnixNano(), 10)
+	return writeFileAtomic(j.versionPath(id), current)
+}
+
+func (j *JobStore) prune() error {
+	versions, err := j.Versions()
+	if err != nil {
+		return err
+	}
+	for i := j.MaxBackups; i < len(versions); i++ {
+		if err := os.Remove(j.versionPath(versions[i].ID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *JobStore) versionPath(id string) string {
+	return filepath.Join(j.HistoryDir, jobVersionPrefix+id+jobVersionSuffix)
+}
+
+// writeFileAtomic replaces a file by writing a temporary
+// file next to it and renaming it into place, so that the
+// file is never left partially written.
+func writeFileAtomic(path string, data []byte) error {
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	tempPath := tempFile.Name()
+	if _, err := tempFile.Write(data); err != nil {
+		tempFile.Close()
+		os.Remove(tempPath)
+		return err
+	}
+	if err := tempFile.Sync(); err != nil {
+		tempFile.Close()
+		os.Remove(tempPath)
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	if err := os.Chmod(tempPath, 0644); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	return nil
+}
+
+func decodeJobs(data []byte) ([]*jobadmin.Job, error) {
+	var jobs []*jobadmin.Job
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/unixpickle/jobempire/jobadmin"
+)
+
+func TestJobStoreSave(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobempire_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	store := testJobStore(tempDir, 2)
+
+	if jobs, err := store.Load(); err != nil || len(jobs) != 0 {
+		t.Fatalf("unexpected initial pool: %v %v", jobs, err)
+	}
+	for _, name := range []string{"v0", "v1", "v2", "v3"} {
+		if err := store.Save(testJobPool(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkJobPool(t, "current", store.Load, "v3")
+
+	versions, err := store.Versions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 2 {
+		t.Fatalf("expected 2 versions but got %d", len(versions))
+	}
+	if !versions[0].Time.After(versions[1].Time) {
+		t.Error("versions should be sorted newest first")
+	}
+	for i, name := range []string{"v2", "v1"} {
+		id := versions[i].ID
+		checkJobPool(t, "version "+id, func() ([]*jobadmin.Job, error) {
+			return store.LoadVersion(id)
+		}, name)
+	}
+	if _, err := store.ReadVersion("../jobs"); err == nil {
+		t.Error("expected error for invalid version")
+	}
+
+	for _, dir := range []string{tempDir, store.HistoryDir} {
+		listing, err := ioutil.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, info := range listing {
+			if strings.Contains(info.Name(), ".tmp") {
+				t.Errorf("temporary file left behind: %s", info.Name())
+			}
+		}
+	}
+}
+
+func TestRollbackJobs(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobempire_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	handler := &MasterHandler{
+		Scheduler: jobadmin.NewScheduler(),
+		JobStore:  testJobStore(tempDir, 10),
+	}
+	defer handler.Scheduler.Terminate()
+
+	for _, name := range []string{"v0", "v1"} {
+		if err := handler.saveJobs(testJobPool(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Rolling back twice should restore the original pool,
+	// since each rollback backs up the pool it replaces.
+	for _, name := range []string{"v0", "v1"} {
+		versions, err := handler.JobStore.Versions()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := handler.rollbackJobs(versions[0].ID); err != nil {
+			t.Fatal(err)
+		}
+		checkJobPool(t, "scheduler", handler.Scheduler.Jobs, name)
+		checkJobPool(t, "file", handler.JobStore.Load, name)
+	}
+}
+
+func testJobStore(dir string, maxBackups int) *JobStore {
+	return &JobStore{
+		Path:       filepath.Join(dir, "jobs.json"),
+		HistoryDir: filepath.Join(dir, "job_history"),
+		MaxBackups: maxBackups,
+	}
+}
+
+// testJobPool creates a pool with a single job, which is
+// named to identify the pool.
+func testJobPool(name string) []*jobadmin.Job {
+	return []*jobadmin.Job{{ID: "job", Name: name, MaxInstances: 1}}
+}
+
+// checkJobPool checks that a pool was created by
+// testJobPool with the given name.
+func checkJobPool(t *testing.T, desc string, load func() ([]*jobadmin.Job, error),
+	name string) {
+	jobs, err := load()
+	if err != nil {
+		t.Errorf("%s: %s", desc, err)
+	} else if len(jobs) != 1 || jobs[0].Name != name {
+		t.Errorf("%s: expected pool %s but got %v", desc, name, jobs)
+	}
+}
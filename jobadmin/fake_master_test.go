@@ -0,0 +1,168 @@
+package jobadmin
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/unixpickle/jobempire/jobproto"
+)
+
+// A fakeMaster is a jobproto.Master which runs jobs
+// without a slave.
+//
+// Its jobs only run *jobproto.GoRun tasks, which are named
+// by their GoSourceDir and log each of their Arguments to
+// stdout.
+// Tasks whose names start with "fail" fail, and tasks
+// whose names start with "wait" run until they are
+// cancelled.
+type fakeMaster struct {
+	info   jobproto.SlaveInfo
+	closed chan struct{}
+
+	closeOnce sync.Once
+
+	jobsLock sync.Mutex
+	jobs     []*fakeMasterJob
+}
+
+func newFakeMaster(name string) *fakeMaster {
+	return &fakeMaster{
+		info:   jobproto.SlaveInfo{Name: name, MaxProcs: 1, TotalMem: 1024},
+		closed: make(chan struct{}),
+	}
+}
+
+func (f *fakeMaster) SlaveInfo() jobproto.SlaveInfo {
+	return f.info
+}
+
+func (f *fakeMaster) StartJob() (jobproto.MasterJob, error) {
+	return f.StartJobInfo(jobproto.JobInfo{})
+}
+
+func (f *fakeMaster) StartJobInfo(info jobproto.JobInfo) (jobproto.MasterJob, error) {
+	select {
+	case <-f.closed:
+		return nil, errors.New("master closed")
+	default:
+	}
+	info.SlaveName = f.info.Name
+	job := &fakeMasterJob{info: info, closed: make(chan struct{})}
+	f.jobsLock.Lock()
+	f.jobs = append(f.jobs, job)
+	f.jobsLock.Unlock()
+	return job, nil
+}
+
+// Jobs returns the jobs which have been started.
+func (f *fakeMaster) Jobs() []*fakeMasterJob {
+	f.jobsLock.Lock()
+	defer f.jobsLock.Unlock()
+	return append([]*fakeMasterJob{}, f.jobs...)
+}
+
+func (f *fakeMaster) Wait() {
+	<-f.closed
+}
+
+func (f *fakeMaster) Close() error {
+	f.closeOnce.Do(func() {
+		close(f.closed)
+	})
+	return nil
+}
+
+// A fakeMasterJob is a job created by a fakeMaster.
+type fakeMasterJob struct {
+	info   jobproto.JobInfo
+	closed chan struct{}
+
+	lock      sync.Mutex
+	ran       []string
+	finished  bool
+	failed    bool
+	closeOnce sync.Once
+}
+
+// Ran returns the names of the tasks which were started,
+// in the order they were started.
+func (f *fakeMasterJob) Ran() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]string{}, f.ran...)
+}
+
+// Outcome returns the arguments to Finish.
+func (f *fakeMasterJob) Outcome() (finished, failed bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.finished, f.failed
+}
+
+func (f *fakeMasterJob) Close() error {
+	f.closeOnce.Do(func() {
+		close(f.closed)
+	})
+	return nil
+}
+
+func (f *fakeMasterJob) Finish(failed bool) error {
+	f.lock.Lock()
+	f.finished = true
+	f.failed = failed
+	f.lock.Unlock()
+	return f.Close()
+}
+
+func (f *fakeMasterJob) Run(t jobproto.Task, log chan<- jobproto.LogEntry) error {
+	_, err := f.RunWith(t, log, jobproto.RunOptions{})
+	return err
+}
+
+func (f *fakeMasterJob) RunWith(t jobproto.Task, log chan<- jobproto.LogEntry,
+	opts jobproto.RunOptions) (*jobproto.TaskResult, error) {
+	task, ok := t.(*jobproto.GoRun)
+	if !ok {
+		return nil, errors.New("unsupported task")
+	}
+	f.lock.Lock()
+	f.ran = append(f.ran, task.GoSourceDir)
+	f.lock.Unlock()
+
+	if log != nil {
+		for i, arg := range task.Arguments {
+			log <- jobproto.LogEntry{
+				Message: arg,
+				Time:    time.Now(),
+				Stream:  jobproto.StreamStdout,
+				Seq:     i,
+			}
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(task.GoSourceDir, "fail"):
+		return &jobproto.TaskResult{}, errors.New(task.GoSourceDir + " failed")
+	case strings.HasPrefix(task.GoSourceDir, "wait"):
+		select {
+		case <-opts.Cancel:
+			return nil, jobproto.ErrTaskCancelled
+		case <-f.closed:
+			return nil, errors.New("job closed")
+		}
+	}
+	return &jobproto.TaskResult{}, nil
+}
+
+// fakeTask creates a Task for a fakeMasterJob which logs
+// each line of log.
+func fakeTask(name, log string) *Task {
+	task := &jobproto.GoRun{GoSourceDir: name}
+	if log != "" {
+		task.Arguments = strings.Split(log, "\n")
+	}
+	return &Task{Task: task}
+}
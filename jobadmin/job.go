@@ -1,6 +1,11 @@
 package jobadmin
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/unixpickle/jobempire/jobproto"
+)
 
 // A Job stores static information about a job and about
 // the way in which the job should be deployed.
@@ -13,6 +18,13 @@ type Job struct {
 	// uniqueness would probably help users.
 	Name string
 
+	// Key stores an optional, user-chosen identifier.
+	// Unlike ID, the Key is kept when jobs are exported and
+	// imported, so it can be used to match jobs in a file
+	// with jobs in a pool.
+	// If set, it must be unique in a pool of jobs.
+	Key string
+
 	// Tasks stores a sequential list of task descriptions
 	// to be run in the job.
 	Tasks []*Task
@@ -55,6 +67,16 @@ type Job struct {
 	//
 	// This may be 0 for jobs that are not memory bound.
 	MemUsage int
+
+	// KeepDir decides if slaves keep the job's directory
+	// after the job is done, so that it can be inspected.
+	// It is one of the jobproto.KeepDir constants.
+	KeepDir string
+
+	// KeepHours is the number of hours for which a kept
+	// directory is kept.
+	// A value of 0 means the slave's default is used.
+	KeepHours int
 }
 
 // Copy creates a deep copy of the Job.
@@ -73,6 +95,20 @@ func (j *Job) Copy() (*Job, error) {
 	return &res, nil
 }
 
+// CheckKeepDir makes sure the job's directory retention
+// settings are valid.
+func (j *Job) CheckKeepDir() error {
+	switch j.KeepDir {
+	case jobproto.KeepDirNever, jobproto.KeepDirOnFailure, jobproto.KeepDirAlways:
+	default:
+		return errors.New("unknown directory policy: " + j.KeepDir)
+	}
+	if j.KeepHours < 0 {
+		return errors.New("negative directory retention hours")
+	}
+	return nil
+}
+
 // Unbounded returns true if the job will be scheduled
 // an infinite number of times and cause problems.
 func (j *Job) Unbounded() bool {
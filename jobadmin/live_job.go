@@ -2,6 +2,7 @@ package jobadmin
 
 import (
 	"fmt"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -12,11 +13,18 @@ import (
 // of a job, including its live tasks.
 type LiveJob struct {
 	job       *Job
+	instance  int
+	runID     string
+	slaveName string
 	masterJob jobproto.MasterJob
 	startTime time.Time
 
+	cancelOnce sync.Once
+	cancelled  chan struct{}
+
 	tasksLock sync.RWMutex
 	tasks     []*LiveTask
+	taskPaths [][]int
 	tasksNote nextNotifier
 
 	resLock  sync.RWMutex
@@ -27,13 +35,27 @@ type LiveJob struct {
 // RunLiveJob launches a job on the Master.
 // The job's tasks will automatically be run as LiveTasks.
 func RunLiveJob(m jobproto.Master, j *Job) (*LiveJob, error) {
+	return RunLiveJobInstance(m, j, 0)
+}
+
+// RunLiveJobInstance is like RunLiveJob, but it specifies
+// the instance number to report in the job's JobInfo.
+func RunLiveJobInstance(m jobproto.Master, j *Job, instance int) (*LiveJob, error) {
 	jobCopy, err := j.Copy()
 	if err != nil {
 		return nil, fmt.Errorf("copy Job: %s", err)
 	}
 
 	startTime := time.Now()
-	masterJob, err := m.StartJob()
+	runID := newRunID(startTime)
+	masterJob, err := m.StartJobInfo(jobproto.JobInfo{
+		JobID:     j.ID,
+		JobName:   j.Name,
+		RunID:     runID,
+		Instance:  instance,
+		KeepDir:   j.KeepDir,
+		KeepHours: j.KeepHours,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("start job: %s", err)
 	}
@@ -41,7 +63,11 @@ func RunLiveJob(m jobproto.Master, j *Job) (*LiveJob, error) {
 	lj := &LiveJob{
 		startTime: startTime,
 		job:       jobCopy,
+		instance:  instance,
+		runID:     runID,
+		slaveName: m.SlaveInfo().Name,
 		masterJob: masterJob,
+		cancelled: make(chan struct{}),
 	}
 	go lj.runJob()
 	return lj, nil
@@ -55,6 +81,18 @@ func (l *LiveJob) Job() *Job {
 	return l.job
 }
 
+// Instance returns the instance number of the job.
+func (l *LiveJob) Instance() int {
+	return l.instance
+}
+
+// RunID returns an identifier which is unique to this run
+// of the job.
+// It is used to store the job's artifacts.
+func (l *LiveJob) RunID() string {
+	return l.runID
+}
+
 // Running returns whether or not the job is running.
 func (l *LiveJob) Running() bool {
 	return !l.tasksNote.Closed()
@@ -66,6 +104,9 @@ func (l *LiveJob) Running() bool {
 // will only end once the current task discovers that the
 // job has been disconnected.
 func (l *LiveJob) Cancel() {
+	l.cancelOnce.Do(func() {
+		close(l.cancelled)
+	})
 	l.masterJob.Close()
 }
 
@@ -131,31 +172,193 @@ func (l *LiveJob) EndTime() time.Time {
 	return l.endTime
 }
 
+// A LiveTaskEntry is a node in the tree of tasks returned
+// by LiveJob.TaskTree.
+type LiveTaskEntry struct {
+	// Task is the run of a task, or nil for a group.
+	Task *LiveTask
+
+	// Index is the index of Task in LiveJob.Tasks.
+	Index int
+
+	// Group is the task group, or nil for a task.
+	Group *TaskGroup
+
+	// Entries are the runs of the tasks in a group.
+	Entries []*LiveTaskEntry
+}
+
+// TaskTree returns the tasks which have been run or are
+// running, arranged in the job's task groups.
+// Each attempt at a task is a separate entry.
+//
+// The caller should not modify the result.
+func (l *LiveJob) TaskTree() []*LiveTaskEntry {
+	l.tasksLock.RLock()
+	defer l.tasksLock.RUnlock()
+	root := &LiveTaskEntry{}
+	groups := map[string]*LiveTaskEntry{}
+	for i, task := range l.tasks {
+		path := l.taskPaths[i]
+		parent := root
+		tasks := l.job.Tasks
+		for depth, idx := range path[:len(path)-1] {
+			key := fmt.Sprint(path[:depth+1])
+			group, ok := groups[key]
+			if !ok {
+				group = &LiveTaskEntry{Group: tasks[idx].Group}
+				groups[key] = group
+				parent.Entries = append(parent.Entries, group)
+			}
+			parent = group
+			tasks = group.Group.Tasks
+		}
+		parent.Entries = append(parent.Entries, &LiveTaskEntry{Task: task, Index: i})
+	}
+	return root.Entries
+}
+
+// discard deletes the logs of the job's tasks.
+// It should only be called once the job is done.
+func (l *LiveJob) discard() {
+	for _, t := range l.Tasks(0, l.TaskCount()) {
+		t.discard()
+	}
+}
+
 func (l *LiveJob) runJob() {
-	for _, t := range l.job.Tasks {
-		lt, err := RunLiveTask(l.masterJob, t)
-		if err != nil {
-			l.done(err)
-			return
+	l.done(l.runTasks(l.job.Tasks, nil))
+}
+
+// runTasks runs tasks one after another.
+// After a task fails, only the tasks which should run on
+// failure are run, and the first error is returned.
+// The path locates the tasks within the job's groups.
+func (l *LiveJob) runTasks(tasks []*Task, path []int) error {
+	var firstErr error
+	for i, t := range tasks {
+		if !t.ShouldRun(firstErr != nil) {
+			continue
+		}
+		err := l.runTask(t, childPath(path, i), l.cancelled, firstErr != nil)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// runTask runs a task or task group.
+// Tasks are retried according to their RetryRules, and
+// each attempt is added to the job's list of tasks.
+//
+// If the cancel channel is closed, the task is stopped.
+// The failed argument tells the tasks in groups whether
+// an earlier task in the job has failed.
+func (l *LiveJob) runTask(t *Task, path []int, cancel <-chan struct{}, failed bool) error {
+	if t.Group != nil {
+		return l.runGroup(t.Group, path, cancel, failed)
+	}
+	for retries := 0; ; retries++ {
+		select {
+		case <-cancel:
+			return fmt.Errorf("task error: %s", jobproto.ErrTaskCancelled)
+		default:
 		}
 		l.tasksLock.Lock()
+		lt, err := runLiveTask(l.masterJob, t, TaskLogInfo{
+			JobID:     l.job.ID,
+			JobName:   l.job.Name,
+			RunID:     l.runID,
+			SlaveName: l.slaveName,
+			TaskIndex: len(l.tasks),
+		})
+		if err != nil {
+			l.tasksLock.Unlock()
+			return err
+		}
 		l.tasks = append(l.tasks, lt)
+		l.taskPaths = append(l.taskPaths, path)
 		l.tasksLock.Unlock()
 		l.tasksNote.Notify()
-		lt.Wait(nil)
-		if err := lt.Error(); err != nil {
-			l.done(fmt.Errorf("task error: %s", err))
-			return
+		lt.Wait(cancel)
+		if lt.Running() {
+			lt.Cancel()
+			lt.Wait(nil)
+		}
+		err = lt.Error()
+		if err == nil {
+			return nil
+		}
+		if t.Retry == nil || !t.Retry.ShouldRetry(lt.Result(), retries) {
+			return fmt.Errorf("task error: %s", err)
+		}
+		select {
+		case <-cancel:
+			return fmt.Errorf("task error: %s", err)
+		case <-time.After(time.Duration(t.Retry.Delay) * time.Second):
 		}
 	}
-	l.done(nil)
+}
+
+// runGroup runs the tasks in a group concurrently and
+// waits for all of them to finish.
+// It returns the first error that any of them failed
+// with.
+//
+// The tasks' run conditions are checked against the state
+// of the job before the group started.
+func (l *LiveJob) runGroup(g *TaskGroup, path []int, cancel <-chan struct{},
+	failed bool) error {
+	groupCancel := make(chan struct{})
+	var stopOnce sync.Once
+	stop := func() {
+		stopOnce.Do(func() {
+			close(groupCancel)
+		})
+	}
+	defer stop()
+	go func() {
+		select {
+		case <-cancel:
+			stop()
+		case <-groupCancel:
+		}
+	}()
+
+	var wg sync.WaitGroup
+	var errLock sync.Mutex
+	var firstErr error
+	for i, t := range g.Tasks {
+		if !t.ShouldRun(failed) {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, t *Task) {
+			defer wg.Done()
+			err := l.runTask(t, childPath(path, i), groupCancel, failed)
+			if err == nil {
+				return
+			}
+			errLock.Lock()
+			if firstErr == nil {
+				firstErr = err
+			}
+			errLock.Unlock()
+			if g.FailFast {
+				stop()
+			}
+		}(i, t)
+	}
+	wg.Wait()
+	return firstErr
 }
 
 func (l *LiveJob) done(e error) {
 	if e == nil {
-		e = l.masterJob.Close()
+		e = l.masterJob.Finish(false)
 	} else {
-		l.masterJob.Close()
+		l.masterJob.Finish(true)
 	}
 
 	l.masterJob.Close()
@@ -169,3 +372,16 @@ func (l *LiveJob) done(e error) {
 
 	l.tasksNote.Close()
 }
+
+// childPath creates the path of the i-th task in the list
+// of tasks at a path.
+func childPath(path []int, i int) []int {
+	res := make([]int, len(path), len(path)+1)
+	copy(res, path)
+	return append(res, i)
+}
+
+// newRunID generates a run ID which sorts by start time.
+func newRunID(start time.Time) string {
+	return fmt.Sprintf("%s-%08x", start.Format("20060102-150405"), rand.Uint32())
+}
@@ -0,0 +1,221 @@
+package jobadmin
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/unixpickle/jobempire/jobproto"
+)
+
+func TestLiveJobRunIf(t *testing.T) {
+	tests := []struct {
+		tasks    []*Task
+		ran      []string
+		err      string
+		failed   bool
+		finished bool
+	}{
+		{
+			tasks: []*Task{
+				fakeTask("a", ""),
+				runIfTask(RunOnFailure, fakeTask("onfail", "")),
+				runIfTask(RunAlways, fakeTask("cleanup", "")),
+				fakeTask("b", ""),
+			},
+			ran:      []string{"a", "cleanup", "b"},
+			finished: true,
+		},
+		{
+			tasks: []*Task{
+				fakeTask("a", ""),
+				fakeTask("fail1", ""),
+				fakeTask("skipped", ""),
+				runIfTask(RunOnFailure, fakeTask("onfail", "")),
+				runIfTask(RunAlways, fakeTask("cleanup", "")),
+				runIfTask(RunOnSuccess, fakeTask("skipped2", "")),
+			},
+			ran:      []string{"a", "fail1", "onfail", "cleanup"},
+			err:      "fail1 failed",
+			failed:   true,
+			finished: true,
+		},
+		{
+			tasks: []*Task{
+				fakeTask("fail1", ""),
+				runIfTask(RunOnFailure, fakeTask("fail2", "")),
+				runIfTask(RunAlways, fakeTask("fail3", "")),
+			},
+			ran:      []string{"fail1", "fail2", "fail3"},
+			err:      "fail1 failed",
+			failed:   true,
+			finished: true,
+		},
+	}
+	for i, test := range tests {
+		fm := newFakeMaster("slave")
+		lj, err := RunLiveJob(fm, &Job{Tasks: test.tasks})
+		if err != nil {
+			t.Fatal(err)
+		}
+		waitLiveJob(t, lj)
+		job := fm.Jobs()[0]
+		if ran := job.Ran(); !reflect.DeepEqual(ran, test.ran) {
+			t.Errorf("case %d: expected to run %v but ran %v", i, test.ran, ran)
+		}
+		checkJobError(t, i, lj, test.err)
+		if finished, failed := job.Outcome(); finished != test.finished ||
+			failed != test.failed {
+			t.Errorf("case %d: finished=%v failed=%v", i, finished, failed)
+		}
+	}
+}
+
+func TestLiveJobGroups(t *testing.T) {
+	tests := []struct {
+		tasks []*Task
+		ran   []string
+		err   string
+	}{
+		{
+			tasks: []*Task{
+				fakeTask("a", ""),
+				groupTask(false, fakeTask("b", ""), fakeTask("c", "")),
+				fakeTask("d", ""),
+			},
+			ran: []string{"a", "b", "c", "d"},
+		},
+		{
+			tasks: []*Task{
+				groupTask(false, fakeTask("b", ""), fakeTask("fail1", "")),
+				fakeTask("skipped", ""),
+				groupTask(false, fakeTask("skipped2", "")),
+				runIfTask(RunAlways, groupTask(false,
+					runIfTask(RunOnFailure, fakeTask("onfail", "")),
+					fakeTask("skipped3", ""),
+					runIfTask(RunAlways, fakeTask("fail2", "")),
+				)),
+			},
+			ran: []string{"b", "fail1", "fail2", "onfail"},
+			err: "fail1 failed",
+		},
+		{
+			tasks: []*Task{
+				groupTask(true, fakeTask("wait1", ""), fakeTask("fail1", "")),
+				runIfTask(RunAlways, fakeTask("cleanup", "")),
+			},
+			ran: []string{"cleanup", "fail1", "wait1"},
+			err: "fail1 failed",
+		},
+		{
+			tasks: []*Task{
+				groupTask(false,
+					groupTask(true, fakeTask("wait1", ""), fakeTask("fail1", "")),
+					fakeTask("b", ""),
+				),
+			},
+			ran: []string{"b", "fail1", "wait1"},
+			err: "fail1 failed",
+		},
+	}
+	for i, test := range tests {
+		fm := newFakeMaster("slave")
+		lj, err := RunLiveJob(fm, &Job{Tasks: test.tasks})
+		if err != nil {
+			t.Fatal(err)
+		}
+		waitLiveJob(t, lj)
+		ran := fm.Jobs()[0].Ran()
+		sort.Strings(ran)
+		if !reflect.DeepEqual(ran, test.ran) {
+			t.Errorf("case %d: expected to run %v but ran %v", i, test.ran, ran)
+		}
+		checkJobError(t, i, lj, test.err)
+	}
+}
+
+func TestLiveJobTaskTree(t *testing.T) {
+	retried := fakeTask("fail1", "")
+	retried.Retry = &RetryRule{MaxRetries: 2}
+	group := groupTask(false, fakeTask("b", ""), retried)
+	fm := newFakeMaster("slave")
+	lj, err := RunLiveJob(fm, &Job{Tasks: []*Task{
+		fakeTask("a", ""),
+		group,
+		runIfTask(RunAlways, fakeTask("c", "")),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitLiveJob(t, lj)
+
+	tree := lj.TaskTree()
+	if len(tree) != 3 {
+		t.Fatalf("expected 3 entries but got %d", len(tree))
+	}
+	if tree[0].Task == nil || tree[0].Index != 0 || taskName(tree[0].Task) != "a" {
+		t.Errorf("bad first entry: %+v", tree[0])
+	}
+	if tree[1].Task != nil || tree[1].Group == nil || len(tree[1].Entries) != 4 {
+		t.Fatalf("bad group entry: %+v", tree[1])
+	}
+	var names []string
+	for _, e := range tree[1].Entries {
+		if e.Task == nil || lj.Tasks(e.Index, e.Index+1)[0] != e.Task {
+			t.Errorf("bad group member: %+v", e)
+			continue
+		}
+		names = append(names, taskName(e.Task))
+	}
+	sort.Strings(names)
+	if expected := []string{"b", "fail1", "fail1", "fail1"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected group members %v but got %v", expected, names)
+	}
+	if tree[2].Task == nil || tree[2].Index != 5 || taskName(tree[2].Task) != "c" {
+		t.Errorf("bad last entry: %+v", tree[2])
+	}
+}
+
+// runIfTask sets a task's run condition.
+func runIfTask(runIf string, t *Task) *Task {
+	t.RunIf = runIf
+	return t
+}
+
+// groupTask creates a task group.
+func groupTask(failFast bool, tasks ...*Task) *Task {
+	return &Task{Group: &TaskGroup{Tasks: tasks, FailFast: failFast}}
+}
+
+func taskName(lt *LiveTask) string {
+	return lt.Task().Task.(*jobproto.GoRun).GoSourceDir
+}
+
+// waitLiveJob waits for a job, failing the test if it
+// does not finish in time.
+func waitLiveJob(t *testing.T, lj *LiveJob) {
+	timeout := make(chan struct{})
+	timer := time.AfterFunc(10*time.Second, func() {
+		close(timeout)
+	})
+	defer timer.Stop()
+	lj.Wait(timeout)
+	if lj.Running() {
+		lj.Cancel()
+		t.Fatal("job did not finish")
+	}
+}
+
+// checkJobError checks that a finished job failed with an
+// error containing a message, or succeeded if the message
+// is empty.
+func checkJobError(t *testing.T, i int, lj *LiveJob, msg string) {
+	err := lj.Error()
+	if msg == "" && err != nil {
+		t.Errorf("case %d: unexpected error: %s", i, err)
+	} else if msg != "" && (err == nil || !strings.Contains(err.Error(), msg)) {
+		t.Errorf("case %d: expected error %q but got %v", i, msg, err)
+	}
+}
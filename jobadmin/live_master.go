@@ -2,6 +2,7 @@ package jobadmin
 
 import (
 	"errors"
+	"io"
 	"sync"
 	"time"
 
@@ -9,9 +10,10 @@ import (
 )
 
 type jobRequest struct {
-	Job *Job
-	Res chan<- *LiveJob
-	Err chan<- error
+	Job      *Job
+	Instance int
+	Res      chan<- *LiveJob
+	Err      chan<- error
 }
 
 // A LiveMaster manages various aspects of an actively
@@ -19,6 +21,7 @@ type jobRequest struct {
 type LiveMaster struct {
 	master    jobproto.Master
 	startTime time.Time
+	id        int
 
 	shutdownLock sync.Mutex
 	isShutdown   bool
@@ -47,6 +50,18 @@ func RunLiveMaster(m jobproto.Master) *LiveMaster {
 	return lm
 }
 
+// ID returns a number which identifies the master within
+// the Scheduler it was added to.
+// IDs are assigned in the order that masters are added,
+// and they are not reused after masters are dropped from
+// the scheduler's history.
+//
+// This should only be called on masters returned by
+// Scheduler.Masters.
+func (l *LiveMaster) ID() int {
+	return l.id
+}
+
 // SlaveInfo returns information about the slave.
 func (l *LiveMaster) SlaveInfo() jobproto.SlaveInfo {
 	return l.master.SlaveInfo()
@@ -90,19 +105,43 @@ func (l *LiveMaster) Cancel() {
 
 // RunJob queues up a job to be run on the master.
 func (l *LiveMaster) RunJob(job *Job) (*LiveJob, error) {
+	return l.RunJobInstance(job, 0)
+}
+
+// RunJobInstance is like RunJob, but it specifies the
+// instance number of the job.
+func (l *LiveMaster) RunJobInstance(job *Job, instance int) (*LiveJob, error) {
 	if !l.Accepting() {
 		return nil, errors.New("master cannot accept new jobs")
 	}
 	resChan := make(chan *LiveJob, 1)
 	errChan := make(chan error, 1)
 	select {
-	case l.newJobs <- jobRequest{job, resChan, errChan}:
+	case l.newJobs <- jobRequest{job, instance, resChan, errChan}:
 		return <-resChan, <-errChan
 	case <-l.shutdown:
 		return nil, errors.New("master cannot accept new jobs")
 	}
 }
 
+// WorkDirs lists the job directories which the slave has
+// kept after their jobs were done.
+func (l *LiveMaster) WorkDirs() ([]jobproto.WorkDirInfo, error) {
+	return jobproto.ListWorkDirs(l.master)
+}
+
+// ListWorkDir lists a directory inside one of the slave's
+// kept job directories, like jobproto.ListWorkDir.
+func (l *LiveMaster) ListWorkDir(runID, dirPath string) ([]jobproto.WorkFileInfo, error) {
+	return jobproto.ListWorkDir(l.master, runID, dirPath)
+}
+
+// DownloadWorkFile copies a file from one of the slave's
+// kept job directories, like jobproto.DownloadWorkFile.
+func (l *LiveMaster) DownloadWorkFile(runID, filePath string, w io.Writer) error {
+	return jobproto.DownloadWorkFile(l.master, runID, filePath, w)
+}
+
 // JobCount returns the number of jobs which have been
 // started on this master.
 func (l *LiveMaster) JobCount() int {
@@ -149,6 +188,15 @@ func (l *LiveMaster) EndTime() time.Time {
 	return l.endTime
 }
 
+// discard deletes the task logs of the master's jobs.
+// It is called once the master has stopped running and
+// has been dropped from its scheduler's history.
+func (l *LiveMaster) discard() {
+	for _, j := range l.Jobs(0, l.JobCount()) {
+		j.discard()
+	}
+}
+
 func (l *LiveMaster) runMaster() {
 	go func() {
 		l.master.Wait()
@@ -180,7 +228,7 @@ func (l *LiveMaster) runMaster() {
 
 		select {
 		case jobReq := <-l.newJobs:
-			nextJob, err := RunLiveJob(l.master, jobReq.Job)
+			nextJob, err := RunLiveJobInstance(l.master, jobReq.Job, jobReq.Instance)
 			jobReq.Res <- nextJob
 			jobReq.Err <- err
 			if err == nil {
@@ -1,11 +1,14 @@
 package jobadmin
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
 	"github.com/unixpickle/jobempire/jobproto"
+	"github.com/unixpickle/jobempire/jobproto/taskclient"
 )
 
 // A LiveTask contains information about a running or
@@ -15,17 +18,34 @@ type LiveTask struct {
 	task      *Task
 	startTime time.Time
 
+	cancelOnce sync.Once
+	cancelled  chan struct{}
+
 	logLock sync.RWMutex
-	log     []jobproto.LogEntry
+	log     *taskLog
 	logNote nextNotifier
 
-	resLock  sync.RWMutex
-	resError error
-	endTime  time.Time
+	progressLock sync.RWMutex
+	progress     *TaskProgress
+
+	resLock   sync.RWMutex
+	resError  error
+	resResult *jobproto.TaskResult
+	endTime   time.Time
 }
 
 // RunLiveTask runs a Task and creates a LiveTask for it.
+// Task groups must be run with a LiveJob instead.
 func RunLiveTask(j jobproto.MasterJob, t *Task) (*LiveTask, error) {
+	return runLiveTask(j, t, TaskLogInfo{})
+}
+
+// runLiveTask is like RunLiveTask, but it also describes
+// the task in its log, in case the log is archived.
+func runLiveTask(j jobproto.MasterJob, t *Task, info TaskLogInfo) (*LiveTask, error) {
+	if t.Group != nil {
+		return nil, errors.New("cannot run a task group as a single task")
+	}
 	taskCopy, err := t.Copy()
 	if err != nil {
 		return nil, fmt.Errorf("copy task: %s", err)
@@ -33,7 +53,11 @@ func RunLiveTask(j jobproto.MasterJob, t *Task) (*LiveTask, error) {
 	lt := &LiveTask{
 		task:      taskCopy,
 		startTime: time.Now(),
+		cancelled: make(chan struct{}),
+		log:       newTaskLog(TaskLogs),
 	}
+	info.StartTime = lt.startTime
+	lt.log.info = info
 	go lt.runTask(j)
 	return lt, nil
 }
@@ -51,11 +75,20 @@ func (l *LiveTask) Running() bool {
 	return !l.logNote.Closed()
 }
 
+// Cancel stops the task if it is still running, causing
+// it to fail with jobproto.ErrTaskCancelled.
+// Unlike LiveJob.Cancel, the rest of the job keeps going.
+func (l *LiveTask) Cancel() {
+	l.cancelOnce.Do(func() {
+		close(l.cancelled)
+	})
+}
+
 // LogSize returns the current number of log entries.
 func (l *LiveTask) LogSize() int {
 	l.logLock.RLock()
 	defer l.logLock.RUnlock()
-	return len(l.log)
+	return l.log.Len()
 }
 
 // LogEntries returns the log entries in the given range,
@@ -65,11 +98,14 @@ func (l *LiveTask) LogSize() int {
 // The range must be within bounds, which is possible to
 // ensure by using the value from LogSize as a limit.
 //
+// Older entries may be read back from disk, as configured
+// by TaskLogs.
+//
 // The caller should not modify the result.
 func (l *LiveTask) LogEntries(start, end int) []jobproto.LogEntry {
 	l.logLock.RLock()
 	defer l.logLock.RUnlock()
-	return l.log[start:end]
+	return l.log.Entries(start, end)
 }
 
 // WaitLog waits for new log entries to arrive.
@@ -107,6 +143,32 @@ func (l *LiveTask) Error() error {
 	return l.resError
 }
 
+// Result returns the result reported by the slave when
+// the task finished.
+// It is nil if the task is still running or if the slave
+// did not report a result.
+func (l *LiveTask) Result() *jobproto.TaskResult {
+	l.resLock.RLock()
+	defer l.resLock.RUnlock()
+	return l.resResult
+}
+
+// Progress returns the latest progress reported by the
+// task, or nil if it has not reported any.
+func (l *LiveTask) Progress() *TaskProgress {
+	l.progressLock.RLock()
+	defer l.progressLock.RUnlock()
+	if l.progress == nil {
+		return nil
+	}
+	res := *l.progress
+	res.Metrics = map[string]float64{}
+	for k, v := range l.progress.Metrics {
+		res.Metrics[k] = v
+	}
+	return &res
+}
+
 // StartTime returns the time when the task was started.
 func (l *LiveTask) StartTime() time.Time {
 	return l.startTime
@@ -121,20 +183,79 @@ func (l *LiveTask) EndTime() time.Time {
 	return l.endTime
 }
 
+// TaskProgress summarizes the progress updates which a
+// task has reported.
+type TaskProgress struct {
+	// Fraction is the latest fraction of the work done,
+	// from 0 to 1, or -1 if none has been reported.
+	Fraction float64
+
+	Status  string
+	Metrics map[string]float64
+
+	// Time is when the latest update arrived.
+	Time time.Time
+}
+
+func (t *TaskProgress) update(u taskclient.Update) {
+	switch u.Kind {
+	case taskclient.KindProgress:
+		t.Fraction = math.Max(0, math.Min(1, u.Value))
+	case taskclient.KindMetric:
+		t.Metrics[u.Name] = u.Value
+	case taskclient.KindStatus:
+		t.Status = u.Text
+	default:
+		return
+	}
+	t.Time = time.Now()
+}
+
+// discard deletes the log entries which were written to
+// disk.
+// It should only be called once the task is done.
+func (l *LiveTask) discard() {
+	l.logLock.Lock()
+	defer l.logLock.Unlock()
+	l.log.removeChunks()
+}
+
 func (l *LiveTask) runTask(j jobproto.MasterJob) {
 	logChan := make(chan jobproto.LogEntry)
 	go func() {
 		for entry := range logChan {
 			l.logLock.Lock()
-			l.log = append(l.log, entry)
+			l.log.Add(entry)
 			l.logLock.Unlock()
 			l.logNote.Notify()
 		}
+		l.logLock.Lock()
+		l.log.Finish()
+		l.logLock.Unlock()
 		l.logNote.Close()
 	}()
-	err := j.Run(l.task.Task, logChan)
+	progressChan := make(chan taskclient.Update)
+	progressDone := make(chan struct{})
+	go func() {
+		defer close(progressDone)
+		for u := range progressChan {
+			l.progressLock.Lock()
+			if l.progress == nil {
+				l.progress = &TaskProgress{Fraction: -1, Metrics: map[string]float64{}}
+			}
+			l.progress.update(u)
+			l.progressLock.Unlock()
+		}
+	}()
+	result, err := j.RunWith(l.task.Task, logChan, jobproto.RunOptions{
+		Progress: progressChan,
+		Cancel:   l.cancelled,
+	})
+	close(progressChan)
+	<-progressDone
 	l.resLock.Lock()
 	l.resError = err
+	l.resResult = result
 	l.endTime = time.Now()
 	l.resLock.Unlock()
 	close(logChan)
@@ -0,0 +1,242 @@
+package jobadmin
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/unixpickle/jobempire/jobproto"
+)
+
+const (
+	defaultSearchResults = 200
+	searchBatchSize      = 1000
+)
+
+// A LogQuery describes which task log entries to search.
+// Empty filter fields match everything.
+type LogQuery struct {
+	// Pattern is a substring to look for, or a regular
+	// expression if Regexp is set.
+	Pattern string
+	Regexp  bool
+
+	JobID     string
+	SlaveName string
+	Stream    string
+
+	// Since and Until restrict the time of matching
+	// entries. Zero times are not used as limits.
+	Since time.Time
+	Until time.Time
+
+	// Context is the number of entries to include before
+	// and after each match.
+	Context int
+
+	// MaxResults limits the number of matches.
+	// If it is 0, a default limit is used.
+	MaxResults int
+}
+
+// A LogSearchResult contains the matches for a LogQuery.
+type LogSearchResult struct {
+	Matches []*LogMatch
+
+	// Truncated is set if there were more matches than
+	// the query's limit.
+	Truncated bool
+}
+
+// A LogMatch is one log entry which matched a query.
+// The task is identified by its master's ID and by its
+// indices in LiveMaster.Jobs and LiveJob.Tasks.
+//
+// For matches in a TaskLogArchive, ArchiveID identifies
+// the log instead, and only TaskIndex is set.
+type LogMatch struct {
+	SlaveID   int
+	JobIndex  int
+	TaskIndex int
+	ArchiveID string
+
+	SlaveName string
+	JobName   string
+
+	Entry  jobproto.LogEntry
+	Before []jobproto.LogEntry
+	After  []jobproto.LogEntry
+}
+
+// SearchLogs searches the logs of every running and
+// previously run task, followed by the logs from before
+// the master was restarted, if archive is non-nil.
+// Newer jobs are searched before older ones.
+func (s *Scheduler) SearchLogs(q *LogQuery, archive *TaskLogArchive) (*LogSearchResult,
+	error) {
+	if q.Pattern == "" {
+		return nil, errors.New("empty search pattern")
+	}
+	match := func(msg string) bool {
+		return strings.Contains(msg, q.Pattern)
+	}
+	if q.Regexp {
+		expr, err := regexp.Compile(q.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		match = expr.MatchString
+	}
+	maxResults := q.MaxResults
+	if maxResults <= 0 {
+		maxResults = defaultSearchResults
+	}
+
+	masters, _, err := s.Masters()
+	if err != nil {
+		return nil, err
+	}
+	res := &LogSearchResult{}
+	for _, master := range masters {
+		slaveName := master.SlaveInfo().Name
+		if q.SlaveName != "" && q.SlaveName != slaveName {
+			continue
+		}
+		jobs := master.Jobs(0, master.JobCount())
+		for jobIdx := len(jobs) - 1; jobIdx >= 0; jobIdx-- {
+			job := jobs[jobIdx]
+			if !q.matchesJob(job) {
+				continue
+			}
+			for taskIdx, task := range job.Tasks(0, job.TaskCount()) {
+				base := LogMatch{
+					SlaveID:   master.ID(),
+					JobIndex:  jobIdx,
+					TaskIndex: taskIdx,
+					SlaveName: slaveName,
+					JobName:   job.Job().Name,
+				}
+				if !q.searchTask(task, match, &base, res, maxResults) {
+					res.Truncated = true
+					return res, nil
+				}
+			}
+		}
+	}
+	if archive == nil {
+		return res, nil
+	}
+	for _, log := range archive.Logs() {
+		if !q.matchesArchived(&log.Info) {
+			continue
+		}
+		base := LogMatch{
+			TaskIndex: log.Info.TaskIndex,
+			ArchiveID: log.ID,
+			SlaveName: log.Info.SlaveName,
+			JobName:   log.Info.JobName,
+		}
+		if !q.searchTask(log, match, &base, res, maxResults) {
+			res.Truncated = true
+			return res, nil
+		}
+	}
+	return res, nil
+}
+
+func (q *LogQuery) matchesJob(job *LiveJob) bool {
+	if q.JobID != "" && q.JobID != job.Job().ID {
+		return false
+	}
+	if !q.Until.IsZero() && job.StartTime().After(q.Until) {
+		return false
+	}
+	if !q.Since.IsZero() && !job.Running() && job.EndTime().Before(q.Since) {
+		return false
+	}
+	return true
+}
+
+func (q *LogQuery) matchesArchived(info *TaskLogInfo) bool {
+	if q.JobID != "" && q.JobID != info.JobID {
+		return false
+	}
+	if q.SlaveName != "" && q.SlaveName != info.SlaveName {
+		return false
+	}
+	if !q.Until.IsZero() && info.StartTime.After(q.Until) {
+		return false
+	}
+	if !q.Since.IsZero() && !info.EndTime.IsZero() && info.EndTime.Before(q.Since) {
+		return false
+	}
+	return true
+}
+
+// A searchableLog is a LiveTask or an ArchivedTaskLog.
+type searchableLog interface {
+	LogSize() int
+	LogEntries(start, end int) []jobproto.LogEntry
+}
+
+// searchTask adds the matches from a task to res.
+// It returns false if there are more matches than the
+// limit allows.
+func (q *LogQuery) searchTask(task searchableLog, match func(string) bool, base *LogMatch,
+	res *LogSearchResult, maxResults int) bool {
+	size := task.LogSize()
+	for start := 0; start < size; start += searchBatchSize {
+		end := start + searchBatchSize
+		if end > size {
+			end = size
+		}
+
+		// Load the surrounding entries as well, so that the
+		// context for every match is in the batch.
+		ctxStart, ctxEnd := start-q.Context, end+q.Context
+		if ctxStart < 0 {
+			ctxStart = 0
+		}
+		if ctxEnd > size {
+			ctxEnd = size
+		}
+		entries := task.LogEntries(ctxStart, ctxEnd)
+
+		for i := start - ctxStart; i < end-ctxStart; i++ {
+			entry := entries[i]
+			if !q.matchesEntry(entry) || !match(entry.Message) {
+				continue
+			}
+			if len(res.Matches) == maxResults {
+				return false
+			}
+			m := *base
+			m.Entry = entry
+			before, after := i-q.Context, i+1+q.Context
+			if before < 0 {
+				before = 0
+			}
+			if after > len(entries) {
+				after = len(entries)
+			}
+			m.Before = entries[before:i]
+			m.After = entries[i+1 : after]
+			res.Matches = append(res.Matches, &m)
+		}
+	}
+	return true
+}
+
+func (q *LogQuery) matchesEntry(e jobproto.LogEntry) bool {
+	if q.Stream != "" && q.Stream != e.Stream {
+		return false
+	}
+	if !q.Since.IsZero() && e.Time.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && e.Time.After(q.Until) {
+		return false
+	}
+	return true
+}
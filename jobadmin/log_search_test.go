@@ -0,0 +1,193 @@
+package jobadmin
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSearchLogs(t *testing.T) {
+	s := NewScheduler()
+	defer s.Terminate()
+
+	alpha := RunLiveMaster(newFakeMaster("alpha"))
+	beta := RunLiveMaster(newFakeMaster("beta"))
+	for _, m := range []*LiveMaster{alpha, beta} {
+		if err := s.AddMaster(m, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	jobA := &Job{ID: "a", Name: "A", Tasks: []*Task{
+		fakeTask("t1", "start\nerror one\nmiddle\nerror two\nend"),
+	}}
+	jobB := &Job{ID: "b", Name: "B", Tasks: []*Task{fakeTask("t2", "error three")}}
+	jobC := &Job{ID: "a", Name: "A", Tasks: []*Task{fakeTask("fail", "error four")}}
+	runFakeJob(t, alpha, jobA)
+	runFakeJob(t, alpha, jobB)
+	runFakeJob(t, beta, jobC)
+
+	search := func(q *LogQuery) *LogSearchResult {
+		res, err := s.SearchLogs(q, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	messages := func(res *LogSearchResult) []string {
+		var msgs []string
+		for _, m := range res.Matches {
+			msgs = append(msgs, m.Entry.Message)
+		}
+		return msgs
+	}
+
+	res := search(&LogQuery{Pattern: "error"})
+	expected := []string{"error three", "error one", "error two", "error four"}
+	if msgs := messages(res); !reflect.DeepEqual(msgs, expected) || res.Truncated {
+		t.Errorf("expected %v but got %v", expected, msgs)
+	}
+	m := res.Matches[0]
+	if m.SlaveID != 0 || m.JobIndex != 1 || m.TaskIndex != 0 ||
+		m.SlaveName != "alpha" || m.JobName != "B" {
+		t.Errorf("bad match location: %+v", m)
+	}
+
+	filters := []struct {
+		query    *LogQuery
+		expected []string
+	}{
+		{&LogQuery{Pattern: "error", JobID: "b"}, []string{"error three"}},
+		{&LogQuery{Pattern: "error", SlaveName: "beta"}, []string{"error four"}},
+		{&LogQuery{Pattern: "error", Stream: "stdout", JobID: "a", SlaveName: "beta"},
+			[]string{"error four"}},
+		{&LogQuery{Pattern: "error", Stream: "stderr"}, nil},
+		{&LogQuery{Pattern: "error", Until: time.Now().Add(-time.Hour)}, nil},
+		{&LogQuery{Pattern: "error", Since: time.Now().Add(time.Hour)}, nil},
+		{&LogQuery{Pattern: "error (one|four)", Regexp: true},
+			[]string{"error one", "error four"}},
+		{&LogQuery{Pattern: "error (one|four)"}, nil},
+	}
+	for i, f := range filters {
+		if msgs := messages(search(f.query)); !reflect.DeepEqual(msgs, f.expected) {
+			t.Errorf("filter %d: expected %v but got %v", i, f.expected, msgs)
+		}
+	}
+
+	res = search(&LogQuery{Pattern: "error", JobID: "a", SlaveName: "alpha", Context: 1})
+	if len(res.Matches) != 2 {
+		t.Fatalf("expected 2 matches but got %d", len(res.Matches))
+	}
+	contexts := [][2][]string{
+		{{"start"}, {"middle"}},
+		{{"middle"}, {"end"}},
+	}
+	for i, m := range res.Matches {
+		var before, after []string
+		for _, e := range m.Before {
+			before = append(before, e.Message)
+		}
+		for _, e := range m.After {
+			after = append(after, e.Message)
+		}
+		if !reflect.DeepEqual(before, contexts[i][0]) || !reflect.DeepEqual(after, contexts[i][1]) {
+			t.Errorf("match %d: bad context %v %v", i, before, after)
+		}
+	}
+	res = search(&LogQuery{Pattern: "start", Context: 10})
+	if len(res.Matches) != 1 || len(res.Matches[0].Before) != 0 ||
+		len(res.Matches[0].After) != 4 {
+		t.Errorf("bad context at the edge of a log: %+v", res.Matches)
+	}
+
+	res = search(&LogQuery{Pattern: "error", MaxResults: 2})
+	if msgs := messages(res); !reflect.DeepEqual(msgs, expected[:2]) || !res.Truncated {
+		t.Errorf("expected truncated %v but got %v (%v)", expected[:2], msgs, res.Truncated)
+	}
+	res = search(&LogQuery{Pattern: "error", MaxResults: 4})
+	if len(res.Matches) != 4 || res.Truncated {
+		t.Error("search with exactly enough results should not be truncated")
+	}
+
+	for _, bad := range []*LogQuery{{}, {Pattern: "(", Regexp: true}} {
+		if _, err := s.SearchLogs(bad, nil); err == nil {
+			t.Errorf("expected error for %+v", bad)
+		}
+	}
+}
+
+func runFakeJob(t *testing.T, m *LiveMaster, j *Job) *LiveJob {
+	lj, err := m.RunJob(j)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lj.Wait(nil)
+	return lj
+}
+
+func TestSearchLogsArchive(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobadmin_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	oldLogs := TaskLogs
+	TaskLogs = TaskLogConfig{SpillDir: tempDir, MemorySize: 1 << 20}
+	defer func() {
+		TaskLogs = oldLogs
+	}()
+
+	// Run the tasks with one scheduler, then search for
+	// them with another, as if the master had restarted.
+	s := NewScheduler()
+	alpha := RunLiveMaster(newFakeMaster("alpha"))
+	if err := s.AddMaster(alpha, false); err != nil {
+		t.Fatal(err)
+	}
+	runFakeJob(t, alpha, &Job{ID: "a", Name: "A", Tasks: []*Task{
+		fakeTask("t1", "start"),
+		fakeTask("t2", "before\nerror one\nafter"),
+	}})
+	runFakeJob(t, alpha, &Job{ID: "b", Name: "B", Tasks: []*Task{fakeTask("t3", "error two")}})
+	s.Terminate()
+
+	archive, err := LoadTaskLogArchive(tempDir, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s = NewScheduler()
+	defer s.Terminate()
+
+	res, err := s.SearchLogs(&LogQuery{Pattern: "error", Context: 1}, archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Matches) != 2 {
+		t.Fatalf("expected 2 matches but got %d", len(res.Matches))
+	}
+	m := res.Matches[0]
+	if m.Entry.Message != "error two" || m.JobName != "B" || m.SlaveName != "alpha" ||
+		m.TaskIndex != 0 || archive.Log(m.ArchiveID) == nil {
+		t.Errorf("bad match: %+v", m)
+	}
+	m = res.Matches[1]
+	if m.Entry.Message != "error one" || m.JobName != "A" || m.TaskIndex != 1 ||
+		len(m.Before) != 1 || len(m.After) != 1 {
+		t.Errorf("bad match: %+v", m)
+	}
+
+	res, err = s.SearchLogs(&LogQuery{Pattern: "error", JobID: "a", SlaveName: "alpha"},
+		archive)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(res.Matches) != 1 || res.Matches[0].Entry.Message != "error one" {
+		t.Errorf("unexpected matches: %v", res.Matches)
+	}
+	res, err = s.SearchLogs(&LogQuery{Pattern: "error", SlaveName: "beta"}, archive)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(res.Matches) != 0 {
+		t.Errorf("unexpected matches: %v", res.Matches)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"sort"
 	"sync"
 	"time"
 )
@@ -12,6 +13,47 @@ const jobDoneWait = time.Second
 
 var errSchedulerShutdown = errors.New("scheduler is shutdown")
 
+// MasterHistory is the number of disconnected masters
+// which a Scheduler keeps, along with their jobs and task
+// logs.
+// Once there are more, the oldest ones are dropped when
+// new masters are added.
+// If it is 0, all masters are kept.
+// Schedulers use the value from when they were created.
+var MasterHistory int
+
+// A SchedulePolicy determines which slave the scheduler
+// picks when more than one could run a job.
+type SchedulePolicy int
+
+const (
+	// RandomPolicy picks any slave with enough resources.
+	RandomPolicy SchedulePolicy = iota
+
+	// PackPolicy fills up the oldest slaves first, leaving
+	// newer slaves idle when possible.
+	PackPolicy
+
+	// SpreadPolicy picks the slave with the lowest fraction
+	// of its CPUs in use.
+	SpreadPolicy
+)
+
+// ParseSchedulePolicy converts a policy name ("random",
+// "pack", or "spread") into a SchedulePolicy.
+func ParseSchedulePolicy(name string) (SchedulePolicy, error) {
+	switch name {
+	case "random":
+		return RandomPolicy, nil
+	case "pack":
+		return PackPolicy, nil
+	case "spread":
+		return SpreadPolicy, nil
+	default:
+		return 0, fmt.Errorf("unknown schedule policy: %s", name)
+	}
+}
+
 type schedJob struct {
 	Master *LiveMaster
 	Job    *Job
@@ -36,6 +78,14 @@ type schedSetMaster struct {
 // jobs and receive notifications when slaves or jobs are
 // available.
 type Scheduler struct {
+	policy  SchedulePolicy
+	history int
+
+	// instances stores the instance numbers in use by each
+	// running job, keyed by job ID.
+	instancesLock sync.Mutex
+	instances     map[string]map[int]bool
+
 	shutdownLock sync.Mutex
 	shutdown     chan struct{}
 
@@ -53,7 +103,16 @@ type Scheduler struct {
 // When you are done with the scheduler, you should call
 // Terminate on it.
 func NewScheduler() *Scheduler {
+	return NewSchedulerPolicy(RandomPolicy)
+}
+
+// NewSchedulerPolicy creates an active scheduler which
+// uses the given policy to choose slaves.
+func NewSchedulerPolicy(p SchedulePolicy) *Scheduler {
 	s := &Scheduler{
+		policy:     p,
+		history:    MasterHistory,
+		instances:  map[string]map[int]bool{},
 		shutdown:   make(chan struct{}),
 		newJobs:    make(chan []*Job),
 		newMaster:  make(chan *schedSetMaster),
@@ -101,6 +160,8 @@ func (s *Scheduler) Running() bool {
 // Masters returns all of the masters, as well as a flag
 // for each one indicating whether or not the master is
 // set to be auto-scheduled.
+// Masters which were dropped from the history, as set by
+// MasterHistory, are not included.
 //
 // This fails if the scheduler has been terminated.
 func (s *Scheduler) Masters() ([]*LiveMaster, []bool, error) {
@@ -140,7 +201,8 @@ func (s *Scheduler) SetAuto(m *LiveMaster, auto bool) {
 }
 
 // WaitMasters waits for more masters to be added.
-// It behaves like LiveMaster.WaitJobs.
+// It behaves like LiveMaster.WaitJobs, where n counts all
+// of the masters ever added, including dropped ones.
 func (s *Scheduler) WaitMasters(n int, cancel <-chan struct{}) bool {
 	return s.masterNote.Wait(n, cancel)
 }
@@ -191,10 +253,24 @@ func (s *Scheduler) Jobs() ([]*Job, error) {
 // any of the jobs is invalid in some way.
 func (s *Scheduler) SetJobs(j []*Job) error {
 	jobsCopy := make([]*Job, len(j))
+	ids := map[string]bool{}
+	keys := map[string]bool{}
 	for i, x := range j {
+		if x.ID == "" {
+			return fmt.Errorf("job %d has no ID", i)
+		} else if ids[x.ID] {
+			return fmt.Errorf("job %d has duplicate ID: %s", i, x.ID)
+		} else if x.Key != "" && keys[x.Key] {
+			return fmt.Errorf("job %d has duplicate key: %s", i, x.Key)
+		}
+		ids[x.ID] = true
+		keys[x.Key] = true
 		if x.Unbounded() {
 			return fmt.Errorf("job %d is unbounded", i)
 		}
+		if err := x.CheckKeepDir(); err != nil {
+			return fmt.Errorf("job %d: %s", i, err)
+		}
 		c, err := x.Copy()
 		if err != nil {
 			return fmt.Errorf("copy job %d: %s", i, err)
@@ -213,6 +289,7 @@ func (s *Scheduler) runLoop() {
 	var jobs []*Job
 	var masters []*LiveMaster
 	var auto []bool
+	var nextID int
 
 	defer func() {
 		for _, m := range masters {
@@ -239,8 +316,11 @@ func (s *Scheduler) runLoop() {
 		case <-doneChan:
 			s.reschedule(jobs, s.availableMasters(masters, auto), doneChan)
 		case m := <-s.newMaster:
+			m.Master.id = nextID
+			nextID++
 			masters = append(masters, m.Master)
 			auto = append(auto, m.Auto)
+			masters, auto = s.dropHistory(masters, auto)
 			s.masterNote.Notify()
 		case j := <-s.runJob:
 			s.startJob(j.Job, j.Master, doneChan)
@@ -266,6 +346,40 @@ func (s *Scheduler) runLoop() {
 	}
 }
 
+// dropHistory removes the oldest disconnected masters
+// beyond the history limit and deletes their task logs.
+//
+// New slices are returned, since the old ones may still
+// be in use by callers of Masters.
+func (s *Scheduler) dropHistory(masters []*LiveMaster, auto []bool) ([]*LiveMaster,
+	[]bool) {
+	if s.history <= 0 {
+		return masters, auto
+	}
+	var excess int
+	for _, m := range masters {
+		if !m.Running() {
+			excess++
+		}
+	}
+	excess -= s.history
+	if excess <= 0 {
+		return masters, auto
+	}
+	var newMasters []*LiveMaster
+	var newAuto []bool
+	for i, m := range masters {
+		if excess > 0 && !m.Running() {
+			excess--
+			m.discard()
+			continue
+		}
+		newMasters = append(newMasters, m)
+		newAuto = append(newAuto, auto[i])
+	}
+	return newMasters, newAuto
+}
+
 func (s *Scheduler) reschedule(jobs []*Job, masters []*LiveMaster, doneChan chan<- struct{}) {
 	jobCounts := map[string]int{}
 	cpuCounts := make([]int, len(masters))
@@ -286,7 +400,7 @@ func (s *Scheduler) reschedule(jobs []*Job, masters []*LiveMaster, doneChan chan
 		jobIdx := pl.Random()
 		job := pl.Jobs[jobIdx]
 		var master *LiveMaster
-		for _, i := range rand.Perm(len(masters)) {
+		for _, i := range s.masterOrder(masters, cpuCounts) {
 			enoughMem := memUsage[i]+job.MemUsage <= masters[i].SlaveInfo().TotalMem
 			enoughCPU := cpuCounts[i] < masters[i].SlaveInfo().MaxProcs
 			if enoughMem && enoughCPU {
@@ -308,6 +422,30 @@ func (s *Scheduler) reschedule(jobs []*Job, masters []*LiveMaster, doneChan chan
 	}
 }
 
+// masterOrder returns the order in which masters should
+// be considered for a job, according to the policy.
+func (s *Scheduler) masterOrder(masters []*LiveMaster, cpuCounts []int) []int {
+	switch s.policy {
+	case PackPolicy:
+		res := make([]int, len(masters))
+		for i := range res {
+			res[i] = i
+		}
+		return res
+	case SpreadPolicy:
+		res := rand.Perm(len(masters))
+		load := func(i int) float64 {
+			return float64(cpuCounts[i]) / float64(masters[i].SlaveInfo().MaxProcs)
+		}
+		sort.SliceStable(res, func(i, j int) bool {
+			return load(res[i]) < load(res[j])
+		})
+		return res
+	default:
+		return rand.Perm(len(masters))
+	}
+}
+
 func (s *Scheduler) availableMasters(m []*LiveMaster, auto []bool) []*LiveMaster {
 	res := make([]*LiveMaster, 0, len(m))
 	for i, x := range m {
@@ -325,7 +463,9 @@ func (s *Scheduler) startJob(j *Job, m *LiveMaster, doneChan chan<- struct{}) {
 			<-minTime
 			doneChan <- struct{}{}
 		}()
-		lj, err := m.RunJob(j)
+		instance := s.acquireInstance(j.ID)
+		defer s.releaseInstance(j.ID, instance)
+		lj, err := m.RunJobInstance(j, instance)
 		if err != nil {
 			return
 		}
@@ -333,6 +473,33 @@ func (s *Scheduler) startJob(j *Job, m *LiveMaster, doneChan chan<- struct{}) {
 	}()
 }
 
+// acquireInstance reserves the lowest unused instance
+// number for a job.
+func (s *Scheduler) acquireInstance(jobID string) int {
+	s.instancesLock.Lock()
+	defer s.instancesLock.Unlock()
+	used := s.instances[jobID]
+	if used == nil {
+		used = map[int]bool{}
+		s.instances[jobID] = used
+	}
+	var instance int
+	for used[instance] {
+		instance++
+	}
+	used[instance] = true
+	return instance
+}
+
+func (s *Scheduler) releaseInstance(jobID string, instance int) {
+	s.instancesLock.Lock()
+	defer s.instancesLock.Unlock()
+	delete(s.instances[jobID], instance)
+	if len(s.instances[jobID]) == 0 {
+		delete(s.instances, jobID)
+	}
+}
+
 type priorityList struct {
 	Jobs          []*Job
 	TotalPriority int
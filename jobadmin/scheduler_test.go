@@ -0,0 +1,65 @@
+package jobadmin
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestSchedulerHistory(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobadmin_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	oldLogs, oldHistory := TaskLogs, MasterHistory
+	TaskLogs = TaskLogConfig{SpillDir: tempDir, MemorySize: 1 << 20}
+	MasterHistory = 1
+	defer func() {
+		TaskLogs, MasterHistory = oldLogs, oldHistory
+	}()
+
+	s := NewScheduler()
+	defer s.Terminate()
+
+	var masters []*LiveMaster
+	var logDirs []string
+	for _, name := range []string{"alpha", "beta"} {
+		m := RunLiveMaster(newFakeMaster(name))
+		if err := s.AddMaster(m, false); err != nil {
+			t.Fatal(err)
+		}
+		job := runFakeJob(t, m, &Job{ID: name, Tasks: []*Task{fakeTask("t", "hello")}})
+		logDirs = append(logDirs, job.Tasks(0, 1)[0].log.dir)
+		m.Cancel()
+		m.Wait(nil)
+		masters = append(masters, m)
+	}
+	for _, dir := range logDirs {
+		if _, err := os.Stat(dir); err != nil {
+			t.Fatal("missing task log:", err)
+		}
+	}
+
+	gamma := RunLiveMaster(newFakeMaster("gamma"))
+	if err := s.AddMaster(gamma, false); err != nil {
+		t.Fatal(err)
+	}
+	list, _, err := s.Masters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[0] != masters[1] || list[1] != gamma {
+		t.Fatalf("unexpected masters: %v", list)
+	}
+	if list[0].ID() != 1 || list[1].ID() != 2 {
+		t.Errorf("unexpected IDs: %d, %d", list[0].ID(), list[1].ID())
+	}
+	if _, err := os.Stat(logDirs[0]); !os.IsNotExist(err) {
+		t.Error("log of dropped master was not deleted")
+	}
+	if _, err := os.Stat(logDirs[1]); err != nil {
+		t.Error("log of kept master was deleted:", err)
+	}
+}
@@ -12,6 +12,102 @@ import (
 // and provides JSON marshaling functionality.
 type Task struct {
 	Task jobproto.Task
+
+	// Group, if non-nil, makes this Task a group of tasks to
+	// run concurrently, in which case the Task field is nil.
+	Group *TaskGroup
+
+	// Retry, if non-nil, allows the task to be re-run when
+	// it fails. It may not be set on groups.
+	Retry *RetryRule
+
+	// RunIf is the condition for running the task, such as
+	// RunOnFailure.
+	// If it is empty, RunOnSuccess is used.
+	RunIf string
+}
+
+// Conditions for running a task, based on whether an
+// earlier task in the job has failed.
+const (
+	RunOnSuccess = "success"
+	RunOnFailure = "failure"
+	RunAlways    = "always"
+)
+
+// ShouldRun decides if a task should be run, given whether
+// an earlier task in the job has failed.
+func (t *Task) ShouldRun(failed bool) bool {
+	switch t.RunIf {
+	case RunAlways:
+		return true
+	case RunOnFailure:
+		return failed
+	default:
+		return !failed
+	}
+}
+
+// A TaskGroup is a list of tasks which run concurrently.
+// The group finishes once all of its tasks have finished,
+// and it fails if any of them fails.
+//
+// Groups themselves are never retried, but the tasks in
+// them may be.
+type TaskGroup struct {
+	Tasks []*Task
+
+	// FailFast stops the other tasks in the group as soon
+	// as one of them fails.
+	FailFast bool
+}
+
+// A RetryRule decides when a failed task is re-run.
+//
+// If neither ExitCodes nor Signals is set, any failure is
+// retried.
+// Otherwise, only failures of processes matching one of
+// the conditions are retried.
+type RetryRule struct {
+	// MaxRetries is the number of times the task may be
+	// re-run after the first attempt.
+	MaxRetries int
+
+	// ExitCodes lists process exit codes to retry.
+	ExitCodes []int
+
+	// Signals enables retrying processes which were killed
+	// by a signal.
+	Signals bool
+
+	// Delay is the number of seconds to wait before each
+	// retry.
+	Delay int
+}
+
+// ShouldRetry decides if a task should be re-run, given
+// the result of its latest attempt and the number of
+// retries so far.
+// The result may be nil if the slave did not report one.
+func (r *RetryRule) ShouldRetry(res *jobproto.TaskResult, retries int) bool {
+	if retries >= r.MaxRetries {
+		return false
+	}
+	if len(r.ExitCodes) == 0 && !r.Signals {
+		return true
+	}
+	if res == nil || res.Process == nil {
+		return false
+	}
+	if r.Signals && res.Process.Signal != "" {
+		return true
+	}
+	for _, code := range r.ExitCodes {
+		if code == res.Process.ExitCode && res.Process.Signal == "" {
+			return true
+		}
+	}
+	return false
 }
 
 // Copy creates a deep copy of the Task.
@@ -32,21 +128,45 @@ func (t *Task) Copy() (*Task, error) {
 // MarshalJSON marshal's the internal task, given that it
 // is one of the following types:
 //
-//     - *jobproto.FileTransfer
-//     - *jobproto.GoRun
-//     - *jobproto.Exit
+//   - *jobproto.FileTransfer
+//   - *jobproto.BlobTransfer
+//   - *jobproto.URLFetch
+//   - *jobproto.ArchiveExtract
+//   - *jobproto.ArchiveCreate
+//   - *jobproto.InlineFile
+//   - *jobproto.GoRun
+//   - *jobproto.Exit
 //
 // The resulting JSON object has fields for each of those
-// types (e.g. a field named "GoRun").
+// types (e.g. a field named "GoRun"), and a field named
+// "Group" for task groups.
 // Exactly one of said fields will be non-null and contain
 // the JSON-marshaled version of the task.
+// The Retry rule, if there is one, is stored in a field
+// named "Retry", and the RunIf condition in a field named
+// "RunIf".
 //
 // This will fail if t.Task is not a supported type.
 func (t *Task) MarshalJSON() ([]byte, error) {
 	var res marshalTask
+	res.RunIf = t.RunIf
+	if t.Group != nil {
+		res.Group = t.Group
+		return json.Marshal(res)
+	}
 	switch task := t.Task.(type) {
 	case *jobproto.FileTransfer:
 		res.FileTransfer = task
+	case *jobproto.BlobTransfer:
+		res.BlobTransfer = task
+	case *jobproto.URLFetch:
+		res.URLFetch = task
+	case *jobproto.ArchiveExtract:
+		res.ArchiveExtract = task
+	case *jobproto.ArchiveCreate:
+		res.ArchiveCreate = task
+	case *jobproto.InlineFile:
+		res.InlineFile = task
 	case *jobproto.GoRun:
 		res.GoRun = task
 	case *jobproto.Exit:
@@ -54,6 +174,7 @@ func (t *Task) MarshalJSON() ([]byte, error) {
 	default:
 		return nil, fmt.Errorf("unsupported task type: %T", t.Task)
 	}
+	res.Retry = t.Retry
 	return json.Marshal(res)
 }
 
@@ -63,9 +184,29 @@ func (t *Task) UnmarshalJSON(d []byte) error {
 	if err := json.Unmarshal(d, &mt); err != nil {
 		return err
 	}
+	switch mt.RunIf {
+	case "", RunOnSuccess, RunOnFailure, RunAlways:
+	default:
+		return errors.New("unknown run condition: " + mt.RunIf)
+	}
+	if mt.Group != nil && mt.Retry != nil {
+		return errors.New("task groups cannot be retried")
+	}
 	switch true {
+	case mt.Group != nil:
+		t.Group = mt.Group
 	case mt.FileTransfer != nil:
 		t.Task = mt.FileTransfer
+	case mt.BlobTransfer != nil:
+		t.Task = mt.BlobTransfer
+	case mt.URLFetch != nil:
+		t.Task = mt.URLFetch
+	case mt.ArchiveExtract != nil:
+		t.Task = mt.ArchiveExtract
+	case mt.ArchiveCreate != nil:
+		t.Task = mt.ArchiveCreate
+	case mt.InlineFile != nil:
+		t.Task = mt.InlineFile
 	case mt.GoRun != nil:
 		t.Task = mt.GoRun
 	case mt.Exit != nil:
@@ -73,11 +214,21 @@ func (t *Task) UnmarshalJSON(d []byte) error {
 	default:
 		return errors.New("missing task to unmarshal")
 	}
+	t.Retry = mt.Retry
+	t.RunIf = mt.RunIf
 	return nil
 }
 
 type marshalTask struct {
-	FileTransfer *jobproto.FileTransfer
-	GoRun        *jobproto.GoRun
-	Exit         *jobproto.Exit
+	FileTransfer   *jobproto.FileTransfer
+	BlobTransfer   *jobproto.BlobTransfer
+	URLFetch       *jobproto.URLFetch
+	ArchiveExtract *jobproto.ArchiveExtract
+	ArchiveCreate  *jobproto.ArchiveCreate
+	InlineFile     *jobproto.InlineFile
+	GoRun          *jobproto.GoRun
+	Exit           *jobproto.Exit
+	Group          *TaskGroup `json:",omitempty"`
+	Retry          *RetryRule `json:",omitempty"`
+	RunIf          string     `json:",omitempty"`
 }
@@ -0,0 +1,344 @@
+package jobadmin
+
+import (
+	"compress/gzip"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/unixpickle/jobempire/jobproto"
+)
+
+const (
+	taskLogDirPrefix = "task"
+	taskLogIndexFile = "task.json"
+)
+
+// TaskLogConfig configures how LiveTasks store the log
+// entries from their tasks.
+type TaskLogConfig struct {
+	// SpillDir is a directory where older log entries are
+	// written once a task's in-memory log is full.
+	// If it is "", logs are kept entirely in memory.
+	SpillDir string
+
+	// MemorySize is the number of bytes of log messages
+	// which each task keeps in memory.
+	MemorySize int64
+
+	// MaxSize is the number of bytes of log messages which
+	// a task may log before further entries are dropped.
+	// If it is 0, logs are unbounded.
+	MaxSize int64
+}
+
+// TaskLogs configures the logs of all LiveTasks.
+// It should be set before any tasks are run.
+var TaskLogs = TaskLogConfig{MemorySize: 1 << 20}
+
+// TaskLogInfo describes the task which a log belongs to.
+// It is saved along with the log's files, so that the log
+// can be found after the master restarts.
+type TaskLogInfo struct {
+	JobID     string
+	JobName   string
+	RunID     string
+	SlaveName string
+	TaskIndex int
+
+	StartTime time.Time
+
+	// EndTime is zero if the master stopped before the
+	// task finished.
+	EndTime time.Time
+}
+
+// taskLogIndex is saved next to the chunk files of a log.
+type taskLogIndex struct {
+	Info   TaskLogInfo
+	Chunks []int
+}
+
+// A taskLog stores the log entries of a task.
+// Recent entries are kept in memory, while older entries
+// are written to compressed chunk files.
+// Once the task finishes, the rest of the entries are
+// written out as well.
+//
+// The chunk files are deleted when the task is dropped
+// from the scheduler's history.
+// As a backstop, they are also deleted if the taskLog is
+// garbage collected without being dropped.
+//
+// A taskLog is not safe for concurrent use.
+type taskLog struct {
+	config TaskLogConfig
+	info   TaskLogInfo
+
+	dir      string
+	chunks   []logChunk
+	spillErr error
+
+	mem      []jobproto.LogEntry
+	memStart int
+	memBytes int64
+
+	totalBytes   int64
+	truncated    bool
+	dropped      int
+	droppedBytes int64
+}
+
+type logChunk struct {
+	start int
+	count int
+	path  string
+}
+
+func newTaskLog(config TaskLogConfig) *taskLog {
+	return &taskLog{config: config}
+}
+
+// Len returns the number of stored entries.
+func (t *taskLog) Len() int {
+	return t.memStart + len(t.mem)
+}
+
+// Add stores a new entry.
+// Once the log reaches its maximum size, a truncation
+// marker is stored instead and later entries are counted
+// but dropped.
+func (t *taskLog) Add(e jobproto.LogEntry) {
+	size := int64(len(e.Message))
+	if t.truncated {
+		t.dropped++
+		t.droppedBytes += size
+		return
+	}
+	if t.config.MaxSize > 0 && t.totalBytes+size > t.config.MaxSize {
+		t.truncated = true
+		t.dropped++
+		t.droppedBytes += size
+		t.append(t.marker(e.Seq, fmt.Sprintf("log truncated: exceeded %d bytes",
+			t.config.MaxSize)))
+		return
+	}
+	t.totalBytes += size
+	t.append(e)
+}
+
+// Finish stores a final marker if any entries were
+// dropped, and then spills the in-memory entries so that
+// finished tasks do not hold on to memory.
+func (t *taskLog) Finish() {
+	if t.dropped > 0 {
+		t.append(t.marker(t.Len(), fmt.Sprintf("dropped %d log entries (%d bytes)",
+			t.dropped, t.droppedBytes)))
+	}
+	t.info.EndTime = time.Now()
+	if t.config.SpillDir != "" && t.spillErr == nil && len(t.mem) > 0 {
+		t.spillErr = t.spill(len(t.mem))
+	} else if t.dir != "" && t.spillErr == nil {
+		t.spillErr = t.saveIndex()
+	}
+}
+
+// Entries returns the entries in the range [start, end).
+// Entries which cannot be read back from disk are replaced
+// with entries describing the error.
+func (t *taskLog) Entries(start, end int) []jobproto.LogEntry {
+	if start >= t.memStart {
+		return t.mem[start-t.memStart : end-t.memStart]
+	}
+	res := make([]jobproto.LogEntry, 0, end-start)
+	for _, chunk := range t.chunks {
+		chunkEnd := chunk.start + chunk.count
+		if chunkEnd <= start || chunk.start >= end {
+			continue
+		}
+		entries, err := readLogChunk(chunk.path)
+		if err == nil && len(entries) != chunk.count {
+			err = fmt.Errorf("expected %d entries but got %d", chunk.count, len(entries))
+		}
+		if err != nil {
+			entries = make([]jobproto.LogEntry, chunk.count)
+			for i := range entries {
+				entries[i] = t.marker(chunk.start+i, "read spilled log: "+err.Error())
+			}
+		}
+		lo, hi := 0, chunk.count
+		if start > chunk.start {
+			lo = start - chunk.start
+		}
+		if end < chunkEnd {
+			hi = end - chunk.start
+		}
+		res = append(res, entries[lo:hi]...)
+	}
+	if end > t.memStart {
+		res = append(res, t.mem[:end-t.memStart]...)
+	}
+	return res
+}
+
+func (t *taskLog) append(e jobproto.LogEntry) {
+	t.mem = append(t.mem, e)
+	t.memBytes += int64(len(e.Message))
+	if t.config.SpillDir != "" && t.spillErr == nil && t.memBytes > t.config.MemorySize {
+		// Spill until half of the memory limit is in use.
+		var count int
+		var spillBytes int64
+		for count < len(t.mem) && t.memBytes-spillBytes > t.config.MemorySize/2 {
+			spillBytes += int64(len(t.mem[count].Message))
+			count++
+		}
+		t.spillErr = t.spill(count)
+	}
+}
+
+// spill writes the oldest count in-memory entries to a
+// chunk file.
+func (t *taskLog) spill(count int) error {
+	if t.dir == "" {
+		if err := os.MkdirAll(t.config.SpillDir, 0700); err != nil {
+			return err
+		}
+		dir, err := ioutil.TempDir(t.config.SpillDir, taskLogDirPrefix)
+		if err != nil {
+			return err
+		}
+		t.dir = dir
+		runtime.SetFinalizer(t, (*taskLog).removeChunks)
+	}
+
+	var spillBytes int64
+	for _, e := range t.mem[:count] {
+		spillBytes += int64(len(e.Message))
+	}
+	chunk := logChunk{
+		start: t.memStart,
+		count: count,
+		path:  logChunkPath(t.dir, len(t.chunks)),
+	}
+	if err := writeLogChunk(chunk.path, t.mem[:count]); err != nil {
+		return err
+	}
+	t.chunks = append(t.chunks, chunk)
+	if err := t.saveIndex(); err != nil {
+		return err
+	}
+
+	// Copy the remaining entries so that the spilled ones
+	// can be garbage collected.
+	t.mem = append([]jobproto.LogEntry{}, t.mem[count:]...)
+	t.memStart += count
+	t.memBytes -= spillBytes
+	return nil
+}
+
+// saveIndex writes the task info and the sizes of the
+// chunks to the log's directory.
+func (t *taskLog) saveIndex() error {
+	index := taskLogIndex{Info: t.info}
+	for _, chunk := range t.chunks {
+		index.Chunks = append(index.Chunks, chunk.count)
+	}
+	data, err := json.Marshal(&index)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(t.dir, taskLogIndexFile)
+	if err := ioutil.WriteFile(path+".tmp", data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// removeChunks deletes the chunk files.
+// Entries which were stored in them can no longer be read.
+func (t *taskLog) removeChunks() {
+	if t.dir != "" {
+		os.RemoveAll(t.dir)
+		runtime.SetFinalizer(t, nil)
+	}
+}
+
+func (t *taskLog) marker(seq int, message string) jobproto.LogEntry {
+	return jobproto.LogEntry{
+		FromMaster: true,
+		Message:    message,
+		Time:       time.Now(),
+		Stream:     jobproto.StreamSystem,
+		Seq:        seq,
+	}
+}
+
+// loadTaskLog reads back a log which was spilled into a
+// directory, using the directory's index.
+// The resulting log should only be read from.
+func loadTaskLog(dir string) (*taskLog, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, taskLogIndexFile))
+	if err != nil {
+		return nil, err
+	}
+	var index taskLogIndex
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, err
+	}
+	t := &taskLog{info: index.Info, dir: dir}
+	for i, count := range index.Chunks {
+		t.chunks = append(t.chunks, logChunk{
+			start: t.memStart,
+			count: count,
+			path:  logChunkPath(dir, i),
+		})
+		t.memStart += count
+	}
+	return t, nil
+}
+
+func logChunkPath(dir string, i int) string {
+	return filepath.Join(dir, fmt.Sprintf("%d.gob.gz", i))
+}
+
+func writeLogChunk(path string, entries []jobproto.LogEntry) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(path)
+		}
+	}()
+	w := gzip.NewWriter(f)
+	if err := gob.NewEncoder(w).Encode(entries); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func readLogChunk(path string) ([]jobproto.LogEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	var entries []jobproto.LogEntry
+	if err := gob.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
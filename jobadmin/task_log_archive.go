@@ -0,0 +1,136 @@
+package jobadmin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/unixpickle/jobempire/jobproto"
+)
+
+// A TaskLogArchive holds the task logs which previous runs
+// of the master left in a spill directory, so that they
+// can still be searched and downloaded.
+//
+// Logs are deleted once they are older than the archive's
+// maximum age.
+type TaskLogArchive struct {
+	dir    string
+	maxAge time.Duration
+
+	lock sync.RWMutex
+	logs []*ArchivedTaskLog
+}
+
+// An ArchivedTaskLog is the log of a task from a previous
+// run of the master.
+type ArchivedTaskLog struct {
+	// ID identifies the log within its archive.
+	ID string
+
+	Info TaskLogInfo
+
+	log *taskLog
+}
+
+// LoadTaskLogArchive loads the task logs in a spill
+// directory.
+// This should be done before any tasks are run, since the
+// logs of those tasks would be loaded as well.
+//
+// Logs older than maxAge are deleted, along with logs which
+// cannot be read back.
+// If maxAge is 0, logs are kept forever.
+func LoadTaskLogArchive(dir string, maxAge time.Duration) (*TaskLogArchive, error) {
+	a := &TaskLogArchive{dir: dir, maxAge: maxAge}
+	listing, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return a, nil
+		}
+		return nil, err
+	}
+	for _, info := range listing {
+		if !info.IsDir() || !strings.HasPrefix(info.Name(), taskLogDirPrefix) {
+			continue
+		}
+		path := filepath.Join(dir, info.Name())
+		log, err := loadTaskLog(path)
+		if err != nil {
+			if err := os.RemoveAll(path); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		a.logs = append(a.logs, &ArchivedTaskLog{ID: info.Name(), Info: log.info, log: log})
+	}
+	sort.Slice(a.logs, func(i, j int) bool {
+		return a.logs[i].Info.StartTime.After(a.logs[j].Info.StartTime)
+	})
+	return a, a.Prune()
+}
+
+// Logs returns the archived logs, newest first.
+func (a *TaskLogArchive) Logs() []*ArchivedTaskLog {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	return append([]*ArchivedTaskLog{}, a.logs...)
+}
+
+// Log finds an archived log by its ID.
+// It returns nil if there is no such log.
+func (a *TaskLogArchive) Log(id string) *ArchivedTaskLog {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	for _, l := range a.logs {
+		if l.ID == id {
+			return l
+		}
+	}
+	return nil
+}
+
+// Prune deletes the logs which are older than the
+// archive's maximum age.
+// The age of a log is measured from the end of its task,
+// or from its start if the task never finished.
+func (a *TaskLogArchive) Prune() error {
+	if a.maxAge == 0 {
+		return nil
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	var kept []*ArchivedTaskLog
+	var firstErr error
+	for _, l := range a.logs {
+		lastTime := l.Info.EndTime
+		if lastTime.IsZero() {
+			lastTime = l.Info.StartTime
+		}
+		if time.Since(lastTime) <= a.maxAge {
+			kept = append(kept, l)
+		} else if err := os.RemoveAll(l.log.dir); err != nil {
+			kept = append(kept, l)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	a.logs = kept
+	return firstErr
+}
+
+// LogSize returns the number of log entries.
+func (a *ArchivedTaskLog) LogSize() int {
+	return a.log.Len()
+}
+
+// LogEntries returns the log entries in the given range.
+// It is like LiveTask.LogEntries.
+func (a *ArchivedTaskLog) LogEntries(start, end int) []jobproto.LogEntry {
+	return a.log.Entries(start, end)
+}
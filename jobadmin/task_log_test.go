@@ -0,0 +1,211 @@
+package jobadmin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/unixpickle/jobempire/jobproto"
+)
+
+func TestTaskLogSpill(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobadmin_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	log := newTaskLog(TaskLogConfig{SpillDir: tempDir, MemorySize: 100})
+	for i := 0; i < 100; i++ {
+		log.Add(testLogEntry(i, strconv.Itoa(i%10)+"_________"))
+	}
+	if log.spillErr != nil {
+		t.Fatal(log.spillErr)
+	}
+	if len(log.chunks) < 2 {
+		t.Fatalf("expected several chunks but got %d", len(log.chunks))
+	}
+	if log.memBytes > 100 {
+		t.Errorf("memory has %d bytes", log.memBytes)
+	}
+	checkTaskLog(t, log, 100)
+
+	log.Finish()
+	if log.spillErr != nil {
+		t.Fatal(log.spillErr)
+	}
+	if len(log.mem) != 0 || log.memStart != 100 {
+		t.Errorf("finished log still has %d entries in memory", len(log.mem))
+	}
+	checkTaskLog(t, log, 100)
+
+	dir := log.dir
+	log.removeChunks()
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Error("chunk directory was not removed")
+	}
+}
+
+func TestTaskLogMemory(t *testing.T) {
+	log := newTaskLog(TaskLogConfig{MemorySize: 10})
+	for i := 0; i < 50; i++ {
+		log.Add(testLogEntry(i, "0123456789"))
+	}
+	log.Finish()
+	if len(log.chunks) != 0 || len(log.mem) != 50 {
+		t.Error("log without a spill directory should stay in memory")
+	}
+	checkTaskLog(t, log, 50)
+}
+
+func TestTaskLogMaxSize(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobadmin_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	log := newTaskLog(TaskLogConfig{SpillDir: tempDir, MemorySize: 20, MaxSize: 50})
+	for i := 0; i < 20; i++ {
+		log.Add(testLogEntry(i, "0123456789"))
+	}
+	log.Finish()
+
+	if log.Len() != 7 {
+		t.Fatalf("expected 7 entries but got %d", log.Len())
+	}
+	entries := log.Entries(0, log.Len())
+	for i, e := range entries[:5] {
+		if e.FromMaster || e.Seq != i {
+			t.Errorf("entry %d: unexpected entry %v", i, e)
+		}
+	}
+	if !entries[5].FromMaster || !strings.Contains(entries[5].Message, "truncated") {
+		t.Errorf("bad truncation marker: %v", entries[5])
+	}
+	if !entries[6].FromMaster || !strings.Contains(entries[6].Message, "dropped 15") {
+		t.Errorf("bad drop marker: %v", entries[6])
+	}
+}
+
+func TestTaskLogArchive(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobadmin_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	config := TaskLogConfig{SpillDir: tempDir, MemorySize: 100}
+	var logs []*taskLog
+	for i, name := range []string{"old", "new", "unfinished"} {
+		log := newTaskLog(config)
+		log.info = TaskLogInfo{JobName: name, StartTime: time.Now().Add(-time.Minute)}
+		for j := 0; j < 30; j++ {
+			log.Add(testLogEntry(j, "0123456789"))
+		}
+		switch i {
+		case 0:
+			log.Finish()
+			log.info.EndTime = time.Now().Add(-2 * time.Hour)
+			if err := log.saveIndex(); err != nil {
+				t.Fatal(err)
+			}
+		case 1:
+			log.Finish()
+		}
+		if log.spillErr != nil {
+			t.Fatal(log.spillErr)
+		}
+		logs = append(logs, log)
+	}
+	for _, name := range []string{"task_noindex", "other"} {
+		if err := os.Mkdir(filepath.Join(tempDir, name), 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	archive, err := LoadTaskLogArchive(tempDir, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	archived := archive.Logs()
+	if len(archived) != 2 {
+		t.Fatalf("expected 2 logs but got %d", len(archived))
+	}
+	for _, a := range archived {
+		if archive.Log(a.ID) != a {
+			t.Errorf("could not look up log %s", a.ID)
+		}
+		switch a.Info.JobName {
+		case "new":
+			if a.Info.EndTime.IsZero() {
+				t.Error("finished log has no end time")
+			}
+			checkTaskLog(t, a.log, 30)
+		case "unfinished":
+			if !a.Info.EndTime.IsZero() {
+				t.Error("unfinished log has an end time")
+			}
+			if a.LogSize() == 0 || a.LogSize() >= 30 {
+				t.Errorf("unexpected size of unfinished log: %d", a.LogSize())
+			}
+		default:
+			t.Errorf("unexpected log: %s", a.Info.JobName)
+		}
+	}
+	if archive.Log("other") != nil {
+		t.Error("found log which does not exist")
+	}
+
+	listing, err := ioutil.ReadDir(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, info := range listing {
+		names = append(names, info.Name())
+	}
+	expected := []string{filepath.Base(logs[1].dir), filepath.Base(logs[2].dir), "other"}
+	sort.Strings(expected)
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected files %v but got %v", expected, names)
+	}
+
+	archive, err = LoadTaskLogArchive(filepath.Join(tempDir, "missing"), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	} else if len(archive.Logs()) != 0 {
+		t.Error("missing directory should give an empty archive")
+	}
+}
+
+// checkTaskLog checks that every range of a log created
+// by testLogEntry can be read.
+func checkTaskLog(t *testing.T, log *taskLog, size int) {
+	if log.Len() != size {
+		t.Fatalf("expected %d entries but got %d", size, log.Len())
+	}
+	for start := 0; start <= size; start += 7 {
+		for end := start; end <= size; end += 11 {
+			entries := log.Entries(start, end)
+			if len(entries) != end-start {
+				t.Fatalf("range [%d, %d): got %d entries", start, end, len(entries))
+			}
+			for i, e := range entries {
+				if e.Seq != start+i {
+					t.Fatalf("range [%d, %d): entry %d has seq %d", start, end, i, e.Seq)
+				}
+			}
+		}
+	}
+}
+
+func testLogEntry(seq int, message string) jobproto.LogEntry {
+	return jobproto.LogEntry{Message: message, Seq: seq, Stream: jobproto.StreamStdout}
+}
@@ -0,0 +1,27 @@
+package jobadmin
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTaskUnmarshalJSON(t *testing.T) {
+	var task Task
+	data := `{"GoRun": {"GoSourceDir": "dir"}, "Retry": {"MaxRetries": 2}}`
+	if err := json.Unmarshal([]byte(data), &task); err != nil {
+		t.Fatal(err)
+	}
+	if task.Retry == nil || task.Retry.MaxRetries != 2 {
+		t.Errorf("unexpected retry rule: %v", task.Retry)
+	}
+
+	for _, data := range []string{
+		`{"Group": {"Tasks": []}, "Retry": {"MaxRetries": 2}}`,
+		`{"GoRun": {"GoSourceDir": "dir"}, "RunIf": "sometimes"}`,
+		`{}`,
+	} {
+		if err := json.Unmarshal([]byte(data), &task); err == nil {
+			t.Errorf("expected error for %s", data)
+		}
+	}
+}
@@ -0,0 +1,601 @@
+package jobproto
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Archive formats.
+const (
+	ArchiveTar   = "tar"
+	ArchiveTarGz = "tar.gz"
+	ArchiveZip   = "zip"
+)
+
+func init() {
+	gob.Register(&ArchiveExtract{})
+	gob.Register(&ArchiveCreate{})
+}
+
+// ArchiveExtract is a Task which extracts an archive on
+// the slave.
+//
+// Entries which would be extracted outside of the
+// destination directory cause the task to fail, as do
+// links which point outside of it.
+type ArchiveExtract struct {
+	// Archive is the path of the archive, relative to the
+	// job's directory.
+	Archive string
+
+	// Dest is the directory to extract into, relative to
+	// the job's directory.
+	// If it is empty, the job's directory is used.
+	Dest string
+
+	// Format is ArchiveTar, ArchiveTarGz, or ArchiveZip.
+	// If it is empty, it is guessed from the archive's
+	// extension.
+	Format string
+}
+
+// RunMaster runs the master's end of the task, which
+// does nothing.
+func (a *ArchiveExtract) RunMaster(ch TaskChannel) error {
+	return nil
+}
+
+// RunSlave extracts the archive.
+func (a *ArchiveExtract) RunSlave(root string, ch TaskChannel) error {
+	format, err := archiveFormat(a.Format, a.Archive)
+	if err != nil {
+		return err
+	}
+	archivePath, err := jobFilePath(root, a.Archive)
+	if err != nil {
+		return err
+	}
+	dest := root
+	if a.Dest != "" {
+		dest, err = jobFilePath(root, a.Dest)
+		if err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return err
+	}
+
+	x := &extractor{dest: dest}
+	switch format {
+	case ArchiveZip:
+		err = x.ExtractZip(archivePath)
+	default:
+		err = x.ExtractTar(archivePath, format == ArchiveTarGz)
+	}
+	if err != nil {
+		return err
+	}
+	ch.Log(fmt.Sprintf("extracted %d files from %s", x.count, a.Archive))
+	return nil
+}
+
+// ArchiveCreate is a Task which creates an archive on the
+// slave from files in the job's directory.
+type ArchiveCreate struct {
+	// Archive is the path of the archive to create,
+	// relative to the job's directory.
+	Archive string
+
+	// Patterns contains glob patterns, relative to the
+	// job's directory, for the files to archive.
+	// Matching directories are archived recursively.
+	Patterns []string
+
+	// Format is ArchiveTar, ArchiveTarGz, or ArchiveZip.
+	// If it is empty, it is guessed from the archive's
+	// extension.
+	Format string
+}
+
+// RunMaster runs the master's end of the task, which
+// does nothing.
+func (a *ArchiveCreate) RunMaster(ch TaskChannel) error {
+	return nil
+}
+
+// RunSlave creates the archive.
+func (a *ArchiveCreate) RunSlave(root string, ch TaskChannel) error {
+	format, err := archiveFormat(a.Format, a.Archive)
+	if err != nil {
+		return err
+	}
+	archivePath, err := jobFilePath(root, a.Archive)
+	if err != nil {
+		return err
+	}
+	files, err := findArtifacts(root, a.Patterns)
+	if err != nil {
+		return err
+	}
+	archiveRel, _ := filepath.Rel(root, archivePath)
+	for i, file := range files {
+		if file == filepath.ToSlash(archiveRel) {
+			files = append(files[:i], files[i+1:]...)
+			break
+		}
+	}
+	if len(files) == 0 {
+		return errors.New("no files match the patterns")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(archivePath), 0755); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(archivePath), fileCacheTempPrefix)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	switch format {
+	case ArchiveZip:
+		err = writeZip(f, root, files)
+	default:
+		err = writeTar(f, root, files, format == ArchiveTarGz)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(f.Name(), archivePath); err != nil {
+		return err
+	}
+	ch.Log(fmt.Sprintf("archived %d files into %s", len(files), a.Archive))
+	return nil
+}
+
+func writeTar(w io.Writer, root string, files []string, compress bool) error {
+	if compress {
+		gz := gzip.NewWriter(w)
+		if err := writeTar(gz, root, files, false); err != nil {
+			return err
+		}
+		return gz.Close()
+	}
+	tw := tar.NewWriter(w)
+	for _, file := range files {
+		err := withArchiveFile(root, file, func(f *os.File, info os.FileInfo) error {
+			header, err := tar.FileInfoHeader(info, "")
+			if err != nil {
+				return err
+			}
+			header.Name = file
+			if err := tw.WriteHeader(header); err != nil {
+				return err
+			}
+			_, err = io.Copy(tw, f)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+func writeZip(w io.Writer, root string, files []string) error {
+	zw := zip.NewWriter(w)
+	for _, file := range files {
+		err := withArchiveFile(root, file, func(f *os.File, info os.FileInfo) error {
+			header, err := zip.FileInfoHeader(info)
+			if err != nil {
+				return err
+			}
+			header.Name = file
+			header.Method = zip.Deflate
+			entry, err := zw.CreateHeader(header)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(entry, f)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func withArchiveFile(root, file string, fn func(f *os.File, info os.FileInfo) error) error {
+	f, err := os.Open(filepath.Join(root, filepath.FromSlash(file)))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if err := fn(f, info); err != nil {
+		return fmt.Errorf("archive %s: %s", file, err)
+	}
+	return nil
+}
+
+// maxLinkDepth is the number of links an extractor will
+// follow while resolving a single link target.
+const maxLinkDepth = 40
+
+// extractor extracts archive entries into a directory
+// without writing anything outside of it.
+type extractor struct {
+	dest  string
+	count int
+}
+
+func (e *extractor) ExtractTar(archivePath string, compressed bool) error {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var r io.Reader = f
+	if compressed {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if isArchiveRoot(header.Name) {
+			continue
+		}
+		mode := os.FileMode(header.Mode).Perm()
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = e.Dir(header.Name, mode)
+		case tar.TypeReg, tar.TypeRegA:
+			err = e.File(header.Name, mode, tr)
+		case tar.TypeSymlink:
+			err = e.Symlink(header.Name, header.Linkname)
+		case tar.TypeLink:
+			err = e.Link(header.Name, header.Linkname)
+		default:
+			// Devices, FIFOs, and metadata entries are skipped.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeSymlink {
+			p, _ := e.path(header.Name)
+			os.Chtimes(p, header.ModTime, header.ModTime)
+		}
+	}
+}
+
+func (e *extractor) ExtractZip(archivePath string) error {
+	zr, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	for _, file := range zr.File {
+		if isArchiveRoot(file.Name) {
+			continue
+		}
+		mode := file.Mode()
+		switch {
+		case mode.IsDir():
+			err = e.Dir(file.Name, mode.Perm())
+		case mode&os.ModeSymlink != 0:
+			err = e.zipSymlink(file)
+		case mode.IsRegular():
+			err = e.zipFile(file)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if mode&os.ModeSymlink == 0 {
+			p, _ := e.path(file.Name)
+			os.Chtimes(p, file.Modified, file.Modified)
+		}
+	}
+	return nil
+}
+
+func (e *extractor) zipFile(file *zip.File) error {
+	r, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	return e.File(file.Name, file.Mode().Perm(), r)
+}
+
+func (e *extractor) zipSymlink(file *zip.File) error {
+	r, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	target, err := ioutil.ReadAll(io.LimitReader(r, 4096))
+	if err != nil {
+		return err
+	}
+	return e.Symlink(file.Name, string(target))
+}
+
+func (e *extractor) Dir(name string, mode os.FileMode) error {
+	p, err := e.parent(name)
+	if err != nil {
+		return err
+	}
+	if info, err := os.Lstat(p); err == nil {
+		if !info.IsDir() {
+			return fmt.Errorf("unsafe archive entry: %s", name)
+		}
+		return nil
+	}
+	return os.Mkdir(p, mode|0700)
+}
+
+func (e *extractor) File(name string, mode os.FileMode, r io.Reader) error {
+	p, err := e.parent(name)
+	if err != nil {
+		return err
+	}
+	if err := e.remove(name, p); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, r); err != nil {
+		return fmt.Errorf("extract %s: %s", name, err)
+	}
+	e.count++
+	return nil
+}
+
+// Symlink creates a symbolic link, making sure that it
+// leads somewhere inside the destination.
+//
+// Since the link's target is checked against the links
+// and directories which exist at the time, those are never
+// replaced later on.
+func (e *extractor) Symlink(name, target string) error {
+	p, err := e.parent(name)
+	if err != nil {
+		return err
+	}
+	if path.IsAbs(target) || strings.Contains(target, "\\") ||
+		strings.Contains(target, "\x00") {
+		return fmt.Errorf("unsafe link %s -> %s", name, target)
+	}
+	clean, _ := cleanArchivePath(name)
+	dir := strings.Split(path.Dir(clean), "/")
+	if dir[0] == "." {
+		dir = nil
+	}
+	if _, _, err := e.resolveLink(dir, target, 0); err != nil {
+		return fmt.Errorf("unsafe link %s -> %s", name, target)
+	}
+	if existing, err := os.Readlink(p); err == nil {
+		if existing == target {
+			return nil
+		}
+		return fmt.Errorf("unsafe archive entry: %s", name)
+	}
+	if err := e.remove(name, p); err != nil {
+		return err
+	}
+	return os.Symlink(target, p)
+}
+
+func (e *extractor) Link(name, target string) error {
+	p, err := e.parent(name)
+	if err != nil {
+		return err
+	}
+	targetPath, err := e.parent(target)
+	if err != nil {
+		return fmt.Errorf("unsafe link %s -> %s", name, target)
+	}
+	if info, err := os.Lstat(targetPath); err != nil {
+		return err
+	} else if !info.Mode().IsRegular() {
+		return fmt.Errorf("unsafe link %s -> %s", name, target)
+	}
+	if err := e.remove(name, p); err != nil {
+		return err
+	}
+	if err := os.Link(targetPath, p); err != nil {
+		return err
+	}
+	e.count++
+	return nil
+}
+
+// remove deletes an existing entry which is about to be
+// replaced, so that nothing is written through a link.
+// Directories are never replaced, since links may have
+// been checked against them.
+func (e *extractor) remove(name, p string) error {
+	info, err := os.Lstat(p)
+	if err != nil {
+		return nil
+	} else if info.IsDir() {
+		return fmt.Errorf("unsafe archive entry: %s", name)
+	}
+	return os.Remove(p)
+}
+
+// resolveLink follows a link target from a directory,
+// both relative to the destination, and returns the path
+// it leads to.
+// Links which are already on disk are followed, and the
+// path must stay inside the destination at every step.
+//
+// The second return value is false if some component of
+// the path is not a directory yet.
+// Since such a component might become a link later, no
+// ".." may come after it.
+func (e *extractor) resolveLink(dir []string, target string,
+	depth int) ([]string, bool, error) {
+	if depth > maxLinkDepth {
+		return nil, false, errors.New("too many levels of links")
+	}
+	res := append([]string{}, dir...)
+	settled := true
+	for _, part := range strings.Split(target, "/") {
+		switch {
+		case part == "" || part == ".":
+			continue
+		case part == "..":
+			if !settled || len(res) == 0 {
+				return nil, false, errors.New("link leaves destination")
+			}
+			res = res[:len(res)-1]
+			continue
+		case !settled:
+			res = append(res, part)
+			continue
+		}
+		p := filepath.Join(e.dest, filepath.Join(res...), part)
+		info, err := os.Lstat(p)
+		if err != nil {
+			res = append(res, part)
+			settled = false
+		} else if info.Mode()&os.ModeSymlink != 0 {
+			linkTarget, err := os.Readlink(p)
+			if err != nil {
+				return nil, false, err
+			} else if filepath.IsAbs(linkTarget) {
+				return nil, false, errors.New("link leaves destination")
+			}
+			res, settled, err = e.resolveLink(res, filepath.ToSlash(linkTarget), depth+1)
+			if err != nil {
+				return nil, false, err
+			}
+		} else {
+			res = append(res, part)
+			settled = info.IsDir()
+		}
+	}
+	return res, settled, nil
+}
+
+// parent computes the path for an entry and creates its
+// parent directories.
+// It fails if any of the parent directories is a link,
+// since writing through it could escape the destination.
+func (e *extractor) parent(name string) (string, error) {
+	clean, err := cleanArchivePath(name)
+	if err != nil {
+		return "", err
+	}
+	dir := e.dest
+	parts := strings.Split(clean, "/")
+	for _, part := range parts[:len(parts)-1] {
+		dir = filepath.Join(dir, part)
+		info, err := os.Lstat(dir)
+		if os.IsNotExist(err) {
+			if err := os.Mkdir(dir, 0755); err != nil {
+				return "", err
+			}
+			continue
+		} else if err != nil {
+			return "", err
+		}
+		if !info.IsDir() {
+			return "", fmt.Errorf("unsafe archive entry: %s", name)
+		}
+	}
+	return filepath.Join(dir, parts[len(parts)-1]), nil
+}
+
+func (e *extractor) path(name string) (string, error) {
+	clean, err := cleanArchivePath(name)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(e.dest, filepath.FromSlash(clean)), nil
+}
+
+// cleanArchivePath makes sure that an archive entry's
+// name stays within the destination directory.
+func cleanArchivePath(name string) (string, error) {
+	clean := path.Clean(strings.TrimSuffix(name, "/"))
+	if clean == "." || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") ||
+		strings.Contains(name, "\\") || strings.Contains(name, "\x00") {
+		return "", errors.New("unsafe archive entry: " + name)
+	}
+	return clean, nil
+}
+
+// isArchiveRoot checks if an entry's name refers to the
+// destination directory itself, as in "./".
+func isArchiveRoot(name string) bool {
+	return path.Clean(name) == "."
+}
+
+// jobFilePath resolves a path relative to the job's
+// directory, failing if it is outside of the directory.
+func jobFilePath(root, p string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(p))
+	if clean == "." || filepath.IsAbs(clean) || clean == ".." ||
+		strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", errors.New("path outside of job directory: " + p)
+	}
+	return filepath.Join(root, clean), nil
+}
+
+// archiveFormat decides on the format of an archive.
+func archiveFormat(format, archivePath string) (string, error) {
+	switch format {
+	case ArchiveTar, ArchiveTarGz, ArchiveZip:
+		return format, nil
+	case "tgz":
+		return ArchiveTarGz, nil
+	case "":
+	default:
+		return "", errors.New("unknown archive format: " + format)
+	}
+	lower := strings.ToLower(archivePath)
+	switch {
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		return ArchiveTarGz, nil
+	case strings.HasSuffix(lower, ".tar"):
+		return ArchiveTar, nil
+	case strings.HasSuffix(lower, ".zip"):
+		return ArchiveZip, nil
+	}
+	return "", errors.New("unknown archive format for " + archivePath)
+}
@@ -0,0 +1,184 @@
+package jobproto
+
+import (
+	"archive/tar"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestArchiveRoundTrip(t *testing.T) {
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+	job, err := master.StartJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+
+	files := map[string]string{
+		"results/a.txt":       "hello",
+		"results/sub/b.txt":   "world",
+		"results/sub/c.bin":   "\x00\x01\x02",
+		"results/ignored.log": "log",
+	}
+	for name, contents := range files {
+		p := filepath.Join(tempDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	delete(files, "results/ignored.log")
+
+	for _, name := range []string{"out.tar", "out.tar.gz", "out.zip"} {
+		err := job.Run(&ArchiveCreate{
+			Archive:  name,
+			Patterns: []string{"results/*.txt", "results/sub"},
+		}, nil)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		dest := "extracted-" + name
+		if err := job.Run(&ArchiveExtract{Archive: name, Dest: dest}, nil); err != nil {
+			t.Fatal(name, err)
+		}
+		for file, expected := range files {
+			p := filepath.Join(tempDir, dest, filepath.FromSlash(file))
+			if contents, err := ioutil.ReadFile(p); err != nil {
+				t.Error(name, err)
+			} else if string(contents) != expected {
+				t.Errorf("%s: bad contents for %s", name, file)
+			}
+		}
+		ignored := filepath.Join(tempDir, dest, "results", "ignored.log")
+		if _, err := os.Stat(ignored); !os.IsNotExist(err) {
+			t.Errorf("%s: unexpected file", name)
+		}
+	}
+}
+
+func TestArchiveExtractUnsafe(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	root := filepath.Join(tempDir, "root")
+	if err := os.Mkdir(root, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	archives := [][]*tar.Header{
+		{{Name: "../escape", Typeflag: tar.TypeReg}},
+		{{Name: "/escape", Typeflag: tar.TypeReg}},
+		{{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "../"}},
+		{{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/tmp"}},
+		{{Name: "hard", Typeflag: tar.TypeLink, Linkname: "../escape"}},
+		{
+			{Name: "dir/link", Typeflag: tar.TypeSymlink, Linkname: "../other"},
+			{Name: "dir/link/escape", Typeflag: tar.TypeReg},
+		},
+		{
+			{Name: "a/b/up", Typeflag: tar.TypeSymlink, Linkname: ".."},
+			{Name: "q", Typeflag: tar.TypeSymlink, Linkname: "a/b/up/../../../escape"},
+		},
+		{
+			{Name: "a/b/up", Typeflag: tar.TypeSymlink, Linkname: ".."},
+			{Name: "a/up2", Typeflag: tar.TypeSymlink, Linkname: "b/up/.."},
+			{Name: "a/up2", Typeflag: tar.TypeSymlink, Linkname: "../.."},
+		},
+		{
+			{Name: "x/y", Typeflag: tar.TypeSymlink, Linkname: "z/.."},
+			{Name: "x/z", Typeflag: tar.TypeSymlink, Linkname: "../.."},
+		},
+		{
+			{Name: "loop", Typeflag: tar.TypeSymlink, Linkname: "loop/x"},
+			{Name: "q", Typeflag: tar.TypeSymlink, Linkname: "loop/.."},
+		},
+	}
+	for i, headers := range archives {
+		archivePath := filepath.Join(root, "unsafe.tar")
+		f, err := os.Create(archivePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tw := tar.NewWriter(f)
+		for _, header := range headers {
+			header.Mode = 0644
+			if err := tw.WriteHeader(header); err != nil {
+				t.Fatal(err)
+			}
+		}
+		tw.Close()
+		f.Close()
+
+		task := &ArchiveExtract{Archive: "unsafe.tar", Dest: "dest"}
+		if err := task.RunSlave(root, nil); err == nil {
+			t.Errorf("archive %d: expected error", i)
+		}
+		if _, err := os.Lstat(filepath.Join(tempDir, "escape")); !os.IsNotExist(err) {
+			t.Fatalf("archive %d: file escaped", i)
+		}
+		os.RemoveAll(filepath.Join(root, "dest"))
+	}
+
+	task := &ArchiveExtract{Archive: "../unsafe.tar"}
+	if err := task.RunSlave(root, nil); err == nil {
+		t.Error("expected error for archive outside root")
+	}
+
+	safe := []*tar.Header{
+		{Name: "file", Typeflag: tar.TypeReg},
+		{Name: "dir/link", Typeflag: tar.TypeSymlink, Linkname: "../file"},
+		{Name: "a/b/up", Typeflag: tar.TypeSymlink, Linkname: ".."},
+		{Name: "q", Typeflag: tar.TypeSymlink, Linkname: "a/b/up/../file"},
+		{Name: "q", Typeflag: tar.TypeSymlink, Linkname: "a/b/up/../file"},
+	}
+	archivePath := filepath.Join(root, "safe.tar")
+	f, err := os.Create(archivePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tw := tar.NewWriter(f)
+	for _, header := range safe {
+		header.Mode = 0644
+		if err := tw.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tw.Close()
+	f.Close()
+	if err := os.Mkdir(filepath.Join(root, "dest"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	x := &extractor{dest: filepath.Join(root, "dest")}
+	if err := x.ExtractTar(archivePath, false); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"dir/link", "q"} {
+		if _, err := os.Stat(filepath.Join(root, "dest", name)); err != nil {
+			t.Errorf("link %s: %s", name, err)
+		}
+	}
+}
@@ -0,0 +1,402 @@
+package jobproto
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Artifacts, if non-nil, stores the artifacts which GoRun
+// tasks upload to the master.
+var Artifacts *ArtifactStore
+
+// An ArtifactStore stores files uploaded by tasks in a
+// directory, grouped by the job run which produced them.
+//
+// Runs which are older than MaxAge are deleted, as are the
+// oldest runs once the store grows beyond MaxSize bytes.
+// A zero MaxSize or MaxAge means there is no limit.
+type ArtifactStore struct {
+	Dir     string
+	MaxSize int64
+	MaxAge  time.Duration
+
+	// uploads counts the calls to Store in progress for
+	// each run, so that Prune does not delete those runs.
+	lock    sync.Mutex
+	uploads map[string]int
+}
+
+// An ArtifactInfo describes a stored artifact.
+type ArtifactInfo struct {
+	// Path is the slash-separated path of the artifact,
+	// relative to the job's directory on the slave.
+	Path string
+
+	Size    int64
+	ModTime time.Time
+}
+
+// NewArtifactStore creates an ArtifactStore, creating the
+// directory if necessary.
+func NewArtifactStore(dir string, maxSize int64, maxAge time.Duration) (*ArtifactStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &ArtifactStore{Dir: dir, MaxSize: maxSize, MaxAge: maxAge}, nil
+}
+
+// List returns the artifacts for a run, sorted by path.
+// It returns an empty list if the run has no artifacts.
+func (a *ArtifactStore) List(runID string) ([]ArtifactInfo, error) {
+	if err := checkCacheKey(runID); err != nil {
+		return nil, err
+	}
+	runDir := filepath.Join(a.Dir, runID)
+	var res []ArtifactInfo
+	err := filepath.Walk(runDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && p == runDir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if info.IsDir() || strings.HasPrefix(info.Name(), fileCacheTempPrefix) {
+			return nil
+		}
+		rel, err := filepath.Rel(runDir, p)
+		if err != nil {
+			return err
+		}
+		res = append(res, ArtifactInfo{
+			Path:    filepath.ToSlash(rel),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+		return nil
+	})
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Path < res[j].Path
+	})
+	return res, err
+}
+
+// Open opens an artifact from a run.
+func (a *ArtifactStore) Open(runID, artifactPath string) (*os.File, error) {
+	p, err := a.path(runID, artifactPath)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(p)
+}
+
+// Store saves an artifact from a reader.
+// An existing artifact with the same path is replaced.
+func (a *ArtifactStore) Store(runID, artifactPath string, r io.Reader) error {
+	p, err := a.path(runID, artifactPath)
+	if err != nil {
+		return err
+	}
+	if err := a.startUpload(runID, filepath.Dir(p)); err != nil {
+		return err
+	}
+	defer a.finishUpload(runID)
+
+	tempPath := filepath.Join(filepath.Dir(p), fileCacheTempPrefix+filepath.Base(p))
+	f, err := os.Create(tempPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempPath)
+	_, err = io.Copy(f, r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tempPath, p)
+}
+
+// Prune deletes runs which exceed the size or age limits
+// of the store.
+// Runs which are being uploaded to are not deleted.
+func (a *ArtifactStore) Prune() error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	listing, err := ioutil.ReadDir(a.Dir)
+	if err != nil {
+		return err
+	}
+	type runInfo struct {
+		name    string
+		size    int64
+		modTime time.Time
+	}
+	var runs []runInfo
+	var totalSize int64
+	for _, info := range listing {
+		if !info.IsDir() {
+			continue
+		}
+		size, err := dirSize(filepath.Join(a.Dir, info.Name()))
+		if err != nil {
+			return err
+		}
+		runs = append(runs, runInfo{info.Name(), size, info.ModTime()})
+		totalSize += size
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].modTime.Before(runs[j].modTime)
+	})
+	for _, run := range runs {
+		tooBig := a.MaxSize > 0 && totalSize > a.MaxSize
+		tooOld := a.MaxAge > 0 && time.Since(run.modTime) > a.MaxAge
+		if (!tooBig && !tooOld) || a.uploads[run.name] > 0 {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(a.Dir, run.name)); err != nil {
+			return err
+		}
+		totalSize -= run.size
+	}
+	return nil
+}
+
+// startUpload creates the directory for an artifact and
+// marks its run as being uploaded to.
+func (a *ArtifactStore) startUpload(runID, dir string) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	// Touch the run directory so that retention is based on
+	// the latest upload.
+	now := time.Now()
+	os.Chtimes(filepath.Join(a.Dir, runID), now, now)
+
+	if a.uploads == nil {
+		a.uploads = map[string]int{}
+	}
+	a.uploads[runID]++
+	return nil
+}
+
+func (a *ArtifactStore) finishUpload(runID string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	a.uploads[runID]--
+	if a.uploads[runID] == 0 {
+		delete(a.uploads, runID)
+	}
+}
+
+func (a *ArtifactStore) path(runID, artifactPath string) (string, error) {
+	if err := checkCacheKey(runID); err != nil {
+		return "", err
+	}
+	clean, err := cleanArtifactPath(artifactPath)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(a.Dir, runID, filepath.FromSlash(clean)), nil
+}
+
+// cleanArtifactPath makes sure that a slash-separated path
+// is relative and stays within its root directory.
+func cleanArtifactPath(p string) (string, error) {
+	clean := path.Clean(p)
+	if clean == "." || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") ||
+		strings.Contains(p, "\\") {
+		return "", errors.New("invalid artifact path: " + p)
+	}
+	for _, part := range strings.Split(clean, "/") {
+		if strings.HasPrefix(part, fileCacheTempPrefix) {
+			return "", errors.New("invalid artifact path: " + p)
+		}
+	}
+	return clean, nil
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// findArtifacts finds the files in root which match any
+// of the glob patterns.
+// Matching directories are searched recursively.
+// The results are slash-separated paths relative to root.
+func findArtifacts(root string, patterns []string) ([]string, error) {
+	seen := map[string]bool{}
+	var res []string
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(root, filepath.FromSlash(pattern)))
+		if err != nil {
+			return nil, fmt.Errorf("artifact pattern %s: %s", pattern, err)
+		}
+		for _, match := range matches {
+			err := filepath.Walk(match, func(p string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if !info.Mode().IsRegular() {
+					return nil
+				}
+				rel, err := filepath.Rel(root, p)
+				if err != nil {
+					return err
+				}
+				rel = filepath.ToSlash(rel)
+				if _, err := cleanArtifactPath(rel); err != nil {
+					return err
+				}
+				if !seen[rel] {
+					seen[rel] = true
+					res = append(res, rel)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+// sendArtifacts uploads files from the slave to the
+// master.
+// Each artifact is sent as its path, followed by data
+// chunks and an empty chunk.
+// The list is terminated by an empty path.
+func sendArtifacts(ch TaskChannel, root string, paths []string) error {
+	for _, p := range paths {
+		f, err := os.Open(filepath.Join(root, filepath.FromSlash(p)))
+		if err != nil {
+			ch.Log("skipping artifact: " + err.Error())
+			continue
+		}
+		ch.Log("uploading artifact: " + p)
+		err = sendArtifact(ch, p, f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("send artifact %s: %s", p, err)
+		}
+	}
+	if err := ch.Send(""); err != nil {
+		return fmt.Errorf("send artifact terminator: %s", err)
+	}
+	return nil
+}
+
+func sendArtifact(ch TaskChannel, p string, r io.Reader) error {
+	if err := ch.Send(p); err != nil {
+		return err
+	}
+	buf := make([]byte, transferBufferSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			throttleTransfer(ch, n, true)
+			if err := ch.Send(buf[:n]); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			// Terminate the artifact so that the master can
+			// stay in sync with the protocol.
+			ch.Send([]byte{})
+			return err
+		}
+	}
+	return ch.Send([]byte{})
+}
+
+// receiveArtifacts receives the artifacts sent by
+// sendArtifacts and saves them in the Artifacts store
+// under the run ID of the task's job.
+// If there is no store, the artifacts are discarded.
+//
+// If the channel closes while waiting for a path, there
+// are no more artifacts and nil is returned, since the
+// slave failed before it could send them and its own
+// error says why.
+func receiveArtifacts(ch TaskChannel) error {
+	runID := TaskJobInfo(ch).RunID
+	for {
+		obj, err := ch.Receive()
+		if err != nil {
+			return nil
+		}
+		p, ok := obj.(string)
+		if !ok {
+			return fmt.Errorf("invalid artifact path type: %T", obj)
+		} else if p == "" {
+			return nil
+		}
+
+		pr, pw := io.Pipe()
+		storeErr := make(chan error, 1)
+		go func() {
+			if Artifacts == nil || runID == "" {
+				_, err := io.Copy(ioutil.Discard, pr)
+				storeErr <- err
+				return
+			}
+			err := Artifacts.Store(runID, p, pr)
+			pr.CloseWithError(err)
+			storeErr <- err
+		}()
+		recvErr := receiveArtifactData(ch, pw)
+		pw.CloseWithError(recvErr)
+		if err := <-storeErr; recvErr == nil && err != nil {
+			ch.Log(fmt.Sprintf("failed to store artifact %s: %s", p, err))
+		}
+		if recvErr != nil {
+			return recvErr
+		}
+	}
+}
+
+func receiveArtifactData(ch TaskChannel, w io.Writer) error {
+	var writeErr error
+	for {
+		obj, err := ch.Receive()
+		if err != nil {
+			return fmt.Errorf("receive artifact data: %s", err)
+		}
+		data, ok := obj.([]byte)
+		if !ok {
+			return fmt.Errorf("invalid artifact data type: %T", obj)
+		} else if len(data) == 0 {
+			return nil
+		}
+		throttleTransfer(ch, len(data), false)
+		if writeErr == nil {
+			_, writeErr = w.Write(data)
+		}
+	}
+}
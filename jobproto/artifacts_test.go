@@ -0,0 +1,57 @@
+package jobproto
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestArtifactStorePruneUpload(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	store, err := NewArtifactStore(tempDir, 0, time.Nanosecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, w := io.Pipe()
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- store.Store("run", "dir/file", r)
+	}()
+	if _, err := w.Write([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+
+	// The run is older than MaxAge, but it is still being
+	// uploaded to.
+	time.Sleep(time.Millisecond)
+	if err := store.Prune(); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+	if err := <-errChan; err != nil {
+		t.Fatal(err)
+	}
+	list, err := store.List("run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Path != "dir/file" || list[0].Size != 5 {
+		t.Fatalf("unexpected artifacts: %v", list)
+	}
+
+	time.Sleep(time.Millisecond)
+	if err := store.Prune(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(tempDir, "run")); !os.IsNotExist(err) {
+		t.Error("run was not pruned after its upload finished")
+	}
+}
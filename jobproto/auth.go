@@ -38,13 +38,19 @@ func NewMasterConnAuth(c net.Conn, password string) (Master, error) {
 // password than we do.
 // If the handshake fails for any reason, c is closed.
 func NewSlaveConnAuth(c net.Conn, password string) (Slave, error) {
+	return NewSlaveConnAuthInfo(c, password, CurrentSlaveInfo())
+}
+
+// NewSlaveConnAuthInfo is like NewSlaveConnAuth, but it
+// reports the given SlaveInfo to the master.
+func NewSlaveConnAuthInfo(c net.Conn, password string, info SlaveInfo) (Slave, error) {
 	if err := handleChallenge(0, c, password); err != nil {
 		return nil, err
 	}
 	if err := sendChallenge(1, c, password); err != nil {
 		return nil, err
 	}
-	return NewSlaveConn(c)
+	return NewSlaveConnInfo(c, info)
 }
 
 func sendChallenge(seq int, c net.Conn, password string) error {
@@ -65,10 +71,10 @@ func sendChallenge(seq int, c net.Conn, password string) error {
 	}
 
 	if bytes.Equal(actual, expected) {
-		c.SetDeadline(time.Time{})
 		if _, err := c.Write([]byte{1}); err != nil {
 			return err
 		}
+		c.SetDeadline(time.Time{})
 		return nil
 	}
 
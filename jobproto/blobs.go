@@ -0,0 +1,349 @@
+package jobproto
+
+import (
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const blobInfoSuffix = ".json"
+
+func init() {
+	gob.Register(&BlobTransfer{})
+}
+
+// Blobs, if non-nil, stores the blobs which BlobTransfer
+// tasks send to slaves.
+var Blobs *BlobStore
+
+// BlobCache, if non-nil, caches the blobs received by a
+// slave, keyed by their hashes.
+// Several slaves on one machine may share a directory.
+var BlobCache *FileCache
+
+// A BlobStore stores files on the master, addressed by
+// the hex-encoded SHA-256 of their contents.
+type BlobStore struct {
+	Dir string
+
+	lock sync.Mutex
+}
+
+// A BlobInfo describes a stored blob.
+type BlobInfo struct {
+	Hash string
+
+	// Name is a human-readable name for the blob, such as
+	// the file it was added from.
+	Name string
+
+	Size  int64
+	Added time.Time
+}
+
+// NewBlobStore creates a BlobStore, creating the directory
+// if necessary.
+func NewBlobStore(dir string) (*BlobStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &BlobStore{Dir: dir}, nil
+}
+
+// Add stores the contents of a reader as a blob.
+// If a blob with the same contents already exists, it is
+// kept and its info is returned.
+func (b *BlobStore) Add(name string, r io.Reader) (*BlobInfo, error) {
+	f, err := ioutil.TempFile(b.Dir, fileCacheTempPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	hasher := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, hasher), r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	info := &BlobInfo{
+		Hash:  hex.EncodeToString(hasher.Sum(nil)),
+		Name:  name,
+		Size:  size,
+		Added: time.Now(),
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if existing, err := b.info(info.Hash); err == nil {
+		return existing, nil
+	}
+	data, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Rename(f.Name(), b.path(info.Hash)); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(b.path(info.Hash)+blobInfoSuffix, data, 0644); err != nil {
+		os.Remove(b.path(info.Hash))
+		return nil, err
+	}
+	return info, nil
+}
+
+// AddFile stores a copy of a file as a blob, named after
+// the file.
+func (b *BlobStore) AddFile(path string) (*BlobInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return b.Add(filepath.Base(path), f)
+}
+
+// List returns the stored blobs, sorted by name.
+func (b *BlobStore) List() ([]*BlobInfo, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	listing, err := ioutil.ReadDir(b.Dir)
+	if err != nil {
+		return nil, err
+	}
+	var res []*BlobInfo
+	for _, entry := range listing {
+		hash := strings.TrimSuffix(entry.Name(), blobInfoSuffix)
+		if hash == entry.Name() || checkBlobHash(hash) != nil {
+			continue
+		}
+		info, err := b.info(hash)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, info)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Name == res[j].Name {
+			return res[i].Hash < res[j].Hash
+		}
+		return res[i].Name < res[j].Name
+	})
+	return res, nil
+}
+
+// Info returns the info for a blob.
+// If the blob does not exist, the returned error
+// satisfies os.IsNotExist.
+func (b *BlobStore) Info(hash string) (*BlobInfo, error) {
+	if err := checkBlobHash(hash); err != nil {
+		return nil, err
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.info(hash)
+}
+
+// Open opens the contents of a blob.
+func (b *BlobStore) Open(hash string) (*os.File, error) {
+	if err := checkBlobHash(hash); err != nil {
+		return nil, err
+	}
+	return os.Open(b.path(hash))
+}
+
+// Delete removes a blob from the store.
+// Slaves may still have the blob cached.
+func (b *BlobStore) Delete(hash string) error {
+	if err := checkBlobHash(hash); err != nil {
+		return err
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if err := os.Remove(b.path(hash) + blobInfoSuffix); err != nil {
+		return err
+	}
+	return os.Remove(b.path(hash))
+}
+
+func (b *BlobStore) info(hash string) (*BlobInfo, error) {
+	data, err := ioutil.ReadFile(b.path(hash) + blobInfoSuffix)
+	if err != nil {
+		return nil, err
+	}
+	var info BlobInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, fmt.Errorf("read blob info %s: %s", hash, err)
+	}
+	return &info, nil
+}
+
+func (b *BlobStore) path(hash string) string {
+	return filepath.Join(b.Dir, hash)
+}
+
+func checkBlobHash(hash string) error {
+	if len(hash) != sha256.Size*2 {
+		return errors.New("invalid blob hash: " + hash)
+	}
+	for _, ch := range hash {
+		if !(ch >= '0' && ch <= '9') && !(ch >= 'a' && ch <= 'f') {
+			return errors.New("invalid blob hash: " + hash)
+		}
+	}
+	return nil
+}
+
+// BlobTransfer is a Task that copies a blob from the
+// master's Blobs store to a path on the slave.
+//
+// Slaves keep received blobs in their BlobCache, so a
+// blob is only sent to a machine once.
+// If other slaves already have the blob, the master asks
+// the slave to fetch it from them instead, and only sends
+// the blob itself if none of them can be reached.
+type BlobTransfer struct {
+	Hash      string
+	SlavePath string
+}
+
+// RunMaster runs the master's end of the transfer.
+func (b *BlobTransfer) RunMaster(ch TaskChannel) error {
+	slave := taskSlave(ch)
+	hasObj, err := ch.Receive()
+	if err != nil {
+		return fmt.Errorf("receive cache status: %s", err)
+	}
+	has, ok := hasObj.(bool)
+	if !ok {
+		return fmt.Errorf("invalid cache status: %v", hasObj)
+	}
+	if has {
+		ch.Log("slave has cached blob")
+		blobPeers.Add(b.Hash, slave)
+		return nil
+	}
+
+	peers := blobPeers.Acquire(b.Hash, slave)
+	var addrs []string
+	for _, peer := range peers {
+		addrs = append(addrs, peer.peerAddr)
+	}
+	if err := ch.Send(addrs); err != nil {
+		blobPeers.Release(peers)
+		return fmt.Errorf("send peers: %s", err)
+	}
+	sourceObj, err := ch.Receive()
+	blobPeers.Release(peers)
+	if err != nil {
+		return fmt.Errorf("receive blob source: %s", err)
+	}
+	source, ok := sourceObj.(string)
+	if !ok {
+		return fmt.Errorf("invalid blob source: %v", sourceObj)
+	}
+
+	if source != "" {
+		ch.Log("slave fetched blob from peer " + source)
+	} else if err := b.sendBlob(ch); err != nil {
+		return err
+	}
+
+	if _, err := ch.Receive(); err != nil {
+		return fmt.Errorf("receive acknowledgment: %s", err)
+	}
+	blobPeers.Add(b.Hash, slave)
+	return nil
+}
+
+func (b *BlobTransfer) sendBlob(ch TaskChannel) error {
+	if Blobs == nil {
+		return errors.New("no blob store")
+	}
+	f, err := Blobs.Open(b.Hash)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return sendOpenFile(ch, f, b.Hash)
+}
+
+// RunSlave runs the slave's end of the transfer.
+func (b *BlobTransfer) RunSlave(root string, ch TaskChannel) error {
+	if err := checkBlobHash(b.Hash); err != nil {
+		return err
+	}
+	path := filepath.Join(root, b.SlavePath)
+	if BlobCache == nil {
+		if err := b.fetch(ch, path); err != nil {
+			return err
+		}
+		return ch.Send(true)
+	}
+
+	f, hit, err := BlobCache.Fill(b.Hash, func(tempPath string) error {
+		err := b.fetch(ch, tempPath)
+		if err != nil {
+			os.Remove(partialFilePath(tempPath, b.Hash))
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if hit {
+		ch.Log("blob cache hit: " + b.Hash)
+	}
+	if err := ch.Send(true); err != nil {
+		return fmt.Errorf("send cache status: %s", err)
+	}
+	return copyFile(f.Name(), path)
+}
+
+// fetch downloads the blob to a path, either from one of
+// the peers which the master suggests or from the master.
+func (b *BlobTransfer) fetch(ch TaskChannel, path string) error {
+	if err := ch.Send(false); err != nil {
+		return fmt.Errorf("send cache status: %s", err)
+	}
+	addrsObj, err := ch.Receive()
+	if err != nil {
+		return fmt.Errorf("receive peers: %s", err)
+	}
+	addrs, ok := addrsObj.([]string)
+	if !ok {
+		return fmt.Errorf("invalid peers type: %T", addrsObj)
+	}
+
+	var source string
+	for _, addr := range addrs {
+		ch.Log("fetching blob from peer " + addr)
+		if err := fetchFromPeer(ch, addr, b.Hash, path); err != nil {
+			ch.Log(fmt.Sprintf("failed to fetch blob from peer %s: %s", addr, err))
+			continue
+		}
+		source = addr
+		break
+	}
+	if err := ch.Send(source); err != nil {
+		return fmt.Errorf("send blob source: %s", err)
+	}
+	if source == "" {
+		// A partial download from a peer is resumed.
+		return receiveFile(ch, path, b.Hash)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package jobproto
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBlobTransfer(t *testing.T) {
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	Blobs, err = NewBlobStore(filepath.Join(tempDir, "blobs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	BlobCache, err = NewFileCache(filepath.Join(tempDir, "cache"), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		Blobs = nil
+		BlobCache = nil
+	}()
+
+	blobData := make([]byte, transferBufferSize*2+5)
+	rand.Read(blobData)
+	info, err := Blobs.Add("data.bin", bytes.NewReader(blobData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, err := Blobs.Add("copy.bin", bytes.NewReader(blobData)); err != nil {
+		t.Fatal(err)
+	} else if again.Name != "data.bin" {
+		t.Errorf("duplicate blob replaced the original")
+	}
+
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+
+	job, err := master.StartJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+
+	for i, cached := range []bool{false, true} {
+		destPath := fmt.Sprintf("dest_%d", i)
+		logChan := make(chan LogEntry, 10)
+		err := job.Run(&BlobTransfer{Hash: info.Hash, SlavePath: destPath}, logChan)
+		if err != nil {
+			t.Fatalf("transfer %d: %s", i, err)
+		}
+		close(logChan)
+		var hit bool
+		for entry := range logChan {
+			if entry.Message == "slave has cached blob" {
+				hit = true
+			}
+		}
+		if hit != cached {
+			t.Errorf("transfer %d: expected cache hit %v but got %v", i, cached, hit)
+		}
+		contents, err := ioutil.ReadFile(filepath.Join(tempDir, destPath))
+		if err != nil {
+			t.Fatal(err)
+		} else if !bytes.Equal(contents, blobData) {
+			t.Errorf("transfer %d: bad contents", i)
+		}
+	}
+
+	err = job.Run(&BlobTransfer{Hash: "abc", SlavePath: "bad"}, nil)
+	if err == nil {
+		t.Error("expected error for invalid hash")
+	}
+}
@@ -0,0 +1,203 @@
+package jobproto
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const fileCacheTempPrefix = ".tmp-"
+
+// A FileCache stores files in a directory, keyed by
+// strings such as content hashes.
+//
+// When the cache grows beyond MaxSize bytes, the least
+// recently used files are deleted.
+// Files which have not been used for MaxAge are deleted
+// as well.
+// A zero MaxSize or MaxAge means there is no limit.
+type FileCache struct {
+	Dir     string
+	MaxSize int64
+	MaxAge  time.Duration
+
+	lock    sync.Mutex
+	pending map[string]*cacheFill
+}
+
+type cacheFill struct {
+	done chan struct{}
+	err  error
+}
+
+// NewFileCache creates a FileCache, creating the cache
+// directory if necessary.
+func NewFileCache(dir string, maxSize int64, maxAge time.Duration) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &FileCache{
+		Dir:     dir,
+		MaxSize: maxSize,
+		MaxAge:  maxAge,
+		pending: map[string]*cacheFill{},
+	}, nil
+}
+
+// Open opens the file for a key and marks it as recently
+// used.
+// If the key is not in the cache, the returned error
+// satisfies os.IsNotExist.
+func (f *FileCache) Open(key string) (*os.File, error) {
+	if err := checkCacheKey(key); err != nil {
+		return nil, err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.open(key)
+}
+
+// Fill opens the file for a key, creating it with the
+// fill function if it is not already cached.
+//
+// The fill function writes the file to the given path.
+// If multiple goroutines call Fill with the same key at
+// once, the file is only created once and the other
+// callers wait for it.
+//
+// The hit result indicates if the file was already in
+// the cache.
+func (f *FileCache) Fill(key string, fill func(path string) error) (file *os.File,
+	hit bool, err error) {
+	if err := checkCacheKey(key); err != nil {
+		return nil, false, err
+	}
+
+	f.lock.Lock()
+	for {
+		if file, err := f.open(key); err == nil {
+			f.lock.Unlock()
+			return file, true, nil
+		} else if !os.IsNotExist(err) {
+			f.lock.Unlock()
+			return nil, false, err
+		}
+		p, ok := f.pending[key]
+		if !ok {
+			break
+		}
+		f.lock.Unlock()
+		<-p.done
+		if p.err != nil {
+			return nil, false, p.err
+		}
+		f.lock.Lock()
+	}
+	p := &cacheFill{done: make(chan struct{})}
+	f.pending[key] = p
+	f.lock.Unlock()
+
+	defer func() {
+		f.lock.Lock()
+		delete(f.pending, key)
+		f.lock.Unlock()
+		p.err = err
+		close(p.done)
+	}()
+
+	// Several processes may share a cache directory, so the
+	// temporary file is specific to this process.
+	tempName := fmt.Sprintf("%s%s-%d", fileCacheTempPrefix, key, os.Getpid())
+	tempPath := filepath.Join(f.Dir, tempName)
+	defer os.Remove(tempPath)
+	if err := fill(tempPath); err != nil {
+		return nil, false, err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if err := os.Rename(tempPath, f.path(key)); err != nil {
+		return nil, false, err
+	}
+	file, err = os.Open(f.path(key))
+	if err != nil {
+		return nil, false, err
+	}
+	// The new file is open, so pruning cannot take it away
+	// from us, even if it is larger than MaxSize.
+	f.prune()
+	return file, false, nil
+}
+
+// Prune deletes files which exceed the size or age
+// limits of the cache.
+func (f *FileCache) Prune() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.prune()
+}
+
+func (f *FileCache) open(key string) (*os.File, error) {
+	path := f.path(key)
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	os.Chtimes(path, now, now)
+	return file, nil
+}
+
+func (f *FileCache) prune() error {
+	listing, err := ioutil.ReadDir(f.Dir)
+	if err != nil {
+		return err
+	}
+	var entries []os.FileInfo
+	var totalSize int64
+	for _, info := range listing {
+		if info.IsDir() || strings.HasPrefix(info.Name(), fileCacheTempPrefix) {
+			continue
+		}
+		entries = append(entries, info)
+		totalSize += info.Size()
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ModTime().Before(entries[j].ModTime())
+	})
+	for _, info := range entries {
+		tooBig := f.MaxSize > 0 && totalSize > f.MaxSize
+		tooOld := f.MaxAge > 0 && time.Since(info.ModTime()) > f.MaxAge
+		if !tooBig && !tooOld {
+			continue
+		}
+		if err := os.Remove(filepath.Join(f.Dir, info.Name())); err != nil {
+			return err
+		}
+		totalSize -= info.Size()
+	}
+	return nil
+}
+
+func (f *FileCache) path(key string) string {
+	return filepath.Join(f.Dir, key)
+}
+
+func checkCacheKey(key string) error {
+	if key == "" {
+		return errors.New("empty cache key")
+	}
+	for _, ch := range key {
+		if !(ch >= 'a' && ch <= 'z') && !(ch >= 'A' && ch <= 'Z') &&
+			!(ch >= '0' && ch <= '9') && ch != '-' && ch != '_' {
+			return errors.New("invalid cache key: " + key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package jobproto
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFileCacheFill(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cache, err := NewFileCache(tempDir, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fills int32
+	var wg sync.WaitGroup
+	var hits int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f, hit, err := cache.Fill("key", func(path string) error {
+				atomic.AddInt32(&fills, 1)
+				time.Sleep(time.Millisecond * 50)
+				return ioutil.WriteFile(path, []byte("hello"), 0644)
+			})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer f.Close()
+			if hit {
+				atomic.AddInt32(&hits, 1)
+			}
+			data, err := ioutil.ReadAll(f)
+			if err != nil {
+				t.Error(err)
+			} else if string(data) != "hello" {
+				t.Errorf("unexpected data: %q", data)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if fills != 1 {
+		t.Errorf("expected 1 fill but got %d", fills)
+	}
+	if hits != 9 {
+		t.Errorf("expected 9 hits but got %d", hits)
+	}
+}
+
+func TestFileCachePrune(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	cache, err := NewFileCache(tempDir, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, key := range []string{"a", "b", "c"} {
+		f, _, err := cache.Fill(key, func(path string) error {
+			return ioutil.WriteFile(path, []byte("12345"), 0644)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+		if i == 1 {
+			// Make "b" the least recently used file.
+			oldTime := time.Now().Add(-time.Hour)
+			os.Chtimes(cache.path("b"), oldTime, oldTime)
+		}
+	}
+
+	for key, expected := range map[string]bool{"a": true, "b": false, "c": true} {
+		f, err := cache.Open(key)
+		if expected && err != nil {
+			t.Errorf("key %s: %s", key, err)
+		} else if !expected && !os.IsNotExist(err) {
+			t.Errorf("key %s: expected to be evicted", key)
+		}
+		if f != nil {
+			f.Close()
+		}
+	}
+}
@@ -1,10 +1,14 @@
 package jobproto
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/gob"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"hash"
 	"io"
-	"math/rand"
 	"os"
 	"path/filepath"
 )
@@ -13,10 +17,25 @@ const transferBufferSize = 65536
 
 func init() {
 	gob.Register(&FileTransfer{})
+	gob.Register(&transferHeader{})
+	gob.Register(&transferChunk{})
+	gob.Register(&transferResume{})
 }
 
 // FileTransfer is a Task that implements a file transfer
 // between a master and a slave.
+//
+// Files are sent in chunks, each of which is verified
+// with a SHA-256 hash, and the whole file is verified once
+// it has been received.
+// Verified chunks are kept in a partial file next to the
+// destination, so that a retried transfer resumes where
+// the last attempt left off.
+// If the destination already has the same contents as the
+// source, nothing is sent.
+//
+// Chunks are compressed if both ends support a common
+// algorithm in TransferCompression.
 type FileTransfer struct {
 	// If ToSlave is true, the file is being uploaded to
 	// the slave.
@@ -26,12 +45,37 @@ type FileTransfer struct {
 	SlavePath  string
 }
 
+// transferHeader describes the file that the sender is
+// about to send.
+type transferHeader struct {
+	Size int64
+	Hash string
+}
+
+// transferResume tells the sender where to start sending
+// and which compression algorithms the receiver accepts.
+// An Offset of -1 means that nothing needs to be sent.
+type transferResume struct {
+	Offset      int64
+	Compression []string
+}
+
+// transferChunk is a piece of a file and the SHA-256 of
+// its uncompressed data.
+// Encoding is the compression algorithm used for Data, or
+// "" if Data is uncompressed.
+type transferChunk struct {
+	Data     []byte
+	Hash     []byte
+	Encoding string
+}
+
 // RunMaster runs the master's end of the file transfer.
 func (f *FileTransfer) RunMaster(ch TaskChannel) error {
 	if f.ToSlave {
-		return f.runSender(f.MasterPath, ch)
+		return sendFile(ch, f.MasterPath)
 	} else {
-		return f.runReceiver(f.MasterPath, ch)
+		return receiveFile(ch, f.MasterPath, "")
 	}
 }
 
@@ -39,90 +83,246 @@ func (f *FileTransfer) RunMaster(ch TaskChannel) error {
 func (f *FileTransfer) RunSlave(root string, ch TaskChannel) error {
 	path := filepath.Join(root, f.SlavePath)
 	if f.ToSlave {
-		return f.runReceiver(path, ch)
+		return receiveFile(ch, path, "")
 	} else {
-		return f.runSender(path, ch)
+		return sendFile(ch, path)
 	}
 }
 
-func (f *FileTransfer) runSender(path string, ch TaskChannel) error {
+// sendFile sends a file to receiveFile on the other end
+// of a channel.
+func sendFile(ch TaskChannel, path string) error {
 	file, err := os.Open(path)
 	if err != nil {
 		return err
 	}
-	pos, err := file.Seek(0, os.SEEK_END)
+	defer file.Close()
+	return sendOpenFile(ch, file, "")
+}
+
+// sendOpenFile is like sendFile, but for a file which is
+// already open.
+// If the file's hash is already known, it may be passed
+// to avoid reading the file an extra time.
+// The receiver still verifies the hash.
+func sendOpenFile(ch TaskChannel, file *os.File, fileHash string) error {
+	var size int64
+	if fileHash != "" {
+		info, err := file.Stat()
+		if err != nil {
+			return err
+		}
+		size = info.Size()
+	} else {
+		hasher := sha256.New()
+		var err error
+		size, err = io.Copy(hasher, file)
+		if err != nil {
+			return err
+		}
+		fileHash = hex.EncodeToString(hasher.Sum(nil))
+	}
+	ch.Log(fmt.Sprintf("sending file of length %d with hash %s", size, fileHash))
+	if err := ch.Send(&transferHeader{Size: size, Hash: fileHash}); err != nil {
+		return fmt.Errorf("send file header: %s", err)
+	}
+
+	resumeObj, err := ch.Receive()
 	if err != nil {
-		return err
+		return fmt.Errorf("receive offset: %s", err)
+	}
+	resume, ok := resumeObj.(*transferResume)
+	if !ok {
+		return fmt.Errorf("invalid offset type: %T", resumeObj)
+	}
+	offset := resume.Offset
+	if offset < 0 {
+		ch.Log("destination is up to date")
+		return nil
+	} else if offset > size {
+		return fmt.Errorf("offset %d is past end of file", offset)
+	} else if offset > 0 {
+		ch.Log(fmt.Sprintf("resuming from offset %d", offset))
 	}
-	ch.Log(fmt.Sprintf("sending file of length %d", pos))
-	ch.Send(pos)
-	if _, err := file.Seek(0, os.SEEK_SET); err != nil {
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
 		return err
 	}
 
-	defer file.Close()
+	compression := chooseCompression(resume.Compression)
+	if compression != "" {
+		ch.Log("compressing data with " + compression)
+	}
+
 	buf := make([]byte, transferBufferSize)
-	for {
-		n, err := file.Read(buf)
-		if err != nil {
-			if err == io.EOF {
-				return nil
+	for offset < size {
+		n, err := io.ReadFull(file, buf)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			if int64(n) < size-offset {
+				return errors.New("file changed during transfer")
 			}
+		} else if err != nil {
 			return err
-		} else if n == 0 {
-			continue
 		}
-		if err := ch.Send(buf[:n]); err != nil {
+		if int64(n) > size-offset {
+			n = int(size - offset)
+		}
+		if err := sendChunk(ch, buf[:n], compression); err != nil {
 			return err
 		}
+		offset += int64(n)
 	}
+	return nil
+}
+
+// sendChunk sends a verified, possibly compressed chunk.
+func sendChunk(ch TaskChannel, data []byte, compression string) error {
+	chunkHash := sha256.Sum256(data)
+	encoded, encoding := encodeChunk(data, compression)
+	throttleTransfer(ch, len(encoded), true)
+	return ch.Send(&transferChunk{Data: encoded, Hash: chunkHash[:], Encoding: encoding})
 }
 
-func (f *FileTransfer) runReceiver(path string, ch TaskChannel) (resErr error) {
-	tempPath := path + fmt.Sprintf("%v", rand.Int63())
-	outFile, err := os.Create(tempPath)
+// receiveChunk receives a chunk from sendChunk and
+// returns its verified, uncompressed data.
+func receiveChunk(ch TaskChannel) ([]byte, error) {
+	obj, err := ch.Receive()
 	if err != nil {
-		return err
+		return nil, err
 	}
+	chunk, ok := obj.(*transferChunk)
+	if !ok {
+		return nil, fmt.Errorf("invalid chunk type: %T", obj)
+	}
+	throttleTransfer(ch, len(chunk.Data), false)
+	data, err := decodeChunk(chunk.Data, chunk.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	actual := sha256.Sum256(data)
+	if !bytes.Equal(actual[:], chunk.Hash) {
+		return nil, errors.New("checksum mismatch")
+	}
+	return data, nil
+}
 
-	defer func() {
-		if resErr != nil {
-			outFile.Close()
-			os.Remove(tempPath)
-		}
-	}()
-
-	sizeObj, err := ch.Receive()
+// receiveFile receives a file from sendFile and saves it
+// at path.
+// If expectHash is not "", the sender must be sending a
+// file with that hash.
+func receiveFile(ch TaskChannel, path, expectHash string) error {
+	headerObj, err := ch.Receive()
 	if err != nil {
-		return fmt.Errorf("read file size: %s", err)
+		return fmt.Errorf("read file header: %s", err)
 	}
-	size, ok := sizeObj.(int64)
+	header, ok := headerObj.(*transferHeader)
 	if !ok {
-		return fmt.Errorf("invalid file size type: %T", sizeObj)
+		return fmt.Errorf("invalid file header type: %T", headerObj)
+	}
+	ch.Log(fmt.Sprintf("receiving file of length %d with hash %s", header.Size, header.Hash))
+	if expectHash != "" && header.Hash != expectHash {
+		return fmt.Errorf("expected file with hash %s", expectHash)
 	}
 
-	ch.Log(fmt.Sprintf("receiving file of length %d", size))
+	if sameFileHash(path, header) {
+		ch.Log("destination is up to date")
+		return ch.Send(&transferResume{Offset: -1})
+	}
 
-	for {
-		obj, err := ch.Receive()
+	// The partial file is specific to the source file, so
+	// that a different file is never resumed by mistake.
+	partialPath := partialFilePath(path, header.Hash)
+	outFile, hasher, offset, err := openPartialFile(partialPath)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		ch.Log(fmt.Sprintf("resuming from offset %d", offset))
+	}
+	resume := &transferResume{Offset: offset, Compression: TransferCompression}
+	if err := ch.Send(resume); err != nil {
+		outFile.Close()
+		return fmt.Errorf("send offset: %s", err)
+	}
+
+	for offset < header.Size {
+		data, err := receiveChunk(ch)
 		if err != nil {
-			break
-		}
-		data, ok := obj.([]byte)
-		if !ok {
-			return fmt.Errorf("invalid data type: %T", obj)
+			outFile.Close()
+			return fmt.Errorf("receive chunk at offset %d: %s", offset, err)
 		}
 		if _, err := outFile.Write(data); err != nil {
+			outFile.Close()
 			return err
 		}
+		hasher.Write(data)
+		offset += int64(len(data))
 	}
 
-	if off, err := outFile.Seek(0, os.SEEK_CUR); err != nil {
+	if err := outFile.Close(); err != nil {
 		return err
-	} else if off != size {
-		return fmt.Errorf("invalid size %d (expected %d)", off, size)
 	}
+	if offset != header.Size {
+		os.Remove(partialPath)
+		return fmt.Errorf("invalid size %d (expected %d)", offset, header.Size)
+	}
+	if actual := hex.EncodeToString(hasher.Sum(nil)); actual != header.Hash {
+		os.Remove(partialPath)
+		return fmt.Errorf("checksum mismatch for file: got %s", actual)
+	}
+	return os.Rename(partialPath, path)
+}
 
-	outFile.Close()
-	return os.Rename(tempPath, path)
+func partialFilePath(path, hash string) string {
+	return fmt.Sprintf("%s.partial-%.16s", path, hash)
+}
+
+// sameFileHash checks if the file at path already has
+// the size and hash from a header.
+func sameFileHash(path string, header *transferHeader) bool {
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() || info.Size() != header.Size {
+		return false
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return false
+	}
+	return hex.EncodeToString(hasher.Sum(nil)) == header.Hash
+}
+
+// openPartialFile opens or creates a partial file for
+// appending.
+// It returns the length of the existing data, rounded
+// down to a whole number of chunks, along with a hash
+// of that data.
+func openPartialFile(path string) (*os.File, hash.Hash, int64, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, 0, err
+	}
+	offset := info.Size() - info.Size()%transferBufferSize
+	hasher := sha256.New()
+	if _, err := io.CopyN(hasher, f, offset); err != nil {
+		f.Close()
+		return nil, nil, 0, err
+	}
+	if err := f.Truncate(offset); err != nil {
+		f.Close()
+		return nil, nil, 0, err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, nil, 0, err
+	}
+	return f, hasher, offset, nil
 }
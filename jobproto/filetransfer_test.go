@@ -2,7 +2,10 @@ package jobproto
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"io/ioutil"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"testing"
@@ -83,3 +86,221 @@ func TestFileTransfer(t *testing.T) {
 		}
 	}
 }
+
+func TestFileTransferResume(t *testing.T) {
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		os.RemoveAll(tempDir)
+	}()
+
+	sourceFile := filepath.Join(tempDir, "source_file")
+	sourceData := make([]byte, transferBufferSize*3+17)
+	rand.Read(sourceData)
+	if err := ioutil.WriteFile(sourceFile, sourceData, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate an interrupted transfer which got one and a
+	// half chunks through.
+	hash := sha256.Sum256(sourceData)
+	destFile := filepath.Join(tempDir, "dest_file")
+	partialFile := destFile + ".partial-" + hex.EncodeToString(hash[:8])
+	partialData := sourceData[:transferBufferSize*3/2]
+	if err := ioutil.WriteFile(partialFile, partialData, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+
+	job, err := master.StartJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+
+	for i, expected := range []string{"resuming from offset 65536", "destination is up to date"} {
+		logChan := make(chan LogEntry, 10)
+		err = job.Run(&FileTransfer{
+			ToSlave:    true,
+			SlavePath:  "dest_file",
+			MasterPath: sourceFile,
+		}, logChan)
+		if err != nil {
+			t.Fatalf("transfer %d: %s", i, err)
+		}
+		close(logChan)
+		var found bool
+		for entry := range logChan {
+			if entry.Message == expected {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("transfer %d: missing log message %q", i, expected)
+		}
+		contents, err := ioutil.ReadFile(destFile)
+		if err != nil {
+			t.Fatal(err)
+		} else if !bytes.Equal(contents, sourceData) {
+			t.Errorf("transfer %d: bad contents", i)
+		}
+	}
+	if _, err := os.Stat(partialFile); !os.IsNotExist(err) {
+		t.Error("partial file was not removed")
+	}
+}
+
+func TestFileTransferCompression(t *testing.T) {
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		os.RemoveAll(tempDir)
+	}()
+
+	// Mix compressible and random chunks, so that both
+	// encodings are used.
+	sourceFile := filepath.Join(tempDir, "source_file")
+	sourceData := bytes.Repeat([]byte("hello, world! "), transferBufferSize/7)
+	randData := make([]byte, transferBufferSize)
+	rand.Read(randData)
+	sourceData = append(sourceData, randData...)
+	if err := ioutil.WriteFile(sourceFile, sourceData, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+
+	job, err := master.StartJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+
+	logChan := make(chan LogEntry, 10)
+	err = job.Run(&FileTransfer{
+		SlavePath:  "source_file",
+		MasterPath: filepath.Join(tempDir, "dest_file"),
+	}, logChan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	close(logChan)
+	var found bool
+	for entry := range logChan {
+		if entry.Message == "compressing data with gzip" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("transfer was not compressed")
+	}
+	contents, err := ioutil.ReadFile(filepath.Join(tempDir, "dest_file"))
+	if err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(contents, sourceData) {
+		t.Error("bad contents")
+	}
+}
+
+func TestFileTransferBandwidth(t *testing.T) {
+	oldSlaveBandwidth, oldBandwidth := SlaveBandwidth, Bandwidth
+	defer func() {
+		SlaveBandwidth, Bandwidth = oldSlaveBandwidth, oldBandwidth
+	}()
+	SlaveBandwidth = 4 << 20
+	Bandwidth = NewRateLimiter(1 << 20)
+
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		os.RemoveAll(tempDir)
+	}()
+
+	sourceData := make([]byte, 2<<20)
+	rand.Read(sourceData)
+	if err := ioutil.WriteFile(filepath.Join(tempDir, "source_file"), sourceData, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	slaveBandwidth := make(chan int64, 1)
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+			slaveBandwidth <- job.Info().Bandwidth
+		}
+	}()
+
+	job, err := master.StartJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	err = job.Run(&FileTransfer{
+		SlavePath:  "source_file",
+		MasterPath: filepath.Join(tempDir, "dest_file"),
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	elapsed := time.Since(start)
+	job.Close()
+
+	// The first second's worth of data is a free burst.
+	if elapsed < 900*time.Millisecond {
+		t.Errorf("transfer was too fast: %s", elapsed)
+	}
+	if limit := <-slaveBandwidth; limit != 1<<20 {
+		t.Errorf("slave was told to upload at %d bytes/sec", limit)
+	}
+	contents, err := ioutil.ReadFile(filepath.Join(tempDir, "dest_file"))
+	if err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(contents, sourceData) {
+		t.Error("bad contents")
+	}
+}
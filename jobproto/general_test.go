@@ -12,6 +12,10 @@ import (
 const maxTestListenAttempts = 100
 
 func TestingMasterSlave() (Master, Slave, error) {
+	return testingMasterSlaveInfo(CurrentSlaveInfo())
+}
+
+func testingMasterSlaveInfo(info SlaveInfo) (Master, Slave, error) {
 	for i := 0; i < maxTestListenAttempts; i++ {
 		port := rand.Intn(10000) + 1024
 		server, err := net.Listen("tcp", ":"+strconv.Itoa(port))
@@ -47,7 +51,7 @@ func TestingMasterSlave() (Master, Slave, error) {
 		}()
 		go func() {
 			defer wg.Done()
-			slave, slaveErr = NewSlaveConn(slaveConn)
+			slave, slaveErr = NewSlaveConnInfo(slaveConn, info)
 		}()
 		wg.Wait()
 
@@ -3,7 +3,10 @@ package jobproto
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
 	"encoding/gob"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -12,6 +15,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"sync"
 )
 
@@ -19,13 +23,65 @@ func init() {
 	gob.Register(&GoRun{})
 }
 
+// ExecCache, if non-nil, stores executables received by
+// the slave side of GoRun so that they need not be sent
+// again.
+var ExecCache *FileCache
+
+// BuildCache, if non-nil, stores executables compiled by
+// GoRun so that they can be reused by later tasks.
+var BuildCache *FileCache
+
 // GoRun is a task which runs a Go program on the slave
 // by compiling it on the server and transferring the
 // executable.
+//
+// The program is built with the master's environment,
+// so module settings like GOFLAGS, GOPROXY and GOCACHE
+// are honored.
 type GoRun struct {
 	GoPath      string
 	GoSourceDir string
 	Arguments   []string
+
+	// Package is the package to build, relative to
+	// GoSourceDir (which may be a module root).
+	// If empty, GoSourceDir itself is built.
+	Package string
+
+	// Tags, LDFlags and TrimPath correspond to the flags of
+	// the same name for "go build".
+	Tags     []string
+	LDFlags  string
+	TrimPath bool
+
+	// DisableCGO builds with CGO_ENABLED=0, so that
+	// executables do not depend on the slave's libc.
+	// Otherwise, the toolchain decides whether to use cgo.
+	DisableCGO bool
+
+	// BuildEnv contains extra "KEY=VALUE" environment
+	// variables for the compiler.
+	BuildEnv []string
+
+	// Env contains extra "KEY=VALUE" environment variables
+	// for the program.
+	// Values may refer to job metadata and slave labels, as
+	// described by JobInfo.Expand.
+	Env []string
+
+	// StdinFile is a file on the master to feed to the
+	// program's standard input.
+	// If it is empty, StdinText is used instead.
+	// If both are empty, the program gets no input.
+	StdinFile string
+	StdinText string
+
+	// Artifacts contains glob patterns, relative to the
+	// job's directory on the slave, for files to upload to
+	// the master's Artifacts store once the program exits.
+	// Matching directories are uploaded recursively.
+	Artifacts []string
 }
 
 // RunMaster runs the master side of the task.
@@ -39,52 +95,49 @@ func (g *GoRun) RunMaster(ch TaskChannel) error {
 		return fmt.Errorf("invalid platform info: %v", osArchObj)
 	}
 
-	tempDir, err := ioutil.TempDir("", "gorun")
+	executable, err := g.executable(osArch[0], osArch[1], ch)
 	if err != nil {
-		return fmt.Errorf("create temp dir: %s", err)
+		return err
 	}
-	defer func() {
-		if tempDir != "" {
-			os.RemoveAll(tempDir)
-		}
-	}()
-	tempFile := filepath.Join(tempDir, "executable")
 
-	cmd := exec.Command("go", "build", "-o", tempFile)
-	cmd.Env = []string{"GOROOT=" + os.Getenv("GOROOT"), "GOOS=" + osArch[0],
-		"GOARCH=" + osArch[1]}
-	if g.GoPath != "" {
-		cmd.Env = append(cmd.Env, "GOPATH="+g.GoPath)
-	} else {
-		cmd.Env = append(cmd.Env, "GOPATH="+os.Getenv("GOPATH"))
+	hash := sha256.Sum256(executable)
+	if err := ch.Send(hex.EncodeToString(hash[:])); err != nil {
+		return fmt.Errorf("send executable hash: %s", err)
 	}
-	cmd.Dir = g.GoSourceDir
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("compile binary: %s", err)
+	hasObj, err := ch.Receive()
+	if err != nil {
+		return fmt.Errorf("receive cache status: %s", err)
+	}
+	has, ok := hasObj.(bool)
+	if !ok {
+		return fmt.Errorf("invalid cache status: %v", hasObj)
+	}
+	if has {
+		ch.Log("slave has cached executable")
+	} else if err := sendExecutable(ch, executable); err != nil {
+		return err
 	}
 
-	executable, err := ioutil.ReadFile(tempFile)
+	stdin, err := g.openStdin()
 	if err != nil {
-		return fmt.Errorf("read executable: %s", err)
+		return err
+	}
+	if stdin != nil {
+		defer stdin.Close()
 	}
 
-	os.RemoveAll(tempDir)
-	tempDir = ""
+	if err := ch.Send(g.Arguments); err != nil {
+		return fmt.Errorf("send arguments: %s", err)
+	}
 
-	ch.Send(len(executable))
-	for i := 0; i < len(executable); i += transferBufferSize {
-		if i+transferBufferSize >= len(executable) {
-			err = ch.Send(executable[i:])
-		} else {
-			err = ch.Send(executable[i : i+transferBufferSize])
-		}
-		if err != nil {
-			return fmt.Errorf("send executable: %s", err)
+	if stdin != nil {
+		if err := sendStdin(ch, stdin); err != nil {
+			return err
 		}
 	}
 
-	if err := ch.Send(g.Arguments); err != nil {
-		return fmt.Errorf("send arguments: %s", err)
+	if err := receiveArtifacts(ch); err != nil {
+		return err
 	}
 
 	// Wait for the other end to complete.
@@ -93,6 +146,146 @@ func (g *GoRun) RunMaster(ch TaskChannel) error {
 	return nil
 }
 
+// executable compiles the program for the given platform,
+// or fetches it from the BuildCache.
+func (g *GoRun) executable(goos, goarch string, ch TaskChannel) ([]byte, error) {
+	if BuildCache == nil {
+		tempDir, err := ioutil.TempDir("", "gorun")
+		if err != nil {
+			return nil, fmt.Errorf("create temp dir: %s", err)
+		}
+		defer os.RemoveAll(tempDir)
+		tempFile := filepath.Join(tempDir, "executable")
+		if err := g.build(goos, goarch, tempFile, ch); err != nil {
+			return nil, err
+		}
+		executable, err := ioutil.ReadFile(tempFile)
+		if err != nil {
+			return nil, fmt.Errorf("read executable: %s", err)
+		}
+		return executable, nil
+	}
+
+	key, err := g.buildKey(goos, goarch)
+	if err != nil {
+		return nil, fmt.Errorf("hash source: %s", err)
+	}
+	f, hit, err := BuildCache.Fill(key, func(path string) error {
+		return g.build(goos, goarch, path, ch)
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if hit {
+		ch.Log("build cache hit: " + key)
+	} else {
+		ch.Log("build cache miss: " + key)
+	}
+	executable, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, fmt.Errorf("read executable: %s", err)
+	}
+	return executable, nil
+}
+
+func (g *GoRun) build(goos, goarch, outPath string, ch TaskChannel) error {
+	cmd := exec.Command("go", g.buildArgs(outPath)...)
+	cmd.Env = append(os.Environ(), g.buildEnv(goos, goarch)...)
+	cmd.Dir = g.GoSourceDir
+	output, err := cmd.CombinedOutput()
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line != "" {
+			ch.Log(line)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("compile binary: %s", err)
+	}
+	return nil
+}
+
+func (g *GoRun) buildArgs(outPath string) []string {
+	args := []string{"build", "-o", outPath}
+	if len(g.Tags) > 0 {
+		args = append(args, "-tags", strings.Join(g.Tags, ","))
+	}
+	if g.LDFlags != "" {
+		args = append(args, "-ldflags", g.LDFlags)
+	}
+	if g.TrimPath {
+		args = append(args, "-trimpath")
+	}
+	if g.Package != "" {
+		args = append(args, g.Package)
+	}
+	return args
+}
+
+// buildEnv returns the environment variables which
+// override the master's environment for a build.
+func (g *GoRun) buildEnv(goos, goarch string) []string {
+	env := []string{"GOOS=" + goos, "GOARCH=" + goarch}
+	if g.DisableCGO {
+		env = append(env, "CGO_ENABLED=0")
+	}
+	if g.GoPath != "" {
+		env = append(env, "GOPATH="+g.GoPath)
+	}
+	return append(env, g.BuildEnv...)
+}
+
+// buildKey hashes everything which affects the output of
+// a build: the source directory, the build environment,
+// and the target platform.
+//
+// Packages imported from outside the source directory are
+// not hashed, so changes to them are not noticed.
+func (g *GoRun) buildKey(goos, goarch string) (string, error) {
+	h := sha256.New()
+	for _, v := range os.Environ() {
+		if strings.HasPrefix(v, "GO") || strings.HasPrefix(v, "CGO_") {
+			fmt.Fprintf(h, "env %q\n", v)
+		}
+	}
+	for _, v := range g.buildEnv(goos, goarch) {
+		fmt.Fprintf(h, "env %q\n", v)
+	}
+	for _, v := range g.buildArgs("") {
+		fmt.Fprintf(h, "arg %q\n", v)
+	}
+	err := filepath.Walk(g.GoSourceDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != g.GoSourceDir && strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		relPath, err := filepath.Rel(g.GoSourceDir, path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "file %q %v %d\n", filepath.ToSlash(relPath), info.Mode(), info.Size())
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(h, f)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // RunSlave runs the slave side of the task.
 func (g *GoRun) RunSlave(root string, ch TaskChannel) error {
 	osArch := []string{runtime.GOOS, runtime.GOARCH}
@@ -100,25 +293,43 @@ func (g *GoRun) RunSlave(root string, ch TaskChannel) error {
 		return fmt.Errorf("send platform info: %s", err)
 	}
 
-	sizeObj, err := ch.Receive()
+	hashObj, err := ch.Receive()
 	if err != nil {
-		return fmt.Errorf("receive size: %s", err)
+		return fmt.Errorf("receive executable hash: %s", err)
 	}
-	size, ok := sizeObj.(int)
+	hash, ok := hashObj.(string)
 	if !ok {
-		return fmt.Errorf("bad size type: %T", sizeObj)
+		return fmt.Errorf("invalid hash type: %T", hashObj)
 	}
-	var executable bytes.Buffer
-	for executable.Len() < size {
-		dataObj, err := ch.Receive()
-		if err != nil {
-			return fmt.Errorf("receive executable data: %s", err)
+
+	tempExcPath := filepath.Join(root, fmt.Sprintf("%d", rand.Int63()))
+	if runtime.GOOS == "windows" {
+		tempExcPath += ".exe"
+	}
+	defer os.Remove(tempExcPath)
+
+	if err := copyCachedExecutable(hash, tempExcPath); err == nil {
+		ch.Log("executable cache hit: " + hash)
+		if err := ch.Send(true); err != nil {
+			return fmt.Errorf("send cache status: %s", err)
+		}
+	} else {
+		if err := ch.Send(false); err != nil {
+			return fmt.Errorf("send cache status: %s", err)
 		}
-		data, ok := dataObj.([]byte)
-		if !ok {
-			return fmt.Errorf("invalid data type: %T", dataObj)
+		if err := receiveExecutable(ch, hash, tempExcPath); err != nil {
+			return err
+		}
+		if ExecCache != nil {
+			f, _, err := ExecCache.Fill(hash, func(path string) error {
+				return copyFile(tempExcPath, path)
+			})
+			if err != nil {
+				ch.Log("failed to cache executable: " + err.Error())
+			} else {
+				f.Close()
+			}
 		}
-		executable.Write(data)
 	}
 
 	argsObj, err := ch.Receive()
@@ -130,38 +341,67 @@ func (g *GoRun) RunSlave(root string, ch TaskChannel) error {
 		return fmt.Errorf("invalid argument type: %T", argsObj)
 	}
 
-	tempExcPath := filepath.Join(root, fmt.Sprintf("%d", rand.Int63()))
-	if runtime.GOOS == "windows" {
-		tempExcPath += ".exe"
-	}
-	if err := ioutil.WriteFile(tempExcPath, executable.Bytes(), 0755); err != nil {
-		return fmt.Errorf("write executable: %s", err)
-	}
-	defer os.Remove(tempExcPath)
-
 	var logWg sync.WaitGroup
 	cmd := exec.Command(tempExcPath, args...)
 	cmd.Dir = root
+	cmd.Env = g.processEnv(TaskJobInfo(ch))
+	progress, err := newProgressServer(ch)
+	if err != nil {
+		ch.Log("failed to start progress server: " + err.Error())
+	} else {
+		defer progress.Close()
+		cmd.Env = append(cmd.Env, progress.Env())
+	}
 	if err := logCommandOut(&logWg, cmd, ch); err != nil {
 		return err
 	}
 
+	var stdin io.WriteCloser
+	if g.StdinFile != "" || g.StdinText != "" {
+		stdin, err = cmd.StdinPipe()
+		if err != nil {
+			return fmt.Errorf("make stdin pipe: %s", err)
+		}
+	}
+
 	if err := cmd.Start(); err != nil {
 		return fmt.Errorf("start executable: %s", err)
 	}
 
 	go func() {
+		if stdin != nil {
+			receiveStdin(ch, stdin)
+		}
 		// If the channel dies because the job or the entire
 		// slave session died, we should kill the task.
 		ch.Receive()
 		cmd.Process.Kill()
 	}()
 
-	if err := cmd.Wait(); err != nil {
-		return fmt.Errorf("wait for executable: %s", err)
+	// Wait closes the output pipes, so all the output must
+	// be read before it is called.
+	logWg.Wait()
+	waitErr := cmd.Wait()
+	if progress != nil {
+		progress.Close()
+	}
+	if cmd.ProcessState != nil {
+		SetProcessResult(ch, newProcessResult(cmd.ProcessState))
 	}
 
-	logWg.Wait()
+	// Artifacts are uploaded even if the program failed,
+	// since they may help to diagnose the failure.
+	artifacts, err := findArtifacts(root, g.Artifacts)
+	if err != nil {
+		ch.Log("failed to find artifacts: " + err.Error())
+	}
+	if err := sendArtifacts(ch, root, artifacts); err != nil {
+		return err
+	}
+
+	if waitErr != nil {
+		return fmt.Errorf("wait for executable: %s", waitErr)
+	}
 
 	// Notify the other end that we have finished.
 	ch.Send(nil)
@@ -169,6 +409,177 @@ func (g *GoRun) RunSlave(root string, ch TaskChannel) error {
 	return nil
 }
 
+func (g *GoRun) processEnv(info JobInfo) []string {
+	env := append(os.Environ(), info.Env()...)
+	for _, v := range g.Env {
+		parts := strings.SplitN(v, "=", 2)
+		if len(parts) == 2 {
+			v = parts[0] + "=" + info.Expand(parts[1])
+		}
+		env = append(env, v)
+	}
+	return env
+}
+
+// openStdin opens the program's standard input.
+// It returns nil if the program gets no input.
+func (g *GoRun) openStdin() (io.ReadCloser, error) {
+	if g.StdinFile != "" {
+		f, err := os.Open(g.StdinFile)
+		if err != nil {
+			return nil, fmt.Errorf("open stdin: %s", err)
+		}
+		return f, nil
+	} else if g.StdinText != "" {
+		return ioutil.NopCloser(strings.NewReader(g.StdinText)), nil
+	}
+	return nil, nil
+}
+
+// sendStdin streams standard input to the slave, followed
+// by an empty chunk to indicate the end of the input.
+func sendStdin(ch TaskChannel, r io.Reader) error {
+	buf := make([]byte, transferBufferSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			chunk := append([]byte{}, buf[:n]...)
+			if err := ch.Send(chunk); err != nil {
+				return fmt.Errorf("send stdin: %s", err)
+			}
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("read stdin: %s", err)
+		}
+	}
+	if err := ch.Send([]byte{}); err != nil {
+		return fmt.Errorf("send stdin: %s", err)
+	}
+	return nil
+}
+
+// receiveStdin writes the chunks from sendStdin to w.
+// Chunks are still consumed if the program stops reading
+// its input, so that the master does not get stuck.
+func receiveStdin(ch TaskChannel, w io.WriteCloser) {
+	defer w.Close()
+	for {
+		obj, err := ch.Receive()
+		if err != nil {
+			return
+		}
+		data, ok := obj.([]byte)
+		if !ok || len(data) == 0 {
+			return
+		}
+		w.Write(data)
+	}
+}
+
+// sendExecutable sends an executable to the slave, using
+// a compression algorithm which the slave accepts.
+func sendExecutable(ch TaskChannel, executable []byte) error {
+	acceptedObj, err := ch.Receive()
+	if err != nil {
+		return fmt.Errorf("receive accepted compression: %s", err)
+	}
+	accepted, ok := acceptedObj.([]string)
+	if !ok {
+		return fmt.Errorf("invalid compression list type: %T", acceptedObj)
+	}
+	compression := chooseCompression(accepted)
+	if compression != "" {
+		ch.Log("compressing executable with " + compression)
+	}
+
+	ch.Log(fmt.Sprintf("sending executable of length %d", len(executable)))
+	ch.Send(len(executable))
+	for i := 0; i < len(executable); i += transferBufferSize {
+		end := i + transferBufferSize
+		if end > len(executable) {
+			end = len(executable)
+		}
+		if err := sendChunk(ch, executable[i:end], compression); err != nil {
+			return fmt.Errorf("send executable: %s", err)
+		}
+	}
+	return nil
+}
+
+// copyCachedExecutable copies an executable from the
+// ExecCache to the given path.
+// It fails if the executable is not in the cache.
+func copyCachedExecutable(hash, path string) error {
+	if ExecCache == nil {
+		return errors.New("no executable cache")
+	}
+	f, err := ExecCache.Open(hash)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return writeExecutable(f, path)
+}
+
+func receiveExecutable(ch TaskChannel, hash, path string) error {
+	if err := ch.Send(TransferCompression); err != nil {
+		return fmt.Errorf("send accepted compression: %s", err)
+	}
+	sizeObj, err := ch.Receive()
+	if err != nil {
+		return fmt.Errorf("receive size: %s", err)
+	}
+	size, ok := sizeObj.(int)
+	if !ok {
+		return fmt.Errorf("bad size type: %T", sizeObj)
+	}
+	ch.Log(fmt.Sprintf("receiving executable of length %d", size))
+	var executable bytes.Buffer
+	for executable.Len() < size {
+		data, err := receiveChunk(ch)
+		if err != nil {
+			return fmt.Errorf("receive executable data: %s", err)
+		}
+		executable.Write(data)
+	}
+	actualHash := sha256.Sum256(executable.Bytes())
+	if hex.EncodeToString(actualHash[:]) != hash {
+		return errors.New("executable hash mismatch")
+	}
+	return writeExecutable(&executable, path)
+}
+
+func writeExecutable(r io.Reader, path string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0755)
+	if err != nil {
+		return fmt.Errorf("write executable: %s", err)
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, r); err != nil {
+		return fmt.Errorf("write executable: %s", err)
+	}
+	return nil
+}
+
+func copyFile(source, dest string) error {
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
 func logCommandOut(wg *sync.WaitGroup, cmd *exec.Cmd, ch TaskChannel) error {
 	stdout, err := cmd.StdoutPipe()
 	if err != nil {
@@ -187,13 +598,13 @@ func logCommandOut(wg *sync.WaitGroup, cmd *exec.Cmd, ch TaskChannel) error {
 			for {
 				line, err := bufReader.ReadString('\n')
 				if line != "" || err == nil {
-					ch.Log(line)
+					LogStream(ch, name, line)
 				}
 				if err != nil {
 					return
 				}
 			}
-		}([]string{"stdout", "stderr"}[i], x)
+		}([]string{StreamStdout, StreamStderr}[i], x)
 	}
 	return nil
 }
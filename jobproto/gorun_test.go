@@ -2,11 +2,17 @@ package jobproto
 
 import (
 	"bytes"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/unixpickle/jobempire/jobproto/taskclient"
 )
 
 func TestGoRun(t *testing.T) {
@@ -64,3 +70,417 @@ func TestGoRun(t *testing.T) {
 		t.Error("unexpected contents:", contents)
 	}
 }
+
+func TestGoRunEnvStdin(t *testing.T) {
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tempFile := filepath.Join(tempDir, "gorun_out")
+	defer func() {
+		os.RemoveAll(tempDir)
+	}()
+
+	doneChan := make(chan struct{})
+	go func() {
+		defer close(doneChan)
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+
+	job, err := master.StartJobInfo(JobInfo{JobName: "greeter", Instance: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = job.Run(&GoRun{
+		GoSourceDir: "./test_data/test_go_stdin",
+		Arguments:   []string{tempFile},
+		Env:         []string{"GREETING=hello ${job.name}"},
+		StdinText:   "from stdin",
+	}, nil)
+	if err != nil {
+		t.Error("job failed:", err)
+	}
+	job.Close()
+	master.Close()
+
+	select {
+	case <-doneChan:
+	case <-time.After(time.Second):
+		t.Error("slave did not finish before timeout")
+	}
+
+	contents, err := ioutil.ReadFile(tempFile)
+	if err != nil {
+		t.Error(err)
+	} else if string(contents) != "hello greeter 3 from stdin" {
+		t.Errorf("unexpected contents: %q", contents)
+	}
+}
+
+func TestGoRunResult(t *testing.T) {
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		os.RemoveAll(tempDir)
+	}()
+
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+
+	job, err := master.StartJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+	result, err := job.RunWith(&GoRun{
+		GoSourceDir: "./test_data/test_go_exit",
+		Arguments:   []string{"3"},
+	}, nil, RunOptions{})
+	if err == nil {
+		t.Error("expected an error")
+	}
+	if result == nil || result.Process == nil {
+		t.Fatal("missing process result")
+	}
+	if result.Process.ExitCode != 3 {
+		t.Errorf("expected exit code 3 but got %d", result.Process.ExitCode)
+	}
+	if result.Process.Signal != "" {
+		t.Errorf("unexpected signal: %s", result.Process.Signal)
+	}
+	if result.WallTime <= 0 {
+		t.Errorf("invalid wall time: %v", result.WallTime)
+	}
+}
+
+func TestGoRunSlaveError(t *testing.T) {
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		os.RemoveAll(tempDir)
+	}()
+
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+
+	// An executable for another architecture cannot be
+	// started, so the slave fails before sending artifacts.
+	otherArch := "arm64"
+	if runtime.GOARCH == otherArch {
+		otherArch = "amd64"
+	}
+	job, err := master.StartJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+	err = job.Run(&GoRun{
+		GoSourceDir: "./test_data/test_go_exit",
+		BuildEnv:    []string{"GOARCH=" + otherArch},
+		Artifacts:   []string{"*.txt"},
+	}, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.Contains(err.Error(), "start executable") {
+		t.Errorf("expected the slave's error but got: %s", err)
+	}
+}
+
+func TestGoRunProgress(t *testing.T) {
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		os.RemoveAll(tempDir)
+	}()
+
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+
+	job, err := master.StartJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+
+	progress := make(chan taskclient.Update, 10)
+	_, err = job.RunWith(&GoRun{
+		GoSourceDir: "./test_data/test_go_progress",
+	}, nil, RunOptions{Progress: progress})
+	if err != nil {
+		t.Fatal(err)
+	}
+	close(progress)
+
+	var updates []taskclient.Update
+	for u := range progress {
+		updates = append(updates, u)
+	}
+	expected := []taskclient.Update{
+		{Kind: taskclient.KindStatus, Text: "working"},
+		{Kind: taskclient.KindProgress, Value: 0.5},
+		{Kind: taskclient.KindMetric, Name: "loss", Value: 1.25},
+	}
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("expected %v but got %v", expected, updates)
+	}
+}
+
+func TestGoRunArtifacts(t *testing.T) {
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		os.RemoveAll(tempDir)
+	}()
+
+	oldArtifacts := Artifacts
+	defer func() {
+		Artifacts = oldArtifacts
+	}()
+	Artifacts, err = NewArtifactStore(filepath.Join(tempDir, "store"), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(filepath.Join(tempDir, "root"))
+		}
+	}()
+	if err := os.Mkdir(filepath.Join(tempDir, "root"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	job, err := master.StartJobInfo(JobInfo{RunID: "run1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+	err = job.Run(&GoRun{
+		GoSourceDir: "./test_data/test_go_bin",
+		Arguments:   []string{"result.txt"},
+		Artifacts:   []string{"*.txt"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := Artifacts.List("run1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Path != "result.txt" || list[0].Size != 11 {
+		t.Fatalf("unexpected artifacts: %v", list)
+	}
+	f, err := Artifacts.Open("run1", "result.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	contents, err := ioutil.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	} else if string(contents) != "hello there" {
+		t.Errorf("unexpected contents: %q", contents)
+	}
+
+	if _, err := Artifacts.Open("run1", "../run1/result.txt"); err == nil {
+		t.Error("expected error for path outside of run")
+	}
+}
+
+func TestGoRunExecCache(t *testing.T) {
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	ExecCache, err = NewFileCache(filepath.Join(tempDir, "cache"), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The slave must be done with ExecCache before it is
+	// reset.
+	doneChan := make(chan struct{})
+	defer func() {
+		master.Close()
+		<-doneChan
+		ExecCache = nil
+	}()
+	go func() {
+		defer close(doneChan)
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+
+	for i, cached := range []bool{false, true} {
+		job, err := master.StartJob()
+		if err != nil {
+			t.Fatal(err)
+		}
+		logChan := make(chan LogEntry, 100)
+		err = job.Run(&GoRun{
+			GoSourceDir: "./test_data/test_go_exit",
+			Arguments:   []string{"0"},
+		}, logChan)
+		job.Close()
+		close(logChan)
+		if err != nil {
+			t.Fatalf("run %d: %s", i, err)
+		}
+		var log []string
+		for entry := range logChan {
+			log = append(log, entry.Message)
+		}
+		joined := strings.Join(log, "\n")
+		if strings.Contains(joined, "executable cache hit") != cached ||
+			strings.Contains(joined, "sending executable") == cached {
+			t.Errorf("run %d: expected cached=%v but got log:\n%s", i, cached, joined)
+		}
+	}
+}
+
+func TestGoRunLogEntries(t *testing.T) {
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+
+	job, err := master.StartJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+	logChan := make(chan LogEntry, 1000)
+	err = job.Run(&GoRun{GoSourceDir: "./test_data/test_go_streams"}, logChan)
+	close(logChan)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	streams := map[string][]string{}
+	seq := 0
+	for entry := range logChan {
+		if entry.Seq != seq {
+			t.Errorf("entry %q has seq %d but expected %d", entry.Message, entry.Seq, seq)
+		}
+		seq++
+		if entry.Time.IsZero() {
+			t.Errorf("entry %q has no time", entry.Message)
+		}
+		streams[entry.Stream] = append(streams[entry.Stream],
+			strings.TrimSpace(entry.Message))
+	}
+	prefixes := map[string]string{StreamStdout: "out", StreamStderr: "err"}
+	for stream, prefix := range prefixes {
+		var expected []string
+		for i := 0; i < 5; i++ {
+			expected = append(expected, fmt.Sprintf("%s %d", prefix, i))
+		}
+		if !reflect.DeepEqual(streams[stream], expected) {
+			t.Errorf("%s: expected %v but got %v", stream, expected, streams[stream])
+		}
+	}
+	if len(streams[StreamSystem]) == 0 {
+		t.Error("missing system entries")
+	}
+	if len(streams) != 3 {
+		t.Errorf("unexpected streams: %v", streams)
+	}
+}
@@ -0,0 +1,107 @@
+package jobproto
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+const inlineFileDefaultMode = 0644
+
+func init() {
+	gob.Register(&InlineFile{})
+}
+
+// InlineFile is a Task which writes content stored in the
+// task itself to a file on the slave.
+//
+// It is meant for small files, such as configuration
+// files, which are easier to keep in a job than on the
+// master's disk.
+type InlineFile struct {
+	// SlavePath is the path of the file, relative to the
+	// job's directory.
+	SlavePath string
+
+	Content string
+
+	// Template, if true, renders Content as a text/template
+	// with the job's JobInfo as its data, e.g.
+	// {{.JobName}} or {{index .SlaveLabels "gpu"}}.
+	// The template function env looks up environment
+	// variables on the slave.
+	Template bool
+
+	// Mode is the file's permissions.
+	// If it is 0, 0644 is used.
+	Mode os.FileMode
+}
+
+// RunMaster runs the master's end of the task, which
+// does nothing.
+func (i *InlineFile) RunMaster(ch TaskChannel) error {
+	return nil
+}
+
+// RunSlave writes the file.
+func (i *InlineFile) RunSlave(root string, ch TaskChannel) error {
+	path, err := jobFilePath(root, i.SlavePath)
+	if err != nil {
+		return err
+	}
+	content := []byte(i.Content)
+	if i.Template {
+		content, err = i.render(TaskJobInfo(ch))
+		if err != nil {
+			return err
+		}
+	}
+	mode := i.Mode.Perm()
+	if mode == 0 {
+		mode = inlineFileDefaultMode
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), fileCacheTempPrefix)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(content)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	// The mode is set explicitly so that it is not
+	// affected by the umask.
+	if err := os.Chmod(f.Name(), mode); err != nil {
+		return err
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return err
+	}
+	ch.Log(fmt.Sprintf("wrote %d bytes to %s", len(content), i.SlavePath))
+	return nil
+}
+
+func (i *InlineFile) render(info JobInfo) ([]byte, error) {
+	tmpl, err := template.New(i.SlavePath).Funcs(template.FuncMap{
+		"env": os.Getenv,
+	}).Parse(i.Content)
+	if err != nil {
+		return nil, fmt.Errorf("parse template: %s", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, info); err != nil {
+		return nil, fmt.Errorf("render template: %s", err)
+	}
+	return buf.Bytes(), nil
+}
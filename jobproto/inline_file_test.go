@@ -0,0 +1,83 @@
+package jobproto
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInlineFile(t *testing.T) {
+	info := CurrentSlaveInfo()
+	info.Labels = map[string]string{"gpu": "k80"}
+	master, slave, err := testingMasterSlaveInfo(info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+	job, err := master.StartJobInfo(JobInfo{JobName: "trainer", Instance: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+
+	err = job.Run(&InlineFile{
+		SlavePath: "conf/plain.txt",
+		Content:   "name: {{.JobName}}\n",
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = job.Run(&InlineFile{
+		SlavePath: "conf/rendered.txt",
+		Content:   "name: {{.JobName}}-{{.Instance}}\ngpu: {{index .SlaveLabels \"gpu\"}}\n",
+		Template:  true,
+		Mode:      0600,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"plain.txt":    "name: {{.JobName}}\n",
+		"rendered.txt": "name: trainer-2\ngpu: k80\n",
+	}
+	modes := map[string]os.FileMode{"plain.txt": 0644, "rendered.txt": 0600}
+	for name, contents := range expected {
+		p := filepath.Join(tempDir, "conf", name)
+		if data, err := ioutil.ReadFile(p); err != nil {
+			t.Fatal(err)
+		} else if string(data) != contents {
+			t.Errorf("%s: got %q", name, data)
+		}
+		if info, err := os.Stat(p); err != nil {
+			t.Fatal(err)
+		} else if info.Mode().Perm() != modes[name] {
+			t.Errorf("%s: bad mode %o", name, info.Mode().Perm())
+		}
+	}
+
+	failures := []*InlineFile{
+		{SlavePath: "bad.txt", Content: "{{.Missing", Template: true},
+		{SlavePath: "../escape.txt", Content: "hello"},
+	}
+	for i, task := range failures {
+		if err := job.Run(task, nil); err == nil {
+			t.Errorf("failure %d: expected error", i)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package jobproto
+
+import (
+	"encoding/gob"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func init() {
+	gob.Register(JobInfo{})
+}
+
+// Policies for keeping a job's directory on the slave
+// after the job is done.
+const (
+	KeepDirNever     = ""
+	KeepDirOnFailure = "failure"
+	KeepDirAlways    = "always"
+)
+
+// JobInfo describes the job that a task is a part of.
+type JobInfo struct {
+	JobID   string
+	JobName string
+
+	// RunID uniquely identifies this run of the job on the
+	// master, for instance to group its artifacts.
+	RunID string
+
+	// Instance distinguishes between simultaneously running
+	// instances of the same job.
+	// It is the smallest non-negative number which is not
+	// in use by another running instance.
+	Instance int
+
+	SlaveName   string
+	SlaveLabels map[string]string
+
+	// KeepDir decides if the slave keeps the job's
+	// directory once the job is done, so that it can be
+	// inspected later.
+	// It is KeepDirNever, KeepDirOnFailure, or
+	// KeepDirAlways.
+	KeepDir string
+
+	// KeepHours is the number of hours for which a kept
+	// directory is kept.
+	// If it is 0, the slave's default is used.
+	KeepHours int
+
+	// Bandwidth limits the rate, in bytes per second, at
+	// which the slave sends files and artifacts to the
+	// master, or is 0 for no limit.
+	// It is filled in by the master from SlaveBandwidth and
+	// Bandwidth.
+	Bandwidth int64
+}
+
+// Env returns environment variables describing the job,
+// for use by processes that a task launches.
+func (j JobInfo) Env() []string {
+	return []string{
+		"JOBEMPIRE_JOB_ID=" + j.JobID,
+		"JOBEMPIRE_JOB_NAME=" + j.JobName,
+		"JOBEMPIRE_INSTANCE=" + strconv.Itoa(j.Instance),
+		"JOBEMPIRE_SLAVE_NAME=" + j.SlaveName,
+	}
+}
+
+// Expand replaces references to job metadata in a string.
+//
+// The references ${job.id}, ${job.name}, ${job.instance},
+// ${slave.name}, and ${slave.label.KEY} are supported.
+// Other references, such as $HOME, are looked up in the
+// current environment.
+func (j JobInfo) Expand(s string) string {
+	return os.Expand(s, func(name string) string {
+		switch name {
+		case "job.id":
+			return j.JobID
+		case "job.name":
+			return j.JobName
+		case "job.instance":
+			return strconv.Itoa(j.Instance)
+		case "slave.name":
+			return j.SlaveName
+		}
+		if strings.HasPrefix(name, "slave.label.") {
+			return j.SlaveLabels[strings.TrimPrefix(name, "slave.label.")]
+		}
+		return os.Getenv(name)
+	})
+}
+
+// TaskJobInfo returns the JobInfo for the job that a task
+// channel belongs to.
+// If the channel does not know about its job, an empty
+// JobInfo is returned.
+func TaskJobInfo(ch TaskChannel) JobInfo {
+	if c, ok := ch.(jobInfoChannel); ok {
+		return c.JobInfo()
+	}
+	return JobInfo{}
+}
+
+type jobInfoChannel interface {
+	JobInfo() JobInfo
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/unixpickle/gobplexer"
+	"github.com/unixpickle/jobempire/jobproto/taskclient"
 )
 
 const (
@@ -15,11 +16,31 @@ const (
 	pingMaxDelay = time.Minute
 )
 
+// Names of log streams.
+const (
+	StreamStdout = "stdout"
+	StreamStderr = "stderr"
+	StreamSystem = "system"
+)
+
 // A LogEntry stores one line of logged output from either
 // end of a Task.
 type LogEntry struct {
 	FromMaster bool
 	Message    string
+
+	// Time is when the entry was logged, according to the
+	// clock on the end which logged it.
+	Time time.Time
+
+	// Stream is StreamStdout or StreamStderr for output
+	// from a process, or StreamSystem for messages from the
+	// task itself.
+	Stream string
+
+	// Seq numbers the entries of a task in the order the
+	// master received them, starting at 0.
+	Seq int
 }
 
 // A Master provides control over the master side of a
@@ -33,6 +54,12 @@ type Master interface {
 	// Multiple jobs may be running simultaneously.
 	StartJob() (MasterJob, error)
 
+	// StartJobInfo is like StartJob, but it tells both ends
+	// of the job's tasks about the job.
+	// The SlaveName and SlaveLabels fields are filled in
+	// automatically from the SlaveInfo.
+	StartJobInfo(info JobInfo) (MasterJob, error)
+
 	// Wait waits for the remote end to disconnect or
 	// for the Master to be closed.
 	Wait()
@@ -66,6 +93,13 @@ type MasterJob interface {
 	// after the first will have no effect.
 	Close() error
 
+	// Finish tells the slave whether the job failed, which
+	// decides if the slave keeps the job's directory, and
+	// then closes the job.
+	// Slaves treat jobs which are closed without Finish as
+	// failures.
+	Finish(failed bool) error
+
 	// Run runs a task in the context of the job.
 	// It blocks until the task has completed on both ends.
 	// It returns an error if the task fails on either end,
@@ -78,12 +112,46 @@ type MasterJob interface {
 	//
 	// Multiple tasks may be run on a job simultaneously.
 	Run(t Task, log chan<- LogEntry) error
+
+	// RunWith is like Run, but it also returns the result
+	// reported by the slave, and it takes options for
+	// following and stopping the task.
+	// The result is nil if the slave did not report one,
+	// for example because the connection died.
+	RunWith(t Task, log chan<- LogEntry, opts RunOptions) (*TaskResult, error)
+}
+
+// RunOptions configures MasterJob.RunWith.
+// The zero value runs a task like MasterJob.Run.
+type RunOptions struct {
+	// Progress, if non-nil, receives the progress updates
+	// reported by the slave.
+	// Like the log channel, it should be read from
+	// continually.
+	Progress chan<- taskclient.Update
+
+	// Cancel, if non-nil, stops the task if it is closed
+	// before the task is done.
+	// A stopped task fails with ErrTaskCancelled, and any
+	// process it started on the slave is killed.
+	Cancel <-chan struct{}
 }
 
+// ErrTaskCancelled is returned by MasterJob.RunWith when
+// a task is stopped.
+var ErrTaskCancelled = errors.New("task cancelled")
+
 type masterConn struct {
 	connector gobplexer.Connector
 	doneChan  <-chan struct{}
 	info      SlaveInfo
+
+	// limiter enforces SlaveBandwidth, or is nil.
+	limiter *RateLimiter
+
+	// peerAddr is the address of the slave's ServePeers,
+	// or "" if it does not serve peers.
+	peerAddr string
 }
 
 // NewMasterConn creates a Master from a net.Conn.
@@ -119,11 +187,16 @@ func NewMasterConn(c net.Conn) (m Master, e error) {
 			c.Close()
 			close(doneChan)
 		}()
-		return &masterConn{
+		res := &masterConn{
 			connector: connector,
 			doneChan:  doneChan,
 			info:      info,
-		}, nil
+			peerAddr:  peerAddr(c, info),
+		}
+		if SlaveBandwidth > 0 {
+			res.limiter = NewRateLimiter(SlaveBandwidth)
+		}
+		return res, nil
 	}
 }
 
@@ -132,11 +205,48 @@ func (m *masterConn) SlaveInfo() SlaveInfo {
 }
 
 func (m *masterConn) StartJob() (MasterJob, error) {
+	return m.StartJobInfo(JobInfo{})
+}
+
+func (m *masterConn) StartJobInfo(info JobInfo) (MasterJob, error) {
+	info.SlaveName = m.info.Name
+	info.SlaveLabels = m.info.Labels
+	info.Bandwidth = m.uploadBandwidth()
+
 	c, err := m.connector.Connect()
 	if err != nil {
 		return nil, err
 	}
-	return &masterJob{connector: gobplexer.MultiplexConnector(c)}, nil
+	connector := gobplexer.MultiplexConnector(c)
+	infoConn, err := connector.Connect()
+	if err != nil {
+		connector.Close()
+		return nil, fmt.Errorf("connect for job info: %s", err)
+	}
+	defer infoConn.Close()
+	if err := infoConn.Send(info); err != nil {
+		connector.Close()
+		return nil, fmt.Errorf("send job info: %s", err)
+	}
+	// Wait for an acknowledgment so that the info is not
+	// lost when infoConn is closed.
+	if _, err := infoConn.Receive(); err != nil {
+		connector.Close()
+		return nil, fmt.Errorf("receive job info acknowledgment: %s", err)
+	}
+	return &masterJob{connector: connector, info: info, slave: m}, nil
+}
+
+// uploadBandwidth returns the tightest limit on the rate
+// at which the slave may send data, or 0 if there is none.
+func (m *masterConn) uploadBandwidth() int64 {
+	var res int64
+	for _, l := range []*RateLimiter{m.limiter, Bandwidth} {
+		if l != nil && (res == 0 || l.Rate() < res) {
+			res = l.Rate()
+		}
+	}
+	return res
 }
 
 func (m *masterConn) Wait() {
@@ -149,35 +259,78 @@ func (m *masterConn) Close() error {
 
 type masterJob struct {
 	connector gobplexer.Connector
+	info      JobInfo
+	slave     *masterConn
 }
 
 func (m *masterJob) Close() error {
 	return m.connector.Close()
 }
 
+func (m *masterJob) Finish(failed bool) error {
+	err := m.Run(&jobOutcome{Failed: failed}, nil)
+	if closeErr := m.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
 func (m *masterJob) Run(t Task, log chan<- LogEntry) error {
+	_, err := m.RunWith(t, log, RunOptions{})
+	return err
+}
+
+func (m *masterJob) RunWith(t Task, log chan<- LogEntry, opts RunOptions) (res *TaskResult,
+	err error) {
 	taskConn, err := m.connector.Connect()
 	if err != nil {
-		return fmt.Errorf("connect task: %s", err)
+		return nil, fmt.Errorf("connect task: %s", err)
 	}
 	defer taskConn.Close()
 
+	if opts.Cancel != nil {
+		var cancelled bool
+		var cancelLock sync.Mutex
+		done := make(chan struct{})
+		go func() {
+			select {
+			case <-opts.Cancel:
+				cancelLock.Lock()
+				cancelled = true
+				cancelLock.Unlock()
+				// Both ends of the task fail once the
+				// connection is closed.
+				taskConn.Close()
+			case <-done:
+			}
+		}()
+		defer func() {
+			close(done)
+			cancelLock.Lock()
+			defer cancelLock.Unlock()
+			if cancelled && err != nil {
+				err = ErrTaskCancelled
+			}
+		}()
+	}
+
 	connector := gobplexer.MultiplexConnector(taskConn)
 	statusConn, err := connector.Connect()
 	if err != nil {
-		return fmt.Errorf("establish status channel: %s", err)
+		return nil, fmt.Errorf("establish status channel: %s", err)
 	}
 
 	dataConn, err := connector.Connect()
 	if err != nil {
-		return fmt.Errorf("establish data channel: %s", err)
+		return nil, fmt.Errorf("establish data channel: %s", err)
 	}
 
 	logConn, err := connector.Connect()
 	if err != nil {
-		return fmt.Errorf("establish log channel: %s", err)
+		return nil, fmt.Errorf("establish log channel: %s", err)
 	}
 
+	logger := &taskLogger{log: log}
 	var logWg sync.WaitGroup
 	logWg.Add(1)
 	go func() {
@@ -187,57 +340,121 @@ func (m *masterJob) Run(t Task, log chan<- LogEntry) error {
 			if err != nil {
 				return
 			}
-			msgStr, ok := msg.(string)
-			if ok && log != nil {
-				log <- LogEntry{Message: msgStr}
+			switch msg := msg.(type) {
+			case LogEntry:
+				msg.FromMaster = false
+				logger.Add(msg)
+			case string:
+				logger.Add(LogEntry{Message: msg, Time: time.Now(), Stream: StreamSystem})
+			case taskclient.Update:
+				if opts.Progress != nil {
+					opts.Progress <- msg
+				}
 			}
 		}
 	}()
 
 	if err := dataConn.Send(t); err != nil {
-		return fmt.Errorf("send task: %s", err)
+		return nil, fmt.Errorf("send task: %s", err)
 	}
-	runErr := t.RunMaster(masterTaskConn{dataConn, log})
+	runErr := t.RunMaster(masterTaskConn{
+		Connection: dataConn,
+		logger:     logger,
+		info:       m.info,
+		slaveConn:  m.slave,
+	})
 	dataConn.Close()
 	logWg.Wait()
 
-	remoteStatus := readStatusObj(statusConn)
+	result, remoteStatus := readStatusObj(statusConn)
 	if runErr != nil {
-		return runErr
+		return result, runErr
 	} else if remoteStatus != nil {
-		return fmt.Errorf("external error: %s", remoteStatus)
+		return result, fmt.Errorf("external error: %s", remoteStatus)
 	} else {
-		return nil
+		return result, nil
 	}
 }
 
-// readStatusObj reads the first error/nil value from the
+// readStatusObj reads the first status value from the
 // connection.
-func readStatusObj(c gobplexer.Connection) error {
+// Besides a *TaskResult, it accepts nil or an error string
+// from slaves which do not report results.
+func readStatusObj(c gobplexer.Connection) (*TaskResult, error) {
 	value, err := c.Receive()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Allow the other end to fully disconnect.
 	c.Send(nil)
 
 	if value == nil {
-		return nil
+		return nil, nil
+	} else if result, ok := value.(*TaskResult); ok {
+		if result.Error != "" {
+			return result, errors.New(result.Error)
+		}
+		return result, nil
 	} else if errVal, ok := value.(string); ok {
-		return errors.New(errVal)
+		return nil, errors.New(errVal)
 	} else {
-		return fmt.Errorf("invalid status type: %T", value)
+		return nil, fmt.Errorf("invalid status type: %T", value)
 	}
 }
 
 type masterTaskConn struct {
 	gobplexer.Connection
-	log chan<- LogEntry
+	logger    *taskLogger
+	info      JobInfo
+	slaveConn *masterConn
+}
+
+func (m masterTaskConn) JobInfo() JobInfo {
+	return m.info
+}
+
+func (m masterTaskConn) slave() *masterConn {
+	return m.slaveConn
+}
+
+func (m masterTaskConn) throttle(n int, sending bool) {
+	if m.slaveConn != nil && m.slaveConn.limiter != nil {
+		m.slaveConn.limiter.Wait(n)
+	}
+	if Bandwidth != nil {
+		Bandwidth.Wait(n)
+	}
 }
 
 func (m masterTaskConn) Log(message string) {
-	if m.log != nil {
-		m.log <- LogEntry{FromMaster: true, Message: message}
+	m.LogStream(StreamSystem, message)
+}
+
+func (m masterTaskConn) LogStream(stream, message string) {
+	m.logger.Add(LogEntry{
+		FromMaster: true,
+		Message:    message,
+		Time:       time.Now(),
+		Stream:     stream,
+	})
+}
+
+// A taskLogger numbers the log entries from both ends of a
+// task and passes them to a log channel.
+type taskLogger struct {
+	lock    sync.Mutex
+	nextSeq int
+	log     chan<- LogEntry
+}
+
+func (t *taskLogger) Add(e LogEntry) {
+	if t.log == nil {
+		return
 	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	e.Seq = t.nextSeq
+	t.nextSeq++
+	t.log <- e
 }
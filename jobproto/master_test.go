@@ -0,0 +1,88 @@
+package jobproto
+
+import (
+	"encoding/gob"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func init() {
+	gob.Register(&testBlockingTask{})
+}
+
+// testBlockingTask blocks on both ends until its channel
+// is closed.
+type testBlockingTask struct{}
+
+func (t *testBlockingTask) RunMaster(ch TaskChannel) error {
+	_, err := ch.Receive()
+	return err
+}
+
+func (t *testBlockingTask) RunSlave(root string, ch TaskChannel) error {
+	_, err := ch.Receive()
+	slaveBlockingDone <- err
+	return err
+}
+
+var slaveBlockingDone = make(chan error, 1)
+
+func TestRunCancel(t *testing.T) {
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+	job, err := master.StartJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+
+	cancel := make(chan struct{})
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := job.RunWith(&testBlockingTask{}, nil, RunOptions{Cancel: cancel})
+		errChan <- err
+	}()
+	time.Sleep(time.Millisecond * 100)
+	close(cancel)
+
+	select {
+	case err := <-errChan:
+		if err != ErrTaskCancelled {
+			t.Errorf("unexpected error: %v", err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("task was not cancelled")
+	}
+	select {
+	case err := <-slaveBlockingDone:
+		if err == nil {
+			t.Error("expected slave error")
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("slave end was not stopped")
+	}
+
+	// The job should still be usable.
+	if err := job.Run(&InlineFile{SlavePath: "file", Content: "hi"}, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,279 @@
+package jobproto
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/unixpickle/gobplexer"
+)
+
+const (
+	peerDialTimeout = time.Second * 10
+	peerIdleTimeout = time.Minute
+
+	// maxPeerOffers is the number of peers which the master
+	// suggests to a slave fetching a blob.
+	maxPeerOffers = 3
+)
+
+// PeerPassword is the password which slaves use to
+// authenticate each other when sharing blobs.
+// Slaves use the same password as they use for the master.
+var PeerPassword string
+
+// PeerTLS, if non-nil, enables TLS for connections between
+// slaves.
+// ServePeers presents its certificates, and slaves check
+// the certificates of their peers against its RootCAs.
+var PeerTLS *tls.Config
+
+// PeerUploads is the number of slaves which the master
+// allows to fetch blobs from one peer at once.
+// Other slaves fetch blobs from the master.
+// If it is 0, blobs are never shared between slaves.
+var PeerUploads = 4
+
+// blobPeers tracks which slaves can share which blobs.
+var blobPeers = &blobHolders{
+	holders: map[string]map[*masterConn]bool{},
+	uploads: map[*masterConn]int{},
+	watched: map[*masterConn]bool{},
+}
+
+// ServePeers serves the blobs in a cache to other slaves
+// which connect to a listener.
+// It returns when the listener fails or is closed.
+func ServePeers(l net.Listener, cache *FileCache) error {
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go servePeer(c, cache)
+	}
+}
+
+func servePeer(c net.Conn, cache *FileCache) {
+	// Bound the TLS handshake and authentication, so that
+	// stalled connections do not pile up.
+	c.SetDeadline(time.Now().Add(peerIdleTimeout))
+	if PeerTLS != nil {
+		c = tls.Server(c, PeerTLS)
+	}
+	defer c.Close()
+	if err := sendChallenge(0, c, PeerPassword); err != nil {
+		return
+	}
+	if err := handleChallenge(1, c, PeerPassword); err != nil {
+		return
+	}
+	ch := newPeerChannel(c, func(string) {})
+	hashObj, err := ch.Receive()
+	if err != nil {
+		return
+	}
+	hash, ok := hashObj.(string)
+	if !ok || checkBlobHash(hash) != nil {
+		return
+	}
+	f, err := cache.Open(hash)
+	if err != nil {
+		ch.Send(false)
+		return
+	}
+	defer f.Close()
+	if ch.Send(true) == nil {
+		sendOpenFile(ch, f, hash)
+	}
+}
+
+// fetchFromPeer downloads a blob from another slave's
+// ServePeers.
+func fetchFromPeer(ch TaskChannel, addr, hash, path string) error {
+	c, err := dialPeer(addr)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	c.SetDeadline(time.Now().Add(peerIdleTimeout))
+	if err := handleChallenge(0, c, PeerPassword); err != nil {
+		return err
+	}
+	if err := sendChallenge(1, c, PeerPassword); err != nil {
+		return err
+	}
+	peerCh := newPeerChannel(c, ch.Log)
+	if err := peerCh.Send(hash); err != nil {
+		return err
+	}
+	hasObj, err := peerCh.Receive()
+	if err != nil {
+		return err
+	}
+	if has, ok := hasObj.(bool); !ok {
+		return fmt.Errorf("invalid cache status: %v", hasObj)
+	} else if !has {
+		return errors.New("peer does not have blob")
+	}
+	return receiveFile(peerCh, path, hash)
+}
+
+// dialPeer connects to another slave's ServePeers, using
+// TLS if PeerTLS is set.
+func dialPeer(addr string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: peerDialTimeout}
+	if PeerTLS == nil {
+		return dialer.Dial("tcp", addr)
+	}
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	config := PeerTLS.Clone()
+	config.ServerName = host
+	return tls.DialWithDialer(dialer, "tcp", addr, config)
+}
+
+// peerChannel is a TaskChannel between two slaves.
+// Every message must arrive within peerIdleTimeout, so
+// that a stalled peer does not stall a task.
+type peerChannel struct {
+	conn    net.Conn
+	gobConn gobplexer.Connection
+	log     func(message string)
+}
+
+func newPeerChannel(c net.Conn, log func(message string)) *peerChannel {
+	return &peerChannel{conn: c, gobConn: gobplexer.NetConnection(c), log: log}
+}
+
+func (p *peerChannel) Send(msg interface{}) error {
+	p.conn.SetDeadline(time.Now().Add(peerIdleTimeout))
+	return p.gobConn.Send(msg)
+}
+
+func (p *peerChannel) Receive() (interface{}, error) {
+	p.conn.SetDeadline(time.Now().Add(peerIdleTimeout))
+	return p.gobConn.Receive()
+}
+
+func (p *peerChannel) Log(message string) {
+	p.log(message)
+}
+
+// peerAddr computes the address at which other slaves can
+// reach a slave's ServePeers.
+// It returns "" if the slave does not serve peers.
+func peerAddr(c net.Conn, info SlaveInfo) string {
+	if info.PeerPort == 0 {
+		return ""
+	}
+	host := info.PeerHost
+	if host == "" {
+		var err error
+		host, _, err = net.SplitHostPort(c.RemoteAddr().String())
+		if err != nil {
+			return ""
+		}
+	}
+	return net.JoinHostPort(host, strconv.Itoa(info.PeerPort))
+}
+
+// taskSlave returns the connection to the slave on the
+// other end of a master's task channel, or nil.
+func taskSlave(ch TaskChannel) *masterConn {
+	if s, ok := ch.(slaveChannel); ok {
+		return s.slave()
+	}
+	return nil
+}
+
+type slaveChannel interface {
+	slave() *masterConn
+}
+
+// blobHolders keeps track of the slaves which have blobs
+// in their caches, and of how many slaves are fetching
+// blobs from each of them.
+type blobHolders struct {
+	lock    sync.Mutex
+	holders map[string]map[*masterConn]bool
+	uploads map[*masterConn]int
+	watched map[*masterConn]bool
+}
+
+// Add records that a slave has a blob.
+// Slaves which do not serve peers are ignored, and slaves
+// are forgotten once they disconnect.
+func (b *blobHolders) Add(hash string, slave *masterConn) {
+	if slave == nil || slave.peerAddr == "" {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.holders[hash] == nil {
+		b.holders[hash] = map[*masterConn]bool{}
+	}
+	b.holders[hash][slave] = true
+	if !b.watched[slave] {
+		b.watched[slave] = true
+		go func() {
+			<-slave.doneChan
+			b.remove(slave)
+		}()
+	}
+}
+
+// Acquire picks the least busy slaves which have a blob
+// and can accept another upload.
+// The slaves count as busy until they are released.
+func (b *blobHolders) Acquire(hash string, exclude *masterConn) []*masterConn {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	var res []*masterConn
+	for slave := range b.holders[hash] {
+		if slave != exclude && b.uploads[slave] < PeerUploads {
+			res = append(res, slave)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return b.uploads[res[i]] < b.uploads[res[j]]
+	})
+	if len(res) > maxPeerOffers {
+		res = res[:maxPeerOffers]
+	}
+	for _, slave := range res {
+		b.uploads[slave]++
+	}
+	return res
+}
+
+// Release undoes Acquire.
+func (b *blobHolders) Release(slaves []*masterConn) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, slave := range slaves {
+		b.uploads[slave]--
+		if b.uploads[slave] <= 0 {
+			delete(b.uploads, slave)
+		}
+	}
+}
+
+func (b *blobHolders) remove(slave *masterConn) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for hash, holders := range b.holders {
+		delete(holders, slave)
+		if len(holders) == 0 {
+			delete(b.holders, hash)
+		}
+	}
+	delete(b.watched, slave)
+}
@@ -0,0 +1,211 @@
+package jobproto
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	cryptorand "crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"math/rand"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBlobTransferPeers(t *testing.T) {
+	testBlobTransferPeers(t)
+}
+
+func TestBlobTransferPeersTLS(t *testing.T) {
+	cert, err := testCertificate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(cert.Leaf)
+	PeerTLS = &tls.Config{Certificates: []tls.Certificate{cert}, RootCAs: roots}
+	defer func() {
+		PeerTLS = nil
+	}()
+	testBlobTransferPeers(t)
+
+	// Peers which do not speak TLS are not challenged.
+	// The server must be done before PeerTLS is reset.
+	c, server := net.Pipe()
+	served := make(chan struct{})
+	go func() {
+		servePeer(server, nil)
+		close(served)
+	}()
+	c.SetDeadline(time.Now().Add(time.Second))
+	if _, err := c.Read(make([]byte, 1)); err == nil {
+		t.Error("plain connection should not be challenged")
+	}
+	c.Close()
+	<-served
+}
+
+func testBlobTransferPeers(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	Blobs, err = NewBlobStore(filepath.Join(tempDir, "blobs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	PeerPassword = "peer password"
+	defer func() {
+		Blobs = nil
+		BlobCache = nil
+		PeerPassword = ""
+	}()
+
+	blobData := make([]byte, transferBufferSize*3+5)
+	rand.Read(blobData)
+	blob, err := Blobs.Add("data.bin", bytes.NewReader(blobData))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first slave gets the blob from the master and
+	// serves it from its own cache.
+	firstCache, err := NewFileCache(filepath.Join(tempDir, "cache0"), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go ServePeers(listener, firstCache)
+	info := CurrentSlaveInfo()
+	info.PeerPort = listener.Addr().(*net.TCPAddr).Port
+	BlobCache = firstCache
+	firstMaster, err := startTestSlave(info, tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer firstMaster.Close()
+	log, err := runTestBlobTransfer(firstMaster, tempDir, blob.Hash, blobData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(log, "fetched blob from peer") {
+		t.Error("first slave should not use a peer")
+	}
+
+	// Slaves with empty caches get the blob from the first
+	// slave, or from the master once it stops serving.
+	for i, usePeer := range []bool{true, false} {
+		if !usePeer {
+			listener.Close()
+		}
+		BlobCache, err = NewFileCache(filepath.Join(tempDir, fmt.Sprintf("cache%d", i+1)), 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		master, err := startTestSlave(CurrentSlaveInfo(), tempDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		log, err := runTestBlobTransfer(master, tempDir, blob.Hash, blobData)
+		master.Close()
+		if err != nil {
+			t.Fatalf("slave %d: %s", i+1, err)
+		}
+		if !strings.Contains(log, "fetching blob from peer") {
+			t.Errorf("slave %d: peer was not offered", i+1)
+		}
+		if got := strings.Contains(log, "fetched blob from peer"); got != usePeer {
+			t.Errorf("slave %d: expected peer use %v but got %v", i+1, usePeer, got)
+		}
+	}
+}
+
+// testCertificate creates a self-signed certificate for
+// the local host.
+func testCertificate() (tls.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+	der, err := x509.CreateCertificate(cryptorand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, nil
+}
+
+// startTestSlave connects a slave which runs its jobs in
+// a directory.
+func startTestSlave(info SlaveInfo, dir string) (Master, error) {
+	master, slave, err := testingMasterSlaveInfo(info)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(dir)
+		}
+	}()
+	return master, nil
+}
+
+func runTestBlobTransfer(master Master, tempDir, hash string, expected []byte) (string, error) {
+	job, err := master.StartJob()
+	if err != nil {
+		return "", err
+	}
+	defer job.Close()
+
+	logChan := make(chan LogEntry, 100)
+	err = job.Run(&BlobTransfer{Hash: hash, SlavePath: "dest"}, logChan)
+	close(logChan)
+	var log bytes.Buffer
+	for entry := range logChan {
+		log.WriteString(entry.Message + "\n")
+	}
+	if err != nil {
+		return log.String(), err
+	}
+	contents, err := ioutil.ReadFile(filepath.Join(tempDir, "dest"))
+	if err != nil {
+		return log.String(), err
+	} else if !bytes.Equal(contents, expected) {
+		return log.String(), errors.New("bad contents")
+	}
+	return log.String(), nil
+}
@@ -0,0 +1,32 @@
+package jobproto
+
+import (
+	"os"
+	"syscall"
+)
+
+func exitSignal(state *os.ProcessState) string {
+	if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return status.Signal().String()
+	}
+	return ""
+}
+
+func peakRSS(state *os.ProcessState) int64 {
+	if usage, ok := state.SysUsage().(*syscall.Rusage); ok {
+		// Darwin reports the maximum RSS in bytes.
+		return usage.Maxrss
+	}
+	return 0
+}
+
+func processAlive(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
+
+// processNamespace returns "", since all processes on
+// this platform share one PID namespace.
+func processNamespace() string {
+	return ""
+}
@@ -0,0 +1,36 @@
+package jobproto
+
+import (
+	"os"
+	"syscall"
+)
+
+func exitSignal(state *os.ProcessState) string {
+	if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return status.Signal().String()
+	}
+	return ""
+}
+
+func peakRSS(state *os.ProcessState) int64 {
+	if usage, ok := state.SysUsage().(*syscall.Rusage); ok {
+		// Linux reports the maximum RSS in KiB.
+		return usage.Maxrss << 10
+	}
+	return 0
+}
+
+func processAlive(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
+
+// processNamespace identifies the PID namespace of this
+// process, or returns "" if it cannot.
+func processNamespace() string {
+	ns, err := os.Readlink("/proc/self/ns/pid")
+	if err != nil {
+		return ""
+	}
+	return ns
+}
@@ -0,0 +1,24 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package jobproto
+
+import "os"
+
+func exitSignal(state *os.ProcessState) string {
+	return ""
+}
+
+func peakRSS(state *os.ProcessState) int64 {
+	return 0
+}
+
+// processAlive cannot tell if a process exists, so it
+// assumes that it does.
+func processAlive(pid int) bool {
+	return true
+}
+
+func processNamespace() string {
+	return ""
+}
@@ -0,0 +1,147 @@
+package jobproto
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/unixpickle/jobempire/jobproto/taskclient"
+)
+
+const (
+	progressAcceptTimeout = time.Millisecond * 100
+	progressCloseTimeout  = time.Second
+)
+
+func init() {
+	gob.Register(taskclient.Update{})
+}
+
+// ReportProgress sends a progress update from the slave
+// side of a task to the master.
+//
+// It has no effect if the channel does not support
+// progress reports.
+func ReportProgress(ch TaskChannel, u taskclient.Update) {
+	if c, ok := ch.(progressChannel); ok {
+		c.ReportProgress(u)
+	}
+}
+
+type progressChannel interface {
+	ReportProgress(u taskclient.Update)
+}
+
+// A progressServer listens on a local socket for updates
+// from a process and forwards them to the master.
+type progressServer struct {
+	dir      string
+	listener *net.UnixListener
+	ch       TaskChannel
+
+	lock      sync.Mutex
+	conns     map[net.Conn]bool
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func newProgressServer(ch TaskChannel) (*progressServer, error) {
+	// Socket paths are limited to around 100 bytes, so the
+	// socket cannot always live in the job's directory.
+	dir, err := ioutil.TempDir("", "jobempire_task")
+	if err != nil {
+		return nil, err
+	}
+	addr := &net.UnixAddr{Net: "unix", Name: filepath.Join(dir, "progress.sock")}
+	listener, err := net.ListenUnix("unix", addr)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	p := &progressServer{
+		dir:      dir,
+		listener: listener,
+		ch:       ch,
+		conns:    map[net.Conn]bool{},
+	}
+	p.wg.Add(1)
+	go p.acceptLoop()
+	return p, nil
+}
+
+// Env returns the environment variable which tells a
+// process where to find the server.
+func (p *progressServer) Env() string {
+	return taskclient.SocketEnvVar + "=" + p.listener.Addr().String()
+}
+
+// Close stops the server once the connections have been
+// closed by the process, forwarding any updates that are
+// still buffered.
+// Connections which outlive the process, for instance
+// because a child process holds them, are closed after a
+// short timeout.
+//
+// Close may be called more than once.
+func (p *progressServer) Close() {
+	p.closeOnce.Do(func() {
+		// Connections which the process made right before
+		// exiting may not have been accepted yet.
+		p.listener.SetDeadline(time.Now().Add(progressAcceptTimeout))
+		done := make(chan struct{})
+		go func() {
+			p.wg.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(progressCloseTimeout):
+			p.lock.Lock()
+			for conn := range p.conns {
+				conn.Close()
+			}
+			p.lock.Unlock()
+			<-done
+		}
+		p.listener.Close()
+		os.RemoveAll(p.dir)
+	})
+}
+
+func (p *progressServer) acceptLoop() {
+	defer p.wg.Done()
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			return
+		}
+		p.lock.Lock()
+		p.conns[conn] = true
+		p.lock.Unlock()
+		p.wg.Add(1)
+		go p.handleConn(conn)
+	}
+}
+
+func (p *progressServer) handleConn(conn net.Conn) {
+	defer p.wg.Done()
+	defer func() {
+		p.lock.Lock()
+		delete(p.conns, conn)
+		p.lock.Unlock()
+		conn.Close()
+	}()
+	dec := json.NewDecoder(conn)
+	for {
+		var u taskclient.Update
+		if err := dec.Decode(&u); err != nil {
+			return
+		}
+		ReportProgress(p.ch, u)
+	}
+}
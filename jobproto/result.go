@@ -0,0 +1,71 @@
+package jobproto
+
+import (
+	"encoding/gob"
+	"os"
+	"time"
+)
+
+func init() {
+	gob.Register(&TaskResult{})
+}
+
+// A TaskResult describes how the slave side of a task
+// finished.
+type TaskResult struct {
+	// Error is the error from the slave side of the task,
+	// or "" if it succeeded.
+	Error string
+
+	// WallTime is the time it took to run the slave side
+	// of the task.
+	WallTime time.Duration
+
+	// Process is set if the task ran a process.
+	Process *ProcessResult
+}
+
+// A ProcessResult describes how a process exited.
+type ProcessResult struct {
+	// ExitCode is the exit code of the process, or -1 if
+	// the process was terminated by a signal.
+	ExitCode int
+
+	// Signal is the name of the signal which terminated
+	// the process, or "" if it exited normally.
+	Signal string
+
+	// CPUTime is the user and system CPU time used by the
+	// process.
+	CPUTime time.Duration
+
+	// PeakRSS is the maximum resident set size of the
+	// process in bytes, or 0 if it is not known on this
+	// platform.
+	PeakRSS int64
+}
+
+// SetProcessResult records the result of a process which
+// the slave side of a task ran.
+// The result is sent to the master once the task is done.
+//
+// It has no effect if the channel does not support task
+// results.
+func SetProcessResult(ch TaskChannel, r *ProcessResult) {
+	if c, ok := ch.(processResultChannel); ok {
+		c.setProcessResult(r)
+	}
+}
+
+type processResultChannel interface {
+	setProcessResult(r *ProcessResult)
+}
+
+func newProcessResult(state *os.ProcessState) *ProcessResult {
+	return &ProcessResult{
+		ExitCode: state.ExitCode(),
+		Signal:   exitSignal(state),
+		CPUTime:  state.UserTime() + state.SystemTime(),
+		PeakRSS:  peakRSS(state),
+	}
+}
@@ -6,15 +6,17 @@ import (
 	"net"
 	"os"
 	"runtime"
-	"strconv"
 	"sync"
+	"time"
 
 	"github.com/cloudfoundry/gosigar"
 	"github.com/unixpickle/gobplexer"
+	"github.com/unixpickle/jobempire/jobproto/taskclient"
 )
 
 func init() {
 	gob.Register(SlaveInfo{})
+	gob.Register(LogEntry{})
 }
 
 // SlaveInfo stores global information about a slave.
@@ -35,29 +37,38 @@ type SlaveInfo struct {
 
 	// Arch indicates the value of GOARCH.
 	Arch string
+
+	// Name is a human-readable name for the slave, which
+	// defaults to its hostname.
+	Name string
+
+	// Labels stores arbitrary key-value pairs which the
+	// slave was configured with.
+	Labels map[string]string
+
+	// PeerPort is the port on which the slave serves blobs
+	// to other slaves, or 0 if it does not.
+	PeerPort int
+
+	// PeerHost, if set, is the host at which other slaves
+	// can reach the slave.
+	// It defaults to the address the master sees.
+	PeerHost string
 }
 
 // CurrentSlaveInfo computes the SlaveInfo for the current
 // Go process.
 func CurrentSlaveInfo() SlaveInfo {
-	var memAmount int
-	if memStr := os.Getenv("JOB_MEM_LIMIT"); memStr != "" {
-		var err error
-		memAmount, err = strconv.Atoi(memStr)
-		if err != nil {
-			panic("invalid JOB_MEM_LIMIT: " + memStr)
-		}
-	} else {
-		mem := sigar.Mem{}
-		mem.Get()
-		memAmount = int(mem.Total >> 20)
-	}
+	mem := sigar.Mem{}
+	mem.Get()
+	hostname, _ := os.Hostname()
 	return SlaveInfo{
 		NumCPU:   runtime.NumCPU(),
 		MaxProcs: runtime.GOMAXPROCS(0),
-		TotalMem: memAmount,
+		TotalMem: int(mem.Total >> 20),
 		OS:       runtime.GOOS,
 		Arch:     runtime.GOARCH,
+		Name:     hostname,
 	}
 }
 
@@ -83,16 +94,37 @@ type Slave interface {
 type SlaveJob interface {
 	// RunTasks runs the tasks from the Master.
 	RunTasks(rootDir string)
+
+	// Info returns the JobInfo which the master reported.
+	// It is only valid once RunTasks has returned.
+	Info() JobInfo
+
+	// Failed returns false if the master reported that the
+	// job succeeded with MasterJob.Finish, or true
+	// otherwise.
+	// It is only valid once RunTasks has returned.
+	Failed() bool
 }
 
 type slaveConn struct {
 	conn     net.Conn
 	listener gobplexer.Listener
+
+	// limiter enforces the upload bandwidth which the
+	// master reports with each job, or is nil.
+	limiterLock sync.Mutex
+	limiter     *RateLimiter
 }
 
 // NewSlaveConn creates a Slave from a net.Conn.
 // If the handshake fails, c is closed.
-func NewSlaveConn(c net.Conn) (s Slave, e error) {
+func NewSlaveConn(c net.Conn) (Slave, error) {
+	return NewSlaveConnInfo(c, CurrentSlaveInfo())
+}
+
+// NewSlaveConnInfo is like NewSlaveConn, but it reports
+// the given SlaveInfo to the master.
+func NewSlaveConnInfo(c net.Conn, info SlaveInfo) (s Slave, e error) {
 	defer func() {
 		if e != nil {
 			c.Close()
@@ -112,7 +144,7 @@ func NewSlaveConn(c net.Conn) (s Slave, e error) {
 	if err != nil {
 		return nil, fmt.Errorf("accept info connection: %s", err)
 	}
-	if err := statusConn.Send(CurrentSlaveInfo()); err != nil {
+	if err := statusConn.Send(info); err != nil {
 		return nil, fmt.Errorf("send slave info: %s", err)
 	}
 
@@ -130,15 +162,39 @@ func (s *slaveConn) NextJob() (SlaveJob, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &slaveJob{listener: gobplexer.MultiplexListener(c)}, nil
+	return &slaveJob{listener: gobplexer.MultiplexListener(c), conn: s}, nil
 }
 
 func (s *slaveConn) Close() error {
 	return s.conn.Close()
 }
 
+// setBandwidth updates the upload limit, keeping the
+// current limiter if the limit has not changed.
+func (s *slaveConn) setBandwidth(bytesPerSecond int64) {
+	s.limiterLock.Lock()
+	defer s.limiterLock.Unlock()
+	if bytesPerSecond == 0 {
+		s.limiter = nil
+	} else if s.limiter == nil || s.limiter.Rate() != bytesPerSecond {
+		s.limiter = NewRateLimiter(bytesPerSecond)
+	}
+}
+
+func (s *slaveConn) uploadLimiter() *RateLimiter {
+	s.limiterLock.Lock()
+	defer s.limiterLock.Unlock()
+	return s.limiter
+}
+
 type slaveJob struct {
 	listener gobplexer.Listener
+	conn     *slaveConn
+	info     JobInfo
+
+	outcomeLock sync.Mutex
+	reported    bool
+	failed      bool
 }
 
 func (s *slaveJob) RunTasks(rootDir string) {
@@ -148,6 +204,10 @@ func (s *slaveJob) RunTasks(rootDir string) {
 		wg.Wait()
 	}()
 
+	if err := s.receiveInfo(); err != nil {
+		return
+	}
+
 	for {
 		conn, err := s.listener.Accept()
 		if err != nil {
@@ -162,6 +222,42 @@ func (s *slaveJob) RunTasks(rootDir string) {
 	}
 }
 
+func (s *slaveJob) Info() JobInfo {
+	return s.info
+}
+
+func (s *slaveJob) Failed() bool {
+	s.outcomeLock.Lock()
+	defer s.outcomeLock.Unlock()
+	return !s.reported || s.failed
+}
+
+func (s *slaveJob) setOutcome(failed bool) {
+	s.outcomeLock.Lock()
+	defer s.outcomeLock.Unlock()
+	s.reported = true
+	s.failed = failed
+}
+
+func (s *slaveJob) receiveInfo() error {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	infoObj, err := conn.Receive()
+	if err != nil {
+		return err
+	}
+	info, ok := infoObj.(JobInfo)
+	if !ok {
+		return fmt.Errorf("invalid job info type: %T", infoObj)
+	}
+	s.info = info
+	s.conn.setBandwidth(info.Bandwidth)
+	return conn.Send(nil)
+}
+
 func (s *slaveJob) runTask(rootDir string, conn gobplexer.Connection) {
 	listener := gobplexer.MultiplexListener(conn)
 
@@ -189,23 +285,57 @@ func (s *slaveJob) runTask(rootDir string, conn gobplexer.Connection) {
 	if !ok {
 		return
 	}
-	runErr := task.RunSlave(rootDir, slaveTaskConn{dataConn, logConn})
+	startTime := time.Now()
+	result := &TaskResult{}
+	runErr := task.RunSlave(rootDir, slaveTaskConn{dataConn, logConn, s.info, result, s})
 	logConn.Close()
 	dataConn.Close()
 
+	result.WallTime = time.Since(startTime)
 	if runErr != nil {
-		statusConn.Send(runErr.Error())
-	} else {
-		statusConn.Send(nil)
+		result.Error = runErr.Error()
 	}
+	statusConn.Send(result)
 	statusConn.Receive()
 }
 
 type slaveTaskConn struct {
 	gobplexer.Connection
 	logConn gobplexer.Connection
+	info    JobInfo
+	result  *TaskResult
+	job     *slaveJob
+}
+
+func (s slaveTaskConn) setProcessResult(r *ProcessResult) {
+	s.result.Process = r
+}
+
+func (s slaveTaskConn) setOutcome(failed bool) {
+	s.job.setOutcome(failed)
+}
+
+func (s slaveTaskConn) throttle(n int, sending bool) {
+	if !sending {
+		return
+	}
+	if limiter := s.job.conn.uploadLimiter(); limiter != nil {
+		limiter.Wait(n)
+	}
+}
+
+func (s slaveTaskConn) JobInfo() JobInfo {
+	return s.info
 }
 
 func (s slaveTaskConn) Log(message string) {
-	s.logConn.Send(message)
+	s.LogStream(StreamSystem, message)
+}
+
+func (s slaveTaskConn) LogStream(stream, message string) {
+	s.logConn.Send(LogEntry{Message: message, Time: time.Now(), Stream: stream})
+}
+
+func (s slaveTaskConn) ReportProgress(u taskclient.Update) {
+	s.logConn.Send(u)
 }
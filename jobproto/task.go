@@ -32,3 +32,19 @@ type TaskChannel interface {
 	// Log logs the given message.
 	Log(message string)
 }
+
+// LogStream logs a message to a named stream, such as
+// StreamStdout.
+// If the channel does not support streams, the message is
+// logged normally.
+func LogStream(ch TaskChannel, stream, message string) {
+	if s, ok := ch.(streamLogger); ok {
+		s.LogStream(stream, message)
+	} else {
+		ch.Log(message)
+	}
+}
+
+type streamLogger interface {
+	LogStream(stream, message string)
+}
@@ -0,0 +1,147 @@
+// Package taskclient lets programs run by jobempire report
+// their progress back to the master.
+//
+// When a GoRun task starts a program, the slave listens on
+// a local socket and passes its path to the program in the
+// environment variable named by SocketEnvVar.
+// Each connection to the socket carries a stream of JSON
+// encoded Updates.
+//
+// Outside of a task, the package-level functions do
+// nothing, so programs can report progress unconditionally.
+package taskclient
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"sync"
+)
+
+// SocketEnvVar is the environment variable containing the
+// path of the slave's progress socket.
+const SocketEnvVar = "JOBEMPIRE_TASK_SOCKET"
+
+// Kinds of Updates.
+const (
+	KindProgress = "progress"
+	KindMetric   = "metric"
+	KindStatus   = "status"
+)
+
+// ErrNotTask is returned by Dial when the program was not
+// started by a task which accepts progress reports.
+var ErrNotTask = errors.New("not running in a task")
+
+// An Update is one report from a program to the slave.
+type Update struct {
+	// Kind is KindProgress, KindMetric, or KindStatus.
+	Kind string
+
+	// Name is the name of a metric.
+	Name string `json:",omitempty"`
+
+	// Value is the fraction of work done, between 0 and 1,
+	// or the value of a metric.
+	Value float64 `json:",omitempty"`
+
+	// Text is a status message.
+	Text string `json:",omitempty"`
+}
+
+// A Client sends Updates to the slave.
+// It is safe to use from multiple Goroutines.
+type Client struct {
+	lock sync.Mutex
+	conn net.Conn
+	enc  *json.Encoder
+}
+
+// Dial connects to the socket named by SocketEnvVar.
+func Dial() (*Client, error) {
+	path := os.Getenv(SocketEnvVar)
+	if path == "" {
+		return nil, ErrNotTask
+	}
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, enc: json.NewEncoder(conn)}, nil
+}
+
+// Progress reports the fraction of the work which has
+// been done, from 0 to 1.
+func (c *Client) Progress(fraction float64) error {
+	return c.Send(Update{Kind: KindProgress, Value: fraction})
+}
+
+// Metric reports the current value of a named metric,
+// such as a loss or a throughput.
+func (c *Client) Metric(name string, value float64) error {
+	return c.Send(Update{Kind: KindMetric, Name: name, Value: value})
+}
+
+// Status reports a short message describing what the
+// program is doing.
+func (c *Client) Status(text string) error {
+	return c.Send(Update{Kind: KindStatus, Text: text})
+}
+
+// Send sends a raw Update.
+func (c *Client) Send(u Update) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.enc.Encode(&u)
+}
+
+// Close closes the connection to the slave.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+var (
+	defaultLock   sync.Mutex
+	defaultClient *Client
+	defaultErr    error
+	defaultDialed bool
+)
+
+// Progress reports progress with a shared Client.
+// It does nothing if the program is not running in a
+// task.
+func Progress(fraction float64) error {
+	return sendDefault(Update{Kind: KindProgress, Value: fraction})
+}
+
+// Metric reports a metric with a shared Client.
+// It does nothing if the program is not running in a
+// task.
+func Metric(name string, value float64) error {
+	return sendDefault(Update{Kind: KindMetric, Name: name, Value: value})
+}
+
+// Status reports a status message with a shared Client.
+// It does nothing if the program is not running in a
+// task.
+func Status(text string) error {
+	return sendDefault(Update{Kind: KindStatus, Text: text})
+}
+
+func sendDefault(u Update) error {
+	defaultLock.Lock()
+	if !defaultDialed {
+		defaultDialed = true
+		defaultClient, defaultErr = Dial()
+	}
+	client, err := defaultClient, defaultErr
+	defaultLock.Unlock()
+
+	if err == ErrNotTask {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return client.Send(u)
+}
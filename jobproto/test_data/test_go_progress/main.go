@@ -0,0 +1,15 @@
+package main
+
+import "github.com/unixpickle/jobempire/jobproto/taskclient"
+
+func main() {
+	for _, err := range []error{
+		taskclient.Status("working"),
+		taskclient.Progress(0.5),
+		taskclient.Metric("loss", 1.25),
+	} {
+		if err != nil {
+			panic(err)
+		}
+	}
+}
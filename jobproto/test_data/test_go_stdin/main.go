@@ -0,0 +1,18 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+)
+
+func main() {
+	input, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		panic(err)
+	}
+	output := os.Getenv("GREETING") + " " + os.Getenv("JOBEMPIRE_INSTANCE") + " " +
+		string(input)
+	if err := ioutil.WriteFile(os.Args[1], []byte(output), 0755); err != nil {
+		panic(err)
+	}
+}
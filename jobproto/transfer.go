@@ -0,0 +1,179 @@
+package jobproto
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CompressionGzip is the name of gzip compression.
+const CompressionGzip = "gzip"
+
+// TransferCompression lists the compression algorithms,
+// in order of preference, which this end of a connection
+// may use for file and executable transfers.
+//
+// The receiving end of a transfer tells the sending end
+// which algorithms it accepts, and the sender uses the
+// first one that it accepts as well.
+// If either list is empty, data is sent uncompressed.
+var TransferCompression = []string{CompressionGzip}
+
+// Bandwidth, if non-nil, limits the combined rate of file
+// and executable transfers between the master and all of
+// its slaves.
+var Bandwidth *RateLimiter
+
+// SlaveBandwidth, if non-zero, limits the rate of file and
+// executable transfers between the master and each slave,
+// in bytes per second.
+// It applies to connections made after it is set.
+var SlaveBandwidth int64
+
+// ParseCompression parses a comma-separated list of
+// compression algorithms for TransferCompression.
+// The string "none" yields an empty list.
+func ParseCompression(s string) ([]string, error) {
+	if s == "" || s == "none" {
+		return []string{}, nil
+	}
+	var res []string
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name != CompressionGzip {
+			return nil, errors.New("unknown compression: " + name)
+		}
+		res = append(res, name)
+	}
+	return res, nil
+}
+
+// chooseCompression picks the first algorithm from
+// TransferCompression which the receiver accepts.
+// It returns "" if there is no common algorithm.
+func chooseCompression(accepted []string) string {
+	for _, ours := range TransferCompression {
+		for _, theirs := range accepted {
+			if ours == theirs {
+				return ours
+			}
+		}
+	}
+	return ""
+}
+
+// encodeChunk compresses a chunk with the given algorithm.
+// If compression does not make the chunk smaller, the
+// chunk is returned as is, with an empty encoding.
+//
+// Each chunk is compressed on its own rather than as part
+// of a stream, so that chunks can be verified, resumed,
+// and sent uncompressed independently.
+func encodeChunk(data []byte, compression string) ([]byte, string) {
+	if compression != CompressionGzip {
+		return data, ""
+	}
+	var buf bytes.Buffer
+	w, _ := gzip.NewWriterLevel(&buf, gzip.BestSpeed)
+	w.Write(data)
+	w.Close()
+	if buf.Len() >= len(data) {
+		return data, ""
+	}
+	return buf.Bytes(), compression
+}
+
+// decodeChunk undoes encodeChunk.
+// Chunks are limited to transferBufferSize bytes, which
+// protects against maliciously compressed data.
+func decodeChunk(data []byte, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return data, nil
+	case CompressionGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		res, err := ioutil.ReadAll(io.LimitReader(r, transferBufferSize+1))
+		if err != nil {
+			return nil, err
+		} else if len(res) > transferBufferSize {
+			return nil, errors.New("decompressed chunk is too large")
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported encoding: %s", encoding)
+	}
+}
+
+// throttleTransfer waits until n bytes of a transfer may
+// be sent or received on a channel, according to the
+// bandwidth limits.
+// It has no effect if the channel is not rate limited.
+//
+// The master limits data in both directions, while slaves
+// only limit the data they send, which keeps their uploads
+// from flooding the connection.
+func throttleTransfer(ch TaskChannel, n int, sending bool) {
+	if t, ok := ch.(throttledChannel); ok {
+		t.throttle(n, sending)
+	}
+}
+
+type throttledChannel interface {
+	throttle(n int, sending bool)
+}
+
+// A RateLimiter limits a data rate using a token bucket.
+// It allows bursts of up to one second's worth of data.
+type RateLimiter struct {
+	rate float64
+
+	lock   sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a RateLimiter which allows the
+// given number of bytes per second.
+func NewRateLimiter(bytesPerSecond int64) *RateLimiter {
+	return &RateLimiter{
+		rate:   float64(bytesPerSecond),
+		tokens: float64(bytesPerSecond),
+		last:   time.Now(),
+	}
+}
+
+// Rate returns the number of bytes per second which the
+// RateLimiter allows.
+func (r *RateLimiter) Rate() int64 {
+	return int64(r.rate)
+}
+
+// Wait blocks until n more bytes may be transferred.
+//
+// A transfer larger than the burst size is allowed to go
+// through, but later callers wait for it to be paid off.
+func (r *RateLimiter) Wait(n int) {
+	r.lock.Lock()
+	now := time.Now()
+	r.tokens += now.Sub(r.last).Seconds() * r.rate
+	if r.tokens > r.rate {
+		r.tokens = r.rate
+	}
+	r.last = now
+	r.tokens -= float64(n)
+	var delay time.Duration
+	if r.tokens < 0 {
+		delay = time.Duration(-r.tokens / r.rate * float64(time.Second))
+	}
+	r.lock.Unlock()
+	time.Sleep(delay)
+}
@@ -0,0 +1,342 @@
+package jobproto
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/unixpickle/jobempire/jobproto/taskclient"
+)
+
+const (
+	urlFetchMaxDelay      = time.Second * 30
+	urlFetchHeaderTimeout = time.Second * 30
+	urlFetchLogInterval   = time.Second * 5
+	urlFetchPartialSuffix = ".download"
+)
+
+// urlFetchRetryDelay is the delay before the first retry
+// of a download. It doubles with every retry.
+var urlFetchRetryDelay = time.Second
+
+// urlFetchIdleTimeout is the longest a download may go
+// without receiving data before the attempt fails.
+var urlFetchIdleTimeout = time.Second * 30
+
+var urlFetchClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   urlFetchHeaderTimeout,
+			KeepAlive: urlFetchHeaderTimeout,
+		}).DialContext,
+		TLSHandshakeTimeout:   urlFetchHeaderTimeout,
+		ResponseHeaderTimeout: urlFetchHeaderTimeout,
+	},
+}
+
+func init() {
+	gob.Register(&URLFetch{})
+}
+
+// URLFetch is a Task which has the slave download a URL
+// directly into the job's directory.
+//
+// Failed downloads are resumed when the server supports
+// range requests.
+// A download is stopped if the task is cancelled or the
+// connection to the master dies.
+type URLFetch struct {
+	URL       string
+	SlavePath string
+
+	// SHA256, if set, is the hex-encoded hash which the
+	// downloaded file must have.
+	SHA256 string
+
+	// MaxSize, if non-zero, is the maximum size of the
+	// download in bytes.
+	MaxSize int64
+
+	// Retries is the number of times a failed download is
+	// retried.
+	Retries int
+}
+
+// RunMaster runs the master's end of the task, which
+// keeps the channel open until the slave is done, so that
+// the slave notices if the task is cancelled.
+func (u *URLFetch) RunMaster(ch TaskChannel) error {
+	ch.Receive()
+	return nil
+}
+
+// RunSlave downloads the file.
+func (u *URLFetch) RunSlave(root string, ch TaskChannel) error {
+	if !strings.HasPrefix(u.URL, "http://") && !strings.HasPrefix(u.URL, "https://") {
+		return errors.New("unsupported URL: " + u.URL)
+	}
+	expectHash := strings.ToLower(strings.TrimSpace(u.SHA256))
+	if expectHash != "" {
+		if err := checkBlobHash(expectHash); err != nil {
+			return errors.New("invalid SHA-256: " + u.SHA256)
+		}
+	}
+
+	// The master closes the channel if the task is cancelled
+	// or the connection dies.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		ch.Receive()
+		cancel()
+	}()
+
+	path := filepath.Join(root, u.SlavePath)
+	partialPath := path + urlFetchPartialSuffix
+	delay := urlFetchRetryDelay
+	for attempt := 0; ; attempt++ {
+		err := u.download(ctx, ch, partialPath)
+		if ctx.Err() != nil {
+			os.Remove(partialPath)
+			return ErrTaskCancelled
+		}
+		if err == nil {
+			err = u.verify(ch, partialPath, expectHash)
+			if err == nil {
+				return os.Rename(partialPath, path)
+			}
+			// A corrupt download cannot be resumed.
+			os.Remove(partialPath)
+		}
+		if _, ok := err.(permanentError); ok || attempt >= u.Retries {
+			os.Remove(partialPath)
+			return err
+		}
+		ch.Log(fmt.Sprintf("download failed (retrying in %s): %s", delay, err))
+		select {
+		case <-ctx.Done():
+			os.Remove(partialPath)
+			return ErrTaskCancelled
+		case <-time.After(delay):
+		}
+		delay *= 2
+		if delay > urlFetchMaxDelay {
+			delay = urlFetchMaxDelay
+		}
+	}
+}
+
+// download downloads the URL into a partial file,
+// resuming from the end of the file if possible.
+// The download stops if ctx is cancelled or if no data
+// arrives for urlFetchIdleTimeout.
+func (u *URLFetch) download(ctx context.Context, ch TaskChannel, path string) error {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0666)
+	if err != nil {
+		return permanentError{err}
+	}
+	defer f.Close()
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return permanentError{err}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	idle := &idleTimer{timeout: urlFetchIdleTimeout}
+	idle.Start(cancel)
+	defer idle.Stop()
+
+	req, err := http.NewRequest("GET", u.URL, nil)
+	if err != nil {
+		return permanentError{err}
+	}
+	req = req.WithContext(ctx)
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	ch.Log("downloading " + u.URL)
+	resp, err := urlFetchClient.Do(req)
+	if err != nil {
+		return idle.Err(err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && offset > 0 &&
+		strings.HasPrefix(resp.Header.Get("Content-Range"),
+			fmt.Sprintf("bytes %d-", offset)):
+		ch.Log(fmt.Sprintf("resuming from offset %d", offset))
+	case resp.StatusCode == http.StatusOK:
+		if err := f.Truncate(0); err != nil {
+			return permanentError{err}
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return permanentError{err}
+		}
+		offset = 0
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable:
+		// The partial file may be longer than the resource,
+		// so the next attempt starts over.
+		f.Truncate(0)
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	default:
+		err := fmt.Errorf("unexpected status: %s", resp.Status)
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+			resp.StatusCode != http.StatusRequestTimeout &&
+			resp.StatusCode != http.StatusTooManyRequests {
+			return permanentError{err}
+		}
+		return err
+	}
+
+	total := int64(-1)
+	if resp.ContentLength >= 0 {
+		total = offset + resp.ContentLength
+		if u.MaxSize > 0 && total > u.MaxSize {
+			return permanentError{fmt.Errorf("download size %d exceeds limit %d",
+				total, u.MaxSize)}
+		}
+	}
+
+	progress := &fetchProgress{ch: ch, offset: offset, total: total, lastLog: time.Now()}
+	body := io.Reader(resp.Body)
+	if u.MaxSize > 0 {
+		body = io.LimitReader(body, u.MaxSize-offset+1)
+	}
+	buf := make([]byte, transferBufferSize)
+	for {
+		n, err := body.Read(buf)
+		if n > 0 {
+			idle.Reset()
+			if _, err := f.Write(buf[:n]); err != nil {
+				return permanentError{err}
+			}
+			progress.Add(int64(n))
+			if u.MaxSize > 0 && progress.offset > u.MaxSize {
+				return permanentError{fmt.Errorf("download exceeds size limit %d", u.MaxSize)}
+			}
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return idle.Err(err)
+		}
+	}
+	progress.Done()
+	if total >= 0 && progress.offset != total {
+		return fmt.Errorf("got %d bytes but expected %d", progress.offset, total)
+	}
+	return nil
+}
+
+func (u *URLFetch) verify(ch TaskChannel, path, expectHash string) error {
+	if expectHash == "" {
+		return nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return err
+	}
+	if actual := hex.EncodeToString(hasher.Sum(nil)); actual != expectHash {
+		return fmt.Errorf("checksum mismatch: got %s", actual)
+	}
+	ch.Log("checksum verified")
+	return nil
+}
+
+// An idleTimer cancels a download which stops receiving
+// data.
+type idleTimer struct {
+	timeout time.Duration
+
+	lock    sync.Mutex
+	timer   *time.Timer
+	expired bool
+}
+
+// Start starts the timer, which calls cancel if it
+// expires.
+func (i *idleTimer) Start(cancel func()) {
+	i.timer = time.AfterFunc(i.timeout, func() {
+		i.lock.Lock()
+		i.expired = true
+		i.lock.Unlock()
+		cancel()
+	})
+}
+
+// Reset restarts the timer after data has arrived.
+func (i *idleTimer) Reset() {
+	i.timer.Reset(i.timeout)
+}
+
+func (i *idleTimer) Stop() {
+	i.timer.Stop()
+}
+
+// Err replaces an error caused by the timer with one that
+// explains it.
+func (i *idleTimer) Err(err error) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if i.expired {
+		return fmt.Errorf("no data received for %s", i.timeout)
+	}
+	return err
+}
+
+// permanentError wraps an error which should not cause a
+// download to be retried.
+type permanentError struct {
+	error
+}
+
+// fetchProgress logs the progress of a download at
+// regular intervals.
+type fetchProgress struct {
+	ch      TaskChannel
+	offset  int64
+	total   int64
+	lastLog time.Time
+}
+
+func (f *fetchProgress) Add(n int64) {
+	f.offset += n
+	if time.Since(f.lastLog) >= urlFetchLogInterval {
+		f.log()
+	}
+}
+
+func (f *fetchProgress) Done() {
+	f.log()
+}
+
+func (f *fetchProgress) log() {
+	f.lastLog = time.Now()
+	if f.total <= 0 {
+		f.ch.Log(fmt.Sprintf("downloaded %d bytes", f.offset))
+		return
+	}
+	fraction := float64(f.offset) / float64(f.total)
+	f.ch.Log(fmt.Sprintf("downloaded %d of %d bytes (%.1f%%)", f.offset, f.total,
+		fraction*100))
+	ReportProgress(f.ch, taskclient.Update{Kind: taskclient.KindProgress, Value: fraction})
+}
@@ -0,0 +1,212 @@
+package jobproto
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestURLFetch(t *testing.T) {
+	oldDelay := urlFetchRetryDelay
+	urlFetchRetryDelay = time.Millisecond
+	defer func() {
+		urlFetchRetryDelay = oldDelay
+	}()
+
+	data := make([]byte, transferBufferSize*4)
+	rand.Read(data)
+	hash := sha256.Sum256(data)
+	hexHash := hex.EncodeToString(hash[:])
+
+	// The first request fails with a server error, and the
+	// second one is cut off halfway through.
+	var lock sync.Mutex
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		lock.Lock()
+		requests++
+		num := requests
+		lock.Unlock()
+		switch num {
+		case 1:
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+		case 2:
+			w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+			w.Write(data[:len(data)/2])
+		default:
+			http.ServeContent(w, r, "data", time.Time{}, bytes.NewReader(data))
+		}
+	}))
+	defer server.Close()
+
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+	job, err := master.StartJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+
+	runFetch := func(task *URLFetch) (string, error) {
+		logChan := make(chan LogEntry, 100)
+		err := job.Run(task, logChan)
+		close(logChan)
+		var log bytes.Buffer
+		for entry := range logChan {
+			log.WriteString(entry.Message + "\n")
+		}
+		return log.String(), err
+	}
+
+	log, err := runFetch(&URLFetch{
+		URL:       server.URL,
+		SlavePath: "data",
+		SHA256:    hexHash,
+		Retries:   2,
+	})
+	if err != nil {
+		t.Fatal(err, log)
+	}
+	if !strings.Contains(log, "resuming from offset") {
+		t.Error("download was not resumed")
+	}
+	if contents, err := ioutil.ReadFile(filepath.Join(tempDir, "data")); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(contents, data) {
+		t.Error("bad contents")
+	}
+
+	failures := []*URLFetch{
+		{URL: server.URL, SlavePath: "bad_hash", SHA256: strings.Repeat("0", 64)},
+		{URL: server.URL, SlavePath: "too_big", MaxSize: int64(len(data) - 1), Retries: 3},
+		{URL: server.URL + "/missing", SlavePath: "missing", Retries: 3},
+	}
+	for i, task := range failures {
+		lock.Lock()
+		requests = 2
+		lock.Unlock()
+		if _, err := runFetch(task); err == nil {
+			t.Errorf("failure %d: expected error", i)
+		}
+		if _, err := os.Stat(filepath.Join(tempDir, task.SlavePath)); !os.IsNotExist(err) {
+			t.Errorf("failure %d: file should not exist", i)
+		}
+	}
+}
+
+func TestURLFetchStalled(t *testing.T) {
+	oldDelay, oldTimeout := urlFetchRetryDelay, urlFetchIdleTimeout
+	urlFetchRetryDelay = time.Hour
+	urlFetchIdleTimeout = time.Millisecond * 200
+	defer func() {
+		urlFetchRetryDelay, urlFetchIdleTimeout = oldDelay, oldTimeout
+	}()
+
+	// The server sends part of the file and then stalls
+	// until the client gives up.
+	stalled := make(chan struct{}, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "100")
+		w.Write([]byte("partial"))
+		w.(http.Flusher).Flush()
+		stalled <- struct{}{}
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer master.Close()
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	go func() {
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			job.RunTasks(tempDir)
+		}
+	}()
+	job, err := master.StartJob()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer job.Close()
+
+	// A stalled download times out.
+	logChan := make(chan LogEntry, 100)
+	err = job.Run(&URLFetch{URL: server.URL, SlavePath: "idle"}, logChan)
+	close(logChan)
+	if err == nil || !strings.Contains(err.Error(), "no data received") {
+		t.Errorf("expected idle timeout but got %v", err)
+	}
+	<-stalled
+
+	// Cancelling the task interrupts both the download and
+	// the delay before the next attempt.
+	for _, waitForRetry := range []bool{false, true} {
+		urlFetchIdleTimeout = time.Hour
+		if waitForRetry {
+			urlFetchIdleTimeout = time.Millisecond * 100
+		}
+		cancel := make(chan struct{})
+		go func() {
+			<-stalled
+			if waitForRetry {
+				time.Sleep(time.Millisecond * 300)
+			}
+			close(cancel)
+		}()
+		start := time.Now()
+		_, err = job.RunWith(&URLFetch{URL: server.URL, SlavePath: "cancelled", Retries: 1},
+			nil, RunOptions{Cancel: cancel})
+		if err != ErrTaskCancelled {
+			t.Errorf("retry %v: expected cancellation but got %v", waitForRetry, err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second*5 {
+			t.Errorf("retry %v: cancellation took %s", waitForRetry, elapsed)
+		}
+	}
+	time.Sleep(time.Millisecond * 100)
+	if _, err := os.Stat(filepath.Join(tempDir, "cancelled"+urlFetchPartialSuffix)); !os.IsNotExist(err) {
+		t.Error("partial file should be removed")
+	}
+}
@@ -0,0 +1,615 @@
+package jobproto
+
+import (
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloudfoundry/gosigar"
+)
+
+const workDirInfoExt = ".json"
+
+// workDirOrphanAge is how old a job directory without a
+// record must be before it is pruned, since its record may
+// be about to be written.
+const workDirOrphanAge = time.Minute
+
+// workDirHost identifies the machine and PID namespace of
+// this process, so that a slave only judges the process
+// IDs recorded by slaves which it can see.
+// It is empty if the host name is unknown.
+var workDirHost = func() string {
+	host, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return host + "/" + processNamespace()
+}()
+
+func init() {
+	gob.Register([]WorkDirInfo{})
+	gob.Register([]WorkFileInfo{})
+	gob.Register(&jobOutcome{})
+	gob.Register(&workDirIndex{})
+	gob.Register(&workDirList{})
+	gob.Register(&workFileDownload{})
+}
+
+// WorkDirs, if non-nil, holds the directories in which a
+// slave runs its jobs, including the ones which are kept
+// after their jobs are done.
+var WorkDirs *WorkDirStore
+
+// A WorkDirStore manages the directories of jobs on a
+// slave.
+//
+// Once a job is done, its directory is deleted or kept,
+// depending on the job's KeepDir policy.
+// Kept directories are deleted once they expire.
+// The oldest ones are deleted early if the kept
+// directories take up more than MaxSize bytes, or if the
+// disk has less than MinFree bytes available.
+// A zero DefaultAge, MaxSize, or MinFree means there is
+// no limit.
+//
+// Directories of jobs which never finished, because their
+// slave died, are deleted as well.
+// Several slaves may share a store's directory.
+type WorkDirStore struct {
+	Dir string
+
+	// DefaultAge is how long directories are kept for jobs
+	// which do not set KeepHours.
+	DefaultAge time.Duration
+
+	MaxSize int64
+	MinFree int64
+
+	lock    sync.Mutex
+	running map[string]bool
+}
+
+// A WorkDirInfo describes a job directory which was kept
+// on a slave.
+type WorkDirInfo struct {
+	Job    JobInfo
+	Failed bool
+
+	// Size is the total size of the files in the
+	// directory.
+	Size int64
+
+	EndTime time.Time
+
+	// Expires is the time after which the directory is
+	// deleted, or the zero time if it is only deleted to
+	// free up space.
+	Expires time.Time
+
+	// Running is set in the records of jobs which have not
+	// finished yet, in which case PID is the process ID of
+	// the slave running the job and Host identifies the
+	// machine and PID namespace that the slave runs in.
+	// Such directories are never listed.
+	Running bool
+	PID     int
+	Host    string
+
+	name string
+}
+
+// A WorkFileInfo describes a file in a kept job
+// directory.
+type WorkFileInfo struct {
+	Name    string
+	Size    int64
+	Mode    os.FileMode
+	ModTime time.Time
+}
+
+// IsDir returns whether the file is a directory.
+func (w WorkFileInfo) IsDir() bool {
+	return w.Mode.IsDir()
+}
+
+// NewWorkDirStore creates a WorkDirStore, creating the
+// directory if necessary.
+func NewWorkDirStore(dir string, defaultAge time.Duration, maxSize,
+	minFree int64) (*WorkDirStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &WorkDirStore{
+		Dir:        dir,
+		DefaultAge: defaultAge,
+		MaxSize:    maxSize,
+		MinFree:    minFree,
+		running:    map[string]bool{},
+	}, nil
+}
+
+// Create creates an empty directory for a new job.
+//
+// The directory is recorded as running until Finish is
+// called, so that it can be pruned if the slave dies.
+func (w *WorkDirStore) Create() (string, error) {
+	dir, err := ioutil.TempDir(w.Dir, "job")
+	if err != nil {
+		return "", err
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	record := WorkDirInfo{Running: true, PID: os.Getpid(), Host: workDirHost}
+	if err := writeWorkDirRecord(dir, record); err != nil {
+		os.RemoveAll(dir)
+		return "", err
+	}
+	w.running[filepath.Base(dir)] = true
+	return dir, nil
+}
+
+// Finish deletes or keeps the directory of a job which is
+// done, depending on the job's KeepDir policy and on
+// whether it failed.
+// Directories are only kept for jobs with a RunID, since
+// the RunID is used to look them up.
+//
+// Keeping a directory may cause older ones to be pruned.
+// The kept result indicates if the directory is still
+// around afterwards.
+func (w *WorkDirStore) Finish(dir string, info JobInfo, failed bool) (kept bool, err error) {
+	name := filepath.Base(dir)
+	defer func() {
+		w.lock.Lock()
+		delete(w.running, name)
+		w.lock.Unlock()
+	}()
+
+	keep := info.KeepDir == KeepDirAlways || (info.KeepDir == KeepDirOnFailure && failed)
+	if !keep || info.RunID == "" {
+		return false, w.remove(name)
+	}
+
+	size, err := dirSize(dir)
+	if err != nil {
+		w.remove(name)
+		return false, err
+	}
+	record := WorkDirInfo{
+		Job:     info,
+		Failed:  failed,
+		Size:    size,
+		EndTime: time.Now(),
+	}
+	age := time.Duration(info.KeepHours) * time.Hour
+	if age == 0 {
+		age = w.DefaultAge
+	}
+	if age > 0 {
+		record.Expires = record.EndTime.Add(age)
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if err := writeWorkDirRecord(dir, record); err != nil {
+		w.remove(name)
+		return false, err
+	}
+	pruneErr := w.prune()
+	_, err = os.Stat(dir)
+	return err == nil, pruneErr
+}
+
+// List returns the kept directories, oldest first.
+func (w *WorkDirStore) List() ([]WorkDirInfo, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	records, err := w.list()
+	if err != nil {
+		return nil, err
+	}
+	var res []WorkDirInfo
+	for _, record := range records {
+		if !record.Running {
+			res = append(res, record)
+		}
+	}
+	return res, nil
+}
+
+// Path returns the path of the kept directory for a run.
+func (w *WorkDirStore) Path(runID string) (string, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	records, err := w.list()
+	if err != nil {
+		return "", err
+	}
+	for _, record := range records {
+		if runID != "" && record.Job.RunID == runID && !record.Running {
+			return filepath.Join(w.Dir, record.name), nil
+		}
+	}
+	return "", errors.New("no kept directory for run: " + runID)
+}
+
+// Prune deletes kept directories which have expired or
+// which exceed the limits of the store, along with the
+// directories of jobs whose slaves died.
+func (w *WorkDirStore) Prune() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.prune()
+}
+
+func (w *WorkDirStore) list() ([]WorkDirInfo, error) {
+	listing, err := ioutil.ReadDir(w.Dir)
+	if err != nil {
+		return nil, err
+	}
+	var res []WorkDirInfo
+	for _, info := range listing {
+		if info.IsDir() || !strings.HasSuffix(info.Name(), workDirInfoExt) {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(w.Dir, info.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var record WorkDirInfo
+		if err := json.Unmarshal(data, &record); err != nil {
+			// A damaged record has already expired, so that
+			// pruning cleans it up.
+			record = WorkDirInfo{EndTime: info.ModTime(), Expires: info.ModTime()}
+		}
+		record.name = strings.TrimSuffix(info.Name(), workDirInfoExt)
+		res = append(res, record)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].EndTime.Before(res[j].EndTime)
+	})
+	return res, nil
+}
+
+func (w *WorkDirStore) prune() error {
+	if err := w.pruneOrphans(); err != nil {
+		return err
+	}
+	records, err := w.list()
+	if err != nil {
+		return err
+	}
+	var totalSize int64
+	for _, record := range records {
+		totalSize += record.Size
+	}
+	for _, record := range records {
+		if record.Running {
+			continue
+		}
+		expired := !record.Expires.IsZero() && time.Now().After(record.Expires)
+		tooBig := w.MaxSize > 0 && totalSize > w.MaxSize
+		lowDisk := false
+		if w.MinFree > 0 {
+			free, err := w.freeSpace()
+			if err != nil {
+				return err
+			}
+			lowDisk = free < w.MinFree
+		}
+		if !expired && !tooBig && !lowDisk {
+			continue
+		}
+		if err := w.remove(record.name); err != nil {
+			return err
+		}
+		totalSize -= record.Size
+	}
+	return nil
+}
+
+// pruneOrphans deletes the directories of running jobs
+// whose slaves are gone, and job directories which have
+// no record at all.
+//
+// Running jobs recorded on other hosts or in other PID
+// namespaces are left alone, since their process IDs mean
+// nothing here.
+func (w *WorkDirStore) pruneOrphans() error {
+	records, err := w.list()
+	if err != nil {
+		return err
+	}
+	recorded := map[string]bool{}
+	for _, record := range records {
+		recorded[record.name] = true
+		if !record.Running || record.Host == "" || record.Host != workDirHost {
+			continue
+		}
+		// This process may have the ID of a slave which died
+		// before it started, e.g. in a container.
+		alive := w.running[record.name]
+		if record.PID != os.Getpid() {
+			alive = processAlive(record.PID)
+		}
+		if !alive {
+			if err := w.remove(record.name); err != nil {
+				return err
+			}
+		}
+	}
+
+	listing, err := ioutil.ReadDir(w.Dir)
+	if err != nil {
+		return err
+	}
+	for _, info := range listing {
+		name := info.Name()
+		if !info.IsDir() || !strings.HasPrefix(name, "job") || recorded[name] ||
+			time.Since(info.ModTime()) < workDirOrphanAge {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(w.Dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remove deletes a job directory and then its record.
+func (w *WorkDirStore) remove(name string) error {
+	if err := os.RemoveAll(filepath.Join(w.Dir, name)); err != nil {
+		return err
+	}
+	err := os.Remove(filepath.Join(w.Dir, name+workDirInfoExt))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// writeWorkDirRecord replaces the record of a job
+// directory, making sure that other slaves sharing the
+// store never see a partial record.
+func writeWorkDirRecord(dir string, record WorkDirInfo) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	tempPath := dir + workDirInfoExt + ".tmp"
+	if err := ioutil.WriteFile(tempPath, data, 0644); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	if err := os.Rename(tempPath, dir+workDirInfoExt); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	return nil
+}
+
+func (w *WorkDirStore) freeSpace() (int64, error) {
+	usage := sigar.FileSystemUsage{}
+	if err := usage.Get(w.Dir); err != nil {
+		return 0, err
+	}
+	return int64(usage.Avail) << 10, nil
+}
+
+// ListWorkDirs lists the job directories kept on a slave.
+func ListWorkDirs(m Master) ([]WorkDirInfo, error) {
+	task := &workDirIndex{}
+	if err := runWorkDirTask(m, task); err != nil {
+		return nil, err
+	}
+	return task.Dirs, nil
+}
+
+// ListWorkDir lists a directory inside the kept job
+// directory of a run on a slave.
+// The directory's path is slash-separated and relative to
+// the job's directory, which is itself listed for "".
+func ListWorkDir(m Master, runID, dirPath string) ([]WorkFileInfo, error) {
+	task := &workDirList{RunID: runID, Path: dirPath}
+	if err := runWorkDirTask(m, task); err != nil {
+		return nil, err
+	}
+	return task.Files, nil
+}
+
+// DownloadWorkFile copies a file from the kept job
+// directory of a run on a slave to w.
+// Nothing is written to w if the file cannot be opened.
+func DownloadWorkFile(m Master, runID, filePath string, w io.Writer) error {
+	return runWorkDirTask(m, &workFileDownload{RunID: runID, Path: filePath, w: w})
+}
+
+// runWorkDirTask runs a task in a job of its own, so that
+// the task does not need a running job.
+func runWorkDirTask(m Master, t Task) error {
+	job, err := m.StartJob()
+	if err != nil {
+		return err
+	}
+	defer job.Close()
+	return job.Run(t, nil)
+}
+
+// jobOutcome is an internal Task which tells the slave
+// whether its job failed.
+type jobOutcome struct {
+	Failed bool
+}
+
+func (j *jobOutcome) RunMaster(ch TaskChannel) error {
+	return nil
+}
+
+func (j *jobOutcome) RunSlave(root string, ch TaskChannel) error {
+	if c, ok := ch.(outcomeChannel); ok {
+		c.setOutcome(j.Failed)
+	}
+	return nil
+}
+
+type outcomeChannel interface {
+	setOutcome(failed bool)
+}
+
+// workDirIndex is an internal Task which lists the kept
+// job directories on a slave.
+type workDirIndex struct {
+	// Dirs is set on the master's end once the task is
+	// done.
+	Dirs []WorkDirInfo
+}
+
+func (w *workDirIndex) RunMaster(ch TaskChannel) error {
+	obj, err := ch.Receive()
+	if err != nil {
+		// The slave's error is reported in the task status.
+		return nil
+	}
+	list, ok := obj.([]WorkDirInfo)
+	if !ok {
+		return fmt.Errorf("invalid listing type: %T", obj)
+	}
+	w.Dirs = list
+	return nil
+}
+
+func (w *workDirIndex) RunSlave(root string, ch TaskChannel) error {
+	if WorkDirs == nil {
+		return errors.New("slave does not keep job directories")
+	}
+	list, err := WorkDirs.List()
+	if err != nil {
+		return err
+	}
+	return ch.Send(append([]WorkDirInfo{}, list...))
+}
+
+// workDirList is an internal Task which lists a directory
+// inside a kept job directory.
+type workDirList struct {
+	RunID string
+	Path  string
+
+	// Files is set on the master's end once the task is
+	// done.
+	Files []WorkFileInfo
+}
+
+func (w *workDirList) RunMaster(ch TaskChannel) error {
+	obj, err := ch.Receive()
+	if err != nil {
+		// The slave's error is reported in the task status.
+		return nil
+	}
+	list, ok := obj.([]WorkFileInfo)
+	if !ok {
+		return fmt.Errorf("invalid listing type: %T", obj)
+	}
+	w.Files = list
+	return nil
+}
+
+func (w *workDirList) RunSlave(root string, ch TaskChannel) error {
+	dir, err := workDirFile(w.RunID, w.Path)
+	if err != nil {
+		return err
+	}
+	listing, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	res := []WorkFileInfo{}
+	for _, info := range listing {
+		res = append(res, WorkFileInfo{
+			Name:    info.Name(),
+			Size:    info.Size(),
+			Mode:    info.Mode(),
+			ModTime: info.ModTime(),
+		})
+	}
+	return ch.Send(res)
+}
+
+// workFileDownload is an internal Task which sends a file
+// from a kept job directory to the master.
+type workFileDownload struct {
+	RunID string
+	Path  string
+
+	w io.Writer
+}
+
+func (w *workFileDownload) RunMaster(ch TaskChannel) error {
+	if _, err := ch.Receive(); err != nil {
+		// The slave's error is reported in the task status.
+		return nil
+	}
+	return receiveArtifactData(ch, w.w)
+}
+
+func (w *workFileDownload) RunSlave(root string, ch TaskChannel) error {
+	p, err := workDirFile(w.RunID, w.Path)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if info, err := f.Stat(); err != nil {
+		return err
+	} else if !info.Mode().IsRegular() {
+		return errors.New("not a regular file: " + w.Path)
+	}
+	return sendArtifact(ch, w.Path, f)
+}
+
+// workDirFile resolves a slash-separated path inside the
+// kept job directory of a run, where "" refers to the
+// directory itself.
+// Symbolic links may not lead outside of the directory.
+func workDirFile(runID, p string) (string, error) {
+	if WorkDirs == nil {
+		return "", errors.New("slave does not keep job directories")
+	}
+	root, err := WorkDirs.Path(runID)
+	if err != nil {
+		return "", err
+	}
+	target := root
+	if p != "" {
+		target, err = jobFilePath(root, p)
+		if err != nil {
+			return "", err
+		}
+	}
+	realRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", err
+	}
+	realTarget, err := filepath.EvalSymlinks(target)
+	if err != nil {
+		return "", errors.New("no such file: " + p)
+	}
+	rel, err := filepath.Rel(realRoot, realTarget)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errors.New("path outside of job directory: " + p)
+	}
+	return realTarget, nil
+}
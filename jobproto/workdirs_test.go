@@ -0,0 +1,212 @@
+package jobproto
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWorkDirs(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	WorkDirs, err = NewWorkDirStore(tempDir, time.Hour, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	master, slave, err := TestingMasterSlave()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The slave's goroutines must be done with WorkDirs
+	// before it is reset.
+	var wg sync.WaitGroup
+	defer func() {
+		master.Close()
+		wg.Wait()
+		WorkDirs = nil
+	}()
+	finished := make(chan bool, 1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			job, err := slave.NextJob()
+			if err != nil {
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				rootDir, err := WorkDirs.Create()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				job.RunTasks(rootDir)
+				kept, err := WorkDirs.Finish(rootDir, job.Info(), job.Failed())
+				if err != nil {
+					t.Error(err)
+				}
+				if job.Info().RunID != "" {
+					finished <- kept
+				}
+			}()
+		}
+	}()
+
+	for i, failed := range []bool{false, true} {
+		job, err := master.StartJobInfo(JobInfo{
+			RunID:   []string{"run1", "run2"}[i],
+			KeepDir: KeepDirOnFailure,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := job.Run(&InlineFile{SlavePath: "out/log.txt", Content: "hello"}, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := job.Finish(failed); err != nil {
+			t.Fatal(err)
+		}
+		if kept := <-finished; kept != failed {
+			t.Errorf("run %d: expected kept=%v", i, failed)
+		}
+	}
+
+	dirs, err := ListWorkDirs(master)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) != 1 || dirs[0].Job.RunID != "run2" || !dirs[0].Failed || dirs[0].Size != 5 {
+		t.Fatalf("unexpected directories: %v", dirs)
+	}
+
+	listing, err := ListWorkDir(master, "run2", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(listing) != 1 || listing[0].Name != "out" || !listing[0].IsDir() {
+		t.Errorf("unexpected listing: %v", listing)
+	}
+	var buf bytes.Buffer
+	if err := DownloadWorkFile(master, "run2", "out/log.txt", &buf); err != nil {
+		t.Fatal(err)
+	} else if buf.String() != "hello" {
+		t.Errorf("unexpected contents: %q", buf.String())
+	}
+
+	if _, err := ListWorkDir(master, "run1", ""); err == nil {
+		t.Error("expected error for deleted directory")
+	}
+	if err := DownloadWorkFile(master, "run2", "../run1", &buf); err == nil {
+		t.Error("expected error for path outside of directory")
+	}
+	if err := DownloadWorkFile(master, "run2", "out", &buf); err == nil {
+		t.Error("expected error for directory download")
+	}
+}
+
+func TestWorkDirsOrphans(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobproto_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	store, err := NewWorkDirStore(tempDir, time.Hour, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	running, err := store.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A directory without a record, left by an older slave.
+	old := time.Now().Add(-2 * workDirOrphanAge)
+	for _, name := range []string{"job_norecord", "job_new"} {
+		if err := os.Mkdir(filepath.Join(tempDir, name), 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Chtimes(filepath.Join(tempDir, "job_norecord"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	// A directory of a slave which has exited.
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	if err := cmd.Run(); err != nil {
+		t.Fatal(err)
+	}
+	deadDir := filepath.Join(tempDir, "job_dead")
+	if err := os.Mkdir(deadDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	record := WorkDirInfo{Running: true, PID: cmd.Process.Pid, Host: workDirHost}
+	if err := writeWorkDirRecord(deadDir, record); err != nil {
+		t.Fatal(err)
+	}
+
+	// A directory recorded by a previous slave with the
+	// same process ID as this one.
+	restartDir := filepath.Join(tempDir, "job_restart")
+	if err := os.Mkdir(restartDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	record = WorkDirInfo{Running: true, PID: os.Getpid(), Host: workDirHost}
+	if err := writeWorkDirRecord(restartDir, record); err != nil {
+		t.Fatal(err)
+	}
+
+	// A directory of a slave on another host, whose process
+	// ID means nothing here.
+	otherDir := filepath.Join(tempDir, "job_other")
+	if err := os.Mkdir(otherDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	record = WorkDirInfo{Running: true, PID: cmd.Process.Pid, Host: "other/host"}
+	if err := writeWorkDirRecord(otherDir, record); err != nil {
+		t.Fatal(err)
+	}
+
+	if dirs, err := store.List(); err != nil {
+		t.Fatal(err)
+	} else if len(dirs) != 0 {
+		t.Errorf("running directories should not be listed: %v", dirs)
+	}
+	if err := store.Prune(); err != nil {
+		t.Fatal(err)
+	}
+	listing, err := ioutil.ReadDir(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, info := range listing {
+		names = append(names, info.Name())
+	}
+	base := filepath.Base(running)
+	expected := []string{base, base + workDirInfoExt, "job_new", "job_other",
+		"job_other" + workDirInfoExt}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v but got %v", expected, names)
+	}
+
+	if kept, err := store.Finish(running, JobInfo{}, false); err != nil || kept {
+		t.Errorf("unexpected Finish result: %v %v", kept, err)
+	}
+	if _, err := os.Stat(running + workDirInfoExt); !os.IsNotExist(err) {
+		t.Error("record was not removed")
+	}
+}
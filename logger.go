@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"log"
+)
+
+type logLevel int
+
+const (
+	logLevelDebug logLevel = iota
+	logLevelInfo
+	logLevelError
+)
+
+var currentLogLevel = logLevelInfo
+
+// setLogLevel sets the minimum level of messages which
+// are logged.
+func setLogLevel(name string) error {
+	switch name {
+	case "debug":
+		currentLogLevel = logLevelDebug
+	case "info":
+		currentLogLevel = logLevelInfo
+	case "error":
+		currentLogLevel = logLevelError
+	default:
+		return fmt.Errorf("unknown log level: %s", name)
+	}
+	return nil
+}
+
+func logDebug(args ...interface{}) {
+	logAtLevel(logLevelDebug, args)
+}
+
+func logInfo(args ...interface{}) {
+	logAtLevel(logLevelInfo, args)
+}
+
+func logError(args ...interface{}) {
+	logAtLevel(logLevelError, args)
+}
+
+func logAtLevel(level logLevel, args []interface{}) {
+	if level >= currentLogLevel {
+		log.Println(args...)
+	}
+}
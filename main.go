@@ -1,9 +1,9 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
-	"strconv"
 )
 
 func main() {
@@ -13,46 +13,47 @@ func main() {
 
 	switch os.Args[1] {
 	case "master":
-		if len(os.Args) != 7 {
-			dieUsage()
-		}
-		slavePort, err := strconv.Atoi(os.Args[2])
-		if err != nil {
-			fmt.Fprintln(os.Stderr, "Invalid slave port:", os.Args[2])
-			os.Exit(1)
-		}
-		adminPort, err := strconv.Atoi(os.Args[3])
+		config, err := ParseMasterConfig(os.Args[2:])
 		if err != nil {
-			fmt.Fprintln(os.Stderr, "Invalid admin port:", os.Args[3])
-			os.Exit(1)
+			dieConfig(err)
 		}
-		slavePass := os.Args[4]
-		adminPass := os.Args[5]
-		configPath := os.Args[6]
-		MasterMain(slavePort, adminPort, slavePass, adminPass, configPath)
+		MasterMain(config)
 	case "slave":
-		if len(os.Args) != 5 {
-			dieUsage()
+		config, err := ParseSlaveConfig(os.Args[2:])
+		if err != nil {
+			dieConfig(err)
 		}
-		host := os.Args[2]
-		port, err := strconv.Atoi(os.Args[3])
+		SlaveMain(config)
+	case "rotate-keys":
+		config, err := ParseMasterConfig(os.Args[2:])
 		if err != nil {
-			fmt.Fprintln(os.Stderr, "Invalid master port:", os.Args[2])
+			dieConfig(err)
+		}
+		if err := RotateSessionKeys(config.DataDir); err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to rotate keys:", err)
 			os.Exit(1)
 		}
-		password := os.Args[4]
-		SlaveMain(host, port, password)
 	default:
 		dieUsage()
 	}
 }
 
+func dieConfig(err error) {
+	if err != flag.ErrHelp {
+		fmt.Fprintln(os.Stderr, "Invalid configuration:", err)
+	}
+	os.Exit(1)
+}
+
 func dieUsage() {
-	fmt.Fprintln(os.Stderr, "Usage: jobempire master <slave_port> <admin_port> <slave_pass>")
-	fmt.Fprintln(os.Stderr, "                        <admin_pass> <jobs.json>")
-	fmt.Fprintln(os.Stderr, "       jobempire slave <host> <port> <password>")
-	fmt.Fprintln(os.Stderr, "\nOptional environment variables:")
-	fmt.Fprintln(os.Stderr, " JOB_MEM_LIMIT   maximum memory in MiB (for slave)")
+	fmt.Fprintln(os.Stderr, "Usage: jobempire master [flags]")
+	fmt.Fprintln(os.Stderr, "       jobempire slave [flags]")
+	fmt.Fprintln(os.Stderr, "       jobempire rotate-keys [master flags]")
+	fmt.Fprintln(os.Stderr, "\nRun a command with -h to see its flags.")
+	fmt.Fprintln(os.Stderr, "Every command accepts a JSON file of settings via -config.")
+	fmt.Fprintln(os.Stderr, "\nEnvironment variables override the config file, and flags")
+	fmt.Fprintln(os.Stderr, "override environment variables. Each flag has a corresponding")
+	fmt.Fprintln(os.Stderr, "variable, e.g. -max-mem may be set with JOBEMPIRE_MAX_MEM.")
 	fmt.Fprintln(os.Stderr)
 	os.Exit(1)
 }
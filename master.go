@@ -2,56 +2,150 @@ package main
 
 import (
 	"bytes"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"html/template"
-	"io/ioutil"
-	"log"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/unixpickle/jobempire/jobadmin"
 	"github.com/unixpickle/jobempire/jobproto"
 )
 
-func MasterMain(slavePort, adminPort int, slavePass, adminPass string, jobFile string) {
-	jobs, err := readJobs(jobFile)
+const (
+	jobsPollInterval      = time.Second * 2
+	artifactPruneInterval = time.Hour
+	taskLogPruneInterval  = time.Hour
+)
+
+func MasterMain(config *MasterConfig) {
+	if err := setLogLevel(config.LogLevel); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	policy, err := jobadmin.ParseSchedulePolicy(config.SchedulePolicy)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	slavePass, err := readPassFile(config.SlavePassFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to read slave password:", err)
+		os.Exit(1)
+	}
+	adminPass, err := readPassFile(config.AdminPassFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to read admin password:", err)
+		os.Exit(1)
+	}
+
+	if err := os.MkdirAll(config.DataDir, 0700); err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to create data directory:", err)
+		os.Exit(1)
+	}
+
+	jobStore := &JobStore{
+		Path:       config.JobsFile,
+		HistoryDir: filepath.Join(config.DataDir, "job_history"),
+		MaxBackups: config.JobHistory,
+	}
+	jobs, err := jobStore.Load()
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "Failed to read jobs:", err)
 		os.Exit(1)
 	}
 
-	slaveListener, err := net.Listen("tcp", ":"+strconv.Itoa(slavePort))
+	jobproto.BuildCache, err = jobproto.NewFileCache(config.BuildCacheDir,
+		int64(config.BuildCacheSize)<<20, 0)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to create build cache:", err)
+		os.Exit(1)
+	}
+
+	jobproto.Artifacts, err = jobproto.NewArtifactStore(config.ArtifactDir,
+		int64(config.ArtifactSize)<<20, time.Duration(config.ArtifactHours)*time.Hour)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to create artifact store:", err)
+		os.Exit(1)
+	}
+	go pruneArtifacts()
+
+	jobproto.Blobs, err = jobproto.NewBlobStore(config.BlobDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to create blob store:", err)
+		os.Exit(1)
+	}
+	jobproto.PeerUploads = config.PeerUploads
+
+	jobproto.TransferCompression, err = jobproto.ParseCompression(config.Compression)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if config.Bandwidth > 0 {
+		jobproto.Bandwidth = jobproto.NewRateLimiter(int64(config.Bandwidth) << 10)
+	}
+	jobproto.SlaveBandwidth = int64(config.SlaveBandwidth) << 10
+
+	taskLogs, err := jobadmin.LoadTaskLogArchive(config.TaskLogDir,
+		time.Duration(config.TaskLogHours)*time.Hour)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to load task logs:", err)
+		os.Exit(1)
+	}
+	go pruneTaskLogs(taskLogs)
+	jobadmin.MasterHistory = config.SlaveHistory
+	jobadmin.TaskLogs = jobadmin.TaskLogConfig{
+		SpillDir:   config.TaskLogDir,
+		MemorySize: int64(config.TaskLogMemory) << 10,
+		MaxSize:    int64(config.TaskLogMaxSize) << 20,
+	}
+
+	auth, err := NewMasterAuth(adminPass, config.DataDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to load credentials:", err)
+		os.Exit(1)
+	}
+
+	slaveListener, err := listenConfig(config, config.SlaveBind, config.SlavePort)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "Failed to listen for slaves:", err)
 		os.Exit(1)
 	}
 
-	adminListener, err := net.Listen("tcp", ":"+strconv.Itoa(adminPort))
+	adminListener, err := listenConfig(config, config.AdminBind, config.AdminPort)
 	if err != nil {
 		slaveListener.Close()
 		fmt.Fprintln(os.Stderr, "Failed to listen for admins:", err)
 		os.Exit(1)
 	}
 
-	log.Println("Listening on ports", slavePort, "and", adminPort)
+	logInfo("Listening on ports", config.SlavePort, "and", config.AdminPort)
 
 	defer slaveListener.Close()
 	defer adminListener.Close()
 
 	handler := &MasterHandler{
-		Scheduler: jobadmin.NewScheduler(),
-		Auth:      NewMasterAuth(adminPass),
+		Scheduler: jobadmin.NewSchedulerPolicy(policy),
+		Auth:      auth,
 		Templates: parseTemplates(),
-		JobsPath:  jobFile,
+		JobStore:  jobStore,
+		TaskLogs:  taskLogs,
+	}
+	if err := handler.Scheduler.SetJobs(jobs); err != nil {
+		fmt.Fprintln(os.Stderr, "Invalid jobs:", err)
+		os.Exit(1)
 	}
-	handler.Scheduler.SetJobs(jobs)
 
 	go http.Serve(adminListener, handler)
 	go func() {
@@ -65,17 +159,30 @@ func MasterMain(slavePort, adminPort int, slavePass, adminPass string, jobFile s
 				return
 			}
 			go func() {
+				logDebug("Slave", conn.RemoteAddr(), "connected.")
 				master, err := jobproto.NewMasterConnAuth(conn, slavePass)
 				if err != nil {
-					log.Println("Slave", conn.RemoteAddr(), "failed to authenticate.")
+					logError("Slave", conn.RemoteAddr(), "failed to authenticate.")
 					return
 				}
-				log.Println("Slave", conn.RemoteAddr(), "successfully joined.")
+				logInfo("Slave", conn.RemoteAddr(), "successfully joined.")
 				handler.Scheduler.AddMaster(jobadmin.RunLiveMaster(master), false)
 			}()
 		}
 	}()
 
+	if config.WatchJobs {
+		go handler.WatchJobs(jobsPollInterval)
+	}
+	hupChan := make(chan os.Signal, 1)
+	signal.Notify(hupChan, syscall.SIGHUP)
+	go func() {
+		for range hupChan {
+			logInfo("Received SIGHUP; reloading jobs.")
+			handler.ReloadJobs()
+		}
+	}()
+
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 	<-sigChan
@@ -85,19 +192,18 @@ func MasterMain(slavePort, adminPort int, slavePass, adminPass string, jobFile s
 	handler.Scheduler.Wait(nil)
 }
 
-func readJobs(file string) ([]*jobadmin.Job, error) {
-	if _, err := os.Stat(file); os.IsNotExist(err) {
-		return nil, nil
+// listenConfig creates a listener which uses TLS if the
+// config specifies a certificate.
+func listenConfig(config *MasterConfig, host string, port int) (net.Listener, error) {
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	if config.TLSCert == "" {
+		return net.Listen("tcp", addr)
 	}
-	contents, err := ioutil.ReadFile(file)
+	cert, err := tls.LoadX509KeyPair(config.TLSCert, config.TLSKey)
 	if err != nil {
 		return nil, err
 	}
-	var jobs []*jobadmin.Job
-	if err := json.Unmarshal(contents, &jobs); err != nil {
-		return nil, err
-	}
-	return jobs, nil
+	return tls.Listen("tcp", addr, &tls.Config{Certificates: []tls.Certificate{cert}})
 }
 
 func parseTemplates() *template.Template {
@@ -122,10 +228,37 @@ func parseTemplates() *template.Template {
 		"reverse":      templateReverse,
 		"jsonPass":     templateJSONPass,
 		"reverseIndex": templateReverseIndex,
+		"join":         templateJoin,
+		"duration":     templateDuration,
+		"bytes":        templateBytes,
+		"relTime":      templateRelTime,
+		"percent":      templatePercent,
+		"octal":        templateOctal,
 	})
 	return template.Must(res.Parse(body.String()))
 }
 
+// pruneArtifacts periodically deletes old artifacts.
+func pruneArtifacts() {
+	for {
+		if err := jobproto.Artifacts.Prune(); err != nil {
+			logError("Failed to prune artifacts:", err)
+		}
+		time.Sleep(artifactPruneInterval)
+	}
+}
+
+// pruneTaskLogs periodically deletes old task logs from
+// previous runs of the master.
+func pruneTaskLogs(a *jobadmin.TaskLogArchive) {
+	for {
+		time.Sleep(taskLogPruneInterval)
+		if err := a.Prune(); err != nil {
+			logError("Failed to prune task logs:", err)
+		}
+	}
+}
+
 type masterAutoPair struct {
 	Master *jobadmin.LiveMaster
 	Auto   bool
@@ -179,3 +312,56 @@ func templateJSONPass(x interface{}) (map[string]interface{}, error) {
 func templateReverseIndex(i, count int) int {
 	return count - (i + 1)
 }
+
+func templateJoin(x interface{}, sep string) (string, error) {
+	val := reflect.ValueOf(x)
+	if x == nil {
+		return "", nil
+	} else if val.Kind() != reflect.Slice {
+		return "", fmt.Errorf("join: expected slice but got %T", x)
+	}
+	parts := make([]string, val.Len())
+	for i := range parts {
+		parts[i] = fmt.Sprint(val.Index(i).Interface())
+	}
+	return strings.Join(parts, sep), nil
+}
+
+func templateDuration(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
+
+func templateRelTime(start, t time.Time) string {
+	d := t.Sub(start)
+	sign := "+"
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	return fmt.Sprintf("%s%.3fs", sign, d.Seconds())
+}
+
+func templatePercent(fraction float64) string {
+	return fmt.Sprintf("%.1f%%", fraction*100)
+}
+
+// templateOctal formats a JSON number, such as a file
+// mode, in octal.
+func templateOctal(x float64) string {
+	return fmt.Sprintf("%04o", int64(x))
+}
+
+func templateBytes(n int64) string {
+	units := []string{"bytes", "KiB", "MiB", "GiB"}
+	size := float64(n)
+	for _, unit := range units[:len(units)-1] {
+		if size < 1024 {
+			if unit == "bytes" {
+				return fmt.Sprintf("%d bytes", n)
+			}
+			return fmt.Sprintf("%.1f %s", size, unit)
+		}
+		size /= 1024
+	}
+	return fmt.Sprintf("%.1f %s", size, units[len(units)-1])
+}
@@ -1,32 +1,100 @@
 package main
 
 import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gorilla/securecookie"
 	"github.com/gorilla/sessions"
 )
 
+const (
+	sessionKeysFile = "session_keys.json"
+	apiTokensFile   = "api_tokens.json"
+
+	sessionHashKeySize  = 32
+	sessionBlockKeySize = 16
+	apiTokenSize        = 32
+)
+
+// sessionKeyPair is one generation of cookie keys.
+type sessionKeyPair struct {
+	HashKey  []byte
+	BlockKey []byte
+}
+
+// An APIToken is a long-lived credential which can be
+// passed as an HTTP bearer token instead of logging in.
+// Only a hash of the token itself is stored.
+type APIToken struct {
+	ID      string
+	Name    string
+	Hash    string
+	Created time.Time
+}
+
 // A MasterAuth manages admin authentication.
 type MasterAuth struct {
 	adminPass string
-	cookies   *sessions.CookieStore
+	dataDir   string
+
+	lock    sync.RWMutex
+	cookies *sessions.CookieStore
+	tokens  []*APIToken
 }
 
 // NewMasterAuth creates a MasterAuth with an admin
 // password.
-func NewMasterAuth(pass string) *MasterAuth {
-	return &MasterAuth{
-		adminPass: pass,
-		cookies: sessions.NewCookieStore(securecookie.GenerateRandomKey(16),
-			securecookie.GenerateRandomKey(16)),
+//
+// Session keys and API tokens are persisted in dataDir so
+// that restarting the master does not log anybody out.
+// Missing keys are generated and saved automatically.
+func NewMasterAuth(pass, dataDir string) (*MasterAuth, error) {
+	res := &MasterAuth{adminPass: pass, dataDir: dataDir}
+	keys, err := readSessionKeys(dataDir)
+	if err != nil {
+		return nil, err
 	}
+	if len(keys) == 0 {
+		keys, err = rotateSessionKeys(dataDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res.setKeys(keys)
+
+	tokenData, err := ioutil.ReadFile(filepath.Join(dataDir, apiTokensFile))
+	if err == nil {
+		if err := json.Unmarshal(tokenData, &res.tokens); err != nil {
+			return nil, errors.New("read API tokens: " + err.Error())
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return res, nil
 }
 
 // IsAuth returns whether or not the request is from an
 // authenticated source.
 func (m *MasterAuth) IsAuth(r *http.Request) bool {
-	s, _ := m.cookies.Get(r, "sessid")
+	if token := bearerToken(r); token != "" {
+		return m.checkToken(token)
+	}
+	m.lock.RLock()
+	cookies := m.cookies
+	m.lock.RUnlock()
+	s, _ := cookies.Get(r, "sessid")
 	val, _ := s.Values["authenticated"].(bool)
 	return val
 }
@@ -38,7 +106,189 @@ func (m *MasterAuth) CheckPass(password string) bool {
 
 // Auth authenticates the remote HTTP client.
 func (m *MasterAuth) Auth(w http.ResponseWriter, r *http.Request) {
-	s, _ := m.cookies.Get(r, "sessid")
+	m.lock.RLock()
+	cookies := m.cookies
+	m.lock.RUnlock()
+	s, _ := cookies.Get(r, "sessid")
 	s.Values["authenticated"] = true
 	s.Save(r, w)
 }
+
+// RotateKeys generates a new pair of session keys.
+// Sessions signed with the previous keys remain valid
+// until the next rotation.
+func (m *MasterAuth) RotateKeys() error {
+	keys, err := rotateSessionKeys(m.dataDir)
+	if err != nil {
+		return err
+	}
+	m.setKeys(keys)
+	return nil
+}
+
+// KeysModTime returns the time when the session keys
+// were last rotated.
+func (m *MasterAuth) KeysModTime() time.Time {
+	info, err := os.Stat(filepath.Join(m.dataDir, sessionKeysFile))
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// Tokens returns the current API tokens.
+// The caller should not modify the result.
+func (m *MasterAuth) Tokens() []*APIToken {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.tokens
+}
+
+// CreateToken creates and saves a new API token.
+// It returns the secret token string, which is not
+// stored anywhere and cannot be recovered later.
+func (m *MasterAuth) CreateToken(name string) (string, error) {
+	secret := securecookie.GenerateRandomKey(apiTokenSize)
+	id := securecookie.GenerateRandomKey(8)
+	if secret == nil || id == nil {
+		return "", errors.New("failed to generate token")
+	}
+	token := hex.EncodeToString(secret)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	newTokens := append([]*APIToken{}, m.tokens...)
+	newTokens = append(newTokens, &APIToken{
+		ID:      hex.EncodeToString(id),
+		Name:    name,
+		Hash:    hashToken(token),
+		Created: time.Now(),
+	})
+	if err := m.saveTokens(newTokens); err != nil {
+		return "", err
+	}
+	m.tokens = newTokens
+	return token, nil
+}
+
+// RevokeToken deletes the API token with the given ID.
+func (m *MasterAuth) RevokeToken(id string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var newTokens []*APIToken
+	for _, t := range m.tokens {
+		if t.ID != id {
+			newTokens = append(newTokens, t)
+		}
+	}
+	if len(newTokens) == len(m.tokens) {
+		return errors.New("token ID not found: " + id)
+	}
+	if err := m.saveTokens(newTokens); err != nil {
+		return err
+	}
+	m.tokens = newTokens
+	return nil
+}
+
+func (m *MasterAuth) setKeys(keys []sessionKeyPair) {
+	var pairs [][]byte
+	for _, k := range keys {
+		pairs = append(pairs, k.HashKey, k.BlockKey)
+	}
+	m.lock.Lock()
+	m.cookies = sessions.NewCookieStore(pairs...)
+	m.lock.Unlock()
+}
+
+func (m *MasterAuth) checkToken(token string) bool {
+	hash := hashToken(token)
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	for _, t := range m.tokens {
+		if subtle.ConstantTimeCompare([]byte(t.Hash), []byte(hash)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MasterAuth) saveTokens(tokens []*APIToken) error {
+	data, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+	return writeSecretFile(filepath.Join(m.dataDir, apiTokensFile), data)
+}
+
+// RotateSessionKeys generates new session keys in the
+// given data directory, keeping the most recent previous
+// keys so that existing sessions remain valid.
+//
+// A running master only notices keys rotated with this
+// function once it is restarted.
+func RotateSessionKeys(dataDir string) error {
+	_, err := rotateSessionKeys(dataDir)
+	return err
+}
+
+func rotateSessionKeys(dataDir string) ([]sessionKeyPair, error) {
+	oldKeys, err := readSessionKeys(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	newPair := sessionKeyPair{
+		HashKey:  securecookie.GenerateRandomKey(sessionHashKeySize),
+		BlockKey: securecookie.GenerateRandomKey(sessionBlockKeySize),
+	}
+	if newPair.HashKey == nil || newPair.BlockKey == nil {
+		return nil, errors.New("failed to generate session keys")
+	}
+	keys := []sessionKeyPair{newPair}
+	if len(oldKeys) > 0 {
+		keys = append(keys, oldKeys[0])
+	}
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeSecretFile(filepath.Join(dataDir, sessionKeysFile), data); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func readSessionKeys(dataDir string) ([]sessionKeyPair, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dataDir, sessionKeysFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var keys []sessionKeyPair
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, errors.New("read session keys: " + err.Error())
+	}
+	return keys, nil
+}
+
+func writeSecretFile(path string, data []byte) error {
+	tempPath := path + ".tmp"
+	if err := ioutil.WriteFile(tempPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tempPath, path)
+}
+
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(header[len("Bearer "):])
+}
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMasterAuthSessions(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobempire_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	auth, err := NewMasterAuth("pass", tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(tempDir, sessionKeysFile)); err != nil {
+		t.Fatal("session keys were not saved:", err)
+	}
+	if auth.IsAuth(httptest.NewRequest("GET", "/", nil)) {
+		t.Error("request without a cookie should not be authenticated")
+	}
+	cookie := loginCookie(auth)
+
+	// Restarting the master keeps sessions valid.
+	auth, err = NewMasterAuth("pass", tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !auth.IsAuth(cookieRequest(cookie)) {
+		t.Error("session did not survive a restart")
+	}
+
+	// Rotation keeps the previous keys, but no older ones.
+	if err := auth.RotateKeys(); err != nil {
+		t.Fatal(err)
+	}
+	if !auth.IsAuth(cookieRequest(cookie)) {
+		t.Error("session did not survive one rotation")
+	}
+	newCookie := loginCookie(auth)
+	if err := RotateSessionKeys(tempDir); err != nil {
+		t.Fatal(err)
+	}
+	auth, err = NewMasterAuth("pass", tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth.IsAuth(cookieRequest(cookie)) {
+		t.Error("session should expire after two rotations")
+	}
+	if !auth.IsAuth(cookieRequest(newCookie)) {
+		t.Error("session did not survive rotation by another process")
+	}
+}
+
+func TestMasterAuthTokens(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobempire_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	auth, err := NewMasterAuth("pass", tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := auth.CreateToken("ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(tempDir, apiTokensFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), token) {
+		t.Error("token was stored in plain text")
+	} else if !strings.Contains(string(data), hashToken(token)) {
+		t.Error("token hash was not stored")
+	}
+
+	auth, err = NewMasterAuth("pass", tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !auth.IsAuth(bearerRequest(token)) {
+		t.Error("token was not accepted after a restart")
+	}
+	if auth.IsAuth(bearerRequest(token + "0")) {
+		t.Error("wrong token was accepted")
+	}
+
+	tokens := auth.Tokens()
+	if len(tokens) != 1 || tokens[0].Name != "ci" {
+		t.Fatalf("unexpected tokens: %v", tokens)
+	}
+	if err := auth.RevokeToken(tokens[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if auth.IsAuth(bearerRequest(token)) {
+		t.Error("revoked token was accepted")
+	}
+	if err := auth.RevokeToken(tokens[0].ID); err == nil {
+		t.Error("expected error for unknown token")
+	}
+	auth, err = NewMasterAuth("pass", tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth.IsAuth(bearerRequest(token)) {
+		t.Error("revoked token was accepted after a restart")
+	}
+}
+
+// loginCookie authenticates a new session and returns its
+// cookie.
+func loginCookie(auth *MasterAuth) *http.Cookie {
+	rec := httptest.NewRecorder()
+	auth.Auth(rec, httptest.NewRequest("POST", "/login", nil))
+	return rec.Result().Cookies()[0]
+}
+
+func cookieRequest(cookie *http.Cookie) *http.Request {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.AddCookie(cookie)
+	return r
+}
+
+func bearerRequest(token string) *http.Request {
+	r := httptest.NewRequest("GET", "/", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
@@ -12,21 +12,39 @@ import (
 	"math/rand"
 	"mime"
 	"net/http"
+	"os"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/unixpickle/jobempire/jobadmin"
+	"github.com/unixpickle/jobempire/jobproto"
 )
 
+const maxJobFileSize = 1 << 24
+
+// maxPageLogEntries is the number of log entries shown on
+// a live task page. Longer logs can be downloaded.
+const maxPageLogEntries = 5000
+
 type MasterHandler struct {
 	Scheduler *jobadmin.Scheduler
 	Auth      *MasterAuth
 	Templates *template.Template
 
+	// TaskLogs holds the task logs from before the master
+	// was restarted. It may be nil.
+	TaskLogs *jobadmin.TaskLogArchive
+
 	JobsLock sync.Mutex
-	JobsPath string
+	JobStore *JobStore
+
+	reloadLock  sync.RWMutex
+	reloadError error
+	reloadTime  time.Time
 }
 
 func (m *MasterHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -54,13 +72,29 @@ func (m *MasterHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if !m.Auth.IsAuth(r) {
-		http.Redirect(w, r, "/login", http.StatusSeeOther)
+		if bearerToken(r) != "" {
+			m.serveError(w, "invalid API token", http.StatusUnauthorized)
+		} else {
+			http.Redirect(w, r, "/login", http.StatusSeeOther)
+		}
 		return
 	}
 
 	switch cleanPath {
 	case "/jobs":
 		m.ServeJobsPage(w, r)
+	case "/jobs/history":
+		m.ServeJobHistoryPage(w, r)
+	case "/jobs/rollback":
+		m.ServeRollback(w, r)
+	case "/jobs/reload":
+		m.ServeReload(w, r)
+	case "/jobs/export":
+		m.ServeExport(w, r)
+	case "/jobs/import":
+		m.ServeImport(w, r)
+	case "/jobs/apply":
+		m.ServeApply(w, r)
 	case "/slaves":
 		m.ServeSlavesPage(w, r)
 	case "/addjob":
@@ -75,6 +109,26 @@ func (m *MasterHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		m.ServeLiveJobPage(w, r)
 	case "/task":
 		m.ServeLiveTaskPage(w, r)
+	case "/task/log":
+		m.ServeTaskLog(w, r)
+	case "/logs":
+		m.ServeLogSearchPage(w, r)
+	case "/artifacts":
+		m.ServeArtifacts(w, r)
+	case "/artifact":
+		m.ServeArtifact(w, r)
+	case "/workdirs":
+		m.ServeWorkDirsPage(w, r)
+	case "/workdir":
+		m.ServeWorkDirPage(w, r)
+	case "/workdir/file":
+		m.ServeWorkFile(w, r)
+	case "/blobs":
+		m.ServeBlobsPage(w, r)
+	case "/blobs/add":
+		m.ServeAddBlob(w, r)
+	case "/blobs/delete":
+		m.ServeDeleteBlob(w, r)
 	case "/savejob":
 		m.ServeSaveJob(w, r)
 	case "/deletejob":
@@ -87,6 +141,14 @@ func (m *MasterHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		m.ServeStopJob(w, r)
 	case "/launch":
 		m.ServeLaunch(w, r)
+	case "/settings":
+		m.ServeSettingsPage(w, r)
+	case "/rotatekeys":
+		m.ServeRotateKeys(w, r)
+	case "/addtoken":
+		m.ServeAddToken(w, r)
+	case "/revoketoken":
+		m.ServeRevokeToken(w, r)
 	default:
 		m.serveNotFound(w, r)
 	}
@@ -104,7 +166,172 @@ func (m *MasterHandler) ServeLoginPage(w http.ResponseWriter, r *http.Request) {
 }
 
 func (m *MasterHandler) ServeJobsPage(w http.ResponseWriter, r *http.Request) {
-	m.serveTemplate(w, "jobs", m.Scheduler)
+	jobs, err := m.Scheduler.Jobs()
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	m.reloadLock.RLock()
+	pageObj := map[string]interface{}{
+		"Jobs":        jobs,
+		"ReloadError": m.reloadError,
+		"ReloadTime":  m.reloadTime,
+	}
+	m.reloadLock.RUnlock()
+	m.serveTemplate(w, "jobs", pageObj)
+}
+
+func (m *MasterHandler) ServeReload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		m.serveError(w, "reload requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+	m.ReloadJobs()
+	http.Redirect(w, r, "/jobs", http.StatusSeeOther)
+}
+
+func (m *MasterHandler) ServeJobHistoryPage(w http.ResponseWriter, r *http.Request) {
+	versions, err := m.JobStore.Versions()
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Each entry shows the changes made by one save, so the
+	// current file is diffed against the newest backup, and
+	// so on down to the oldest backup.
+	ids := []string{""}
+	for _, v := range versions {
+		ids = append(ids, v.ID)
+	}
+	contents := make([]string, len(ids)+1)
+	for i, id := range ids {
+		data, err := m.JobStore.ReadVersion(id)
+		if err != nil {
+			m.serveError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		contents[i] = prettyJSON(data)
+	}
+	var entries []map[string]interface{}
+	for i, id := range ids {
+		entry := map[string]interface{}{
+			"ID":   id,
+			"Diff": lineDiff(contents[i+1], contents[i]),
+		}
+		if i > 0 {
+			entry["Time"] = versions[i-1].Time
+		}
+		entries = append(entries, entry)
+	}
+	m.serveTemplate(w, "jobHistory", entries)
+}
+
+func (m *MasterHandler) ServeRollback(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		m.serveError(w, "rollback requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := m.rollbackJobs(r.FormValue("version")); err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+	} else {
+		http.Redirect(w, r, "/jobs", http.StatusSeeOther)
+	}
+}
+
+func (m *MasterHandler) ServeExport(w http.ResponseWriter, r *http.Request) {
+	format, err := parseJobFormat(r.FormValue("format"), "")
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	jobs, err := m.Scheduler.Jobs()
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	filename := "jobs." + format
+	if id := r.FormValue("id"); id != "" {
+		var found []*jobadmin.Job
+		for _, j := range jobs {
+			if j.ID == id {
+				found = append(found, j)
+			}
+		}
+		if len(found) == 0 {
+			m.serveError(w, "job ID not found: "+id, http.StatusBadRequest)
+			return
+		}
+		jobs = found
+		if jobs[0].Key != "" {
+			filename = jobs[0].Key + "." + format
+		}
+	}
+	data, err := encodeJobFile(jobs, format)
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if format == "json" {
+		w.Header().Set("Content-Type", "application/json")
+	} else {
+		w.Header().Set("Content-Type", "application/x-yaml")
+	}
+	w.Header().Set("Content-Disposition", "attachment; filename="+strconv.Quote(filename))
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Write(data)
+}
+
+func (m *MasterHandler) ServeImport(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "GET" {
+		m.serveTemplate(w, "jobImport", nil)
+		return
+	}
+	jobs, err := m.readJobFile(r)
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := m.importJobs(jobs); err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+	} else if isAPIRequest(r) {
+		m.serveJSON(w, jobs)
+	} else {
+		http.Redirect(w, r, "/jobs", http.StatusSeeOther)
+	}
+}
+
+func (m *MasterHandler) ServeApply(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		m.serveError(w, "apply requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+	jobs, err := m.readJobFile(r)
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	dryRun := r.FormValue("dryrun") != ""
+	changes, err := m.applyJobs(jobs, dryRun)
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+	} else if isAPIRequest(r) {
+		m.serveJSON(w, changes)
+	} else if !dryRun {
+		http.Redirect(w, r, "/jobs", http.StatusSeeOther)
+	} else {
+		// Re-encode the parsed jobs so that the confirmation
+		// form does not depend on the upload.
+		encoded, err := encodeJobFile(jobs, "json")
+		if err != nil {
+			m.serveError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		m.serveTemplate(w, "jobApply", map[string]interface{}{
+			"Changes": changes,
+			"Jobs":    string(encoded),
+		})
+	}
 }
 
 func (m *MasterHandler) ServeSlavesPage(w http.ResponseWriter, r *http.Request) {
@@ -160,32 +387,478 @@ func (m *MasterHandler) ServeLiveJobPage(w http.ResponseWriter, r *http.Request)
 		m.serveError(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	artifacts, err := jobproto.Artifacts.List(job.RunID())
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// The slave may have pruned the directory since, in
+	// which case browsing it fails.
+	keepDir := job.Job().KeepDir
+	keptDir := !job.Running() && (keepDir == jobproto.KeepDirAlways ||
+		(keepDir == jobproto.KeepDirOnFailure && job.Error() != nil))
 	pageObj := map[string]interface{}{
-		"SlaveID":  r.FormValue("slave"),
-		"JobIndex": r.FormValue("idx"),
-		"LiveJob":  job,
+		"SlaveID":   r.FormValue("slave"),
+		"JobIndex":  r.FormValue("idx"),
+		"LiveJob":   job,
+		"Artifacts": artifacts,
+		"KeptDir":   keptDir,
 	}
 	m.serveTemplate(w, "liveJob", pageObj)
 }
 
+// ServeArtifacts serves a JSON list of the artifacts from
+// a job run.
+func (m *MasterHandler) ServeArtifacts(w http.ResponseWriter, r *http.Request) {
+	artifacts, err := jobproto.Artifacts.List(r.FormValue("run"))
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if artifacts == nil {
+		artifacts = []jobproto.ArtifactInfo{}
+	}
+	m.serveJSON(w, artifacts)
+}
+
+// ServeArtifact serves one artifact as a download.
+func (m *MasterHandler) ServeArtifact(w http.ResponseWriter, r *http.Request) {
+	f, err := jobproto.Artifacts.Open(r.FormValue("run"), r.FormValue("path"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			m.serveError(w, "artifact not found", http.StatusNotFound)
+		} else {
+			m.serveError(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	filename := path.Base(r.FormValue("path"))
+	w.Header().Set("Content-Disposition", "attachment; filename="+strconv.Quote(filename))
+	http.ServeContent(w, r, filename, info.ModTime(), f)
+}
+
+// ServeWorkDirsPage lists the job directories which a
+// slave has kept.
+func (m *MasterHandler) ServeWorkDirsPage(w http.ResponseWriter, r *http.Request) {
+	master, _, err := m.slaveForID(r.FormValue("slave"))
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	dirs, err := master.WorkDirs()
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// Show the most recent directories first.
+	sort.SliceStable(dirs, func(i, j int) bool {
+		return dirs[i].EndTime.After(dirs[j].EndTime)
+	})
+	pageObj := map[string]interface{}{
+		"SlaveID": r.FormValue("slave"),
+		"Dirs":    dirs,
+	}
+	m.serveTemplate(w, "workDirs", pageObj)
+}
+
+// A workFileEntry is a file shown on a job directory page.
+type workFileEntry struct {
+	jobproto.WorkFileInfo
+
+	// Path is the file's path in the job directory.
+	Path string
+}
+
+// ServeWorkDirPage lists a directory inside a job
+// directory which a slave has kept.
+func (m *MasterHandler) ServeWorkDirPage(w http.ResponseWriter, r *http.Request) {
+	master, _, err := m.slaveForID(r.FormValue("slave"))
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	runID := r.FormValue("run")
+	dirPath := r.FormValue("path")
+	listing, err := master.ListWorkDir(runID, dirPath)
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	entries := make([]workFileEntry, len(listing))
+	for i, info := range listing {
+		entries[i] = workFileEntry{WorkFileInfo: info, Path: path.Join(dirPath, info.Name)}
+	}
+	parent := path.Dir(dirPath)
+	if parent == "." {
+		parent = ""
+	}
+	pageObj := map[string]interface{}{
+		"SlaveID": r.FormValue("slave"),
+		"RunID":   runID,
+		"Path":    dirPath,
+		"Parent":  parent,
+		"Entries": entries,
+	}
+	m.serveTemplate(w, "workDir", pageObj)
+}
+
+// ServeWorkFile serves a file from a job directory which
+// a slave has kept as a download.
+func (m *MasterHandler) ServeWorkFile(w http.ResponseWriter, r *http.Request) {
+	master, _, err := m.slaveForID(r.FormValue("slave"))
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filename := path.Base(r.FormValue("path"))
+	dw := &downloadWriter{w: w, filename: filename}
+	err = master.DownloadWorkFile(r.FormValue("run"), r.FormValue("path"), dw)
+	if err != nil {
+		if !dw.started {
+			m.serveError(w, err.Error(), http.StatusBadRequest)
+		}
+		return
+	}
+	// Empty files are never written.
+	dw.start()
+}
+
+// downloadWriter sets the headers for a download once the
+// first data is written, so that errors can still be
+// reported before then.
+type downloadWriter struct {
+	w        http.ResponseWriter
+	filename string
+	started  bool
+}
+
+func (d *downloadWriter) Write(data []byte) (int, error) {
+	d.start()
+	return d.w.Write(data)
+}
+
+func (d *downloadWriter) start() {
+	if !d.started {
+		d.started = true
+		d.w.Header().Set("Content-Type", "application/octet-stream")
+		d.w.Header().Set("Content-Disposition", "attachment; filename="+strconv.Quote(d.filename))
+		d.w.WriteHeader(http.StatusOK)
+	}
+}
+
+// ServeBlobsPage lists the blobs in the blob store.
+func (m *MasterHandler) ServeBlobsPage(w http.ResponseWriter, r *http.Request) {
+	blobs, err := jobproto.Blobs.List()
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if isAPIRequest(r) {
+		if blobs == nil {
+			blobs = []*jobproto.BlobInfo{}
+		}
+		m.serveJSON(w, blobs)
+	} else {
+		m.serveTemplate(w, "blobs", blobs)
+	}
+}
+
+// ServeAddBlob adds a blob to the blob store.
+//
+// The blob is either a file on the master, given by the
+// "path" field, or uploaded data.
+// Uploads are streamed, either as the "file" part of a
+// multipart form or as the request body.
+func (m *MasterHandler) ServeAddBlob(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		m.serveError(w, "adding a blob requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+	info, err := m.addBlob(r)
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+	} else if isAPIRequest(r) {
+		m.serveJSON(w, info)
+	} else {
+		http.Redirect(w, r, "/blobs", http.StatusSeeOther)
+	}
+}
+
+func (m *MasterHandler) addBlob(r *http.Request) (*jobproto.BlobInfo, error) {
+	query := r.URL.Query()
+	reader, err := r.MultipartReader()
+	if err != nil {
+		if p := query.Get("path"); p != "" {
+			return jobproto.Blobs.AddFile(p)
+		}
+		return jobproto.Blobs.Add(query.Get("name"), r.Body)
+	}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil, errors.New("missing file or path")
+		} else if err != nil {
+			return nil, err
+		}
+		switch part.FormName() {
+		case "path":
+			p, err := ioutil.ReadAll(io.LimitReader(part, 4096))
+			if err != nil {
+				return nil, err
+			}
+			if p := strings.TrimSpace(string(p)); p != "" {
+				return jobproto.Blobs.AddFile(p)
+			}
+		case "file":
+			if part.FileName() != "" {
+				return jobproto.Blobs.Add(path.Base(part.FileName()), part)
+			}
+		}
+	}
+}
+
+// ServeDeleteBlob removes a blob from the blob store.
+func (m *MasterHandler) ServeDeleteBlob(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		m.serveError(w, "blob deletion requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := jobproto.Blobs.Delete(r.FormValue("hash")); err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+	} else {
+		http.Redirect(w, r, "/blobs", http.StatusSeeOther)
+	}
+}
+
+// liveTaskPage is the template object for a live task.
+// Only the entries in [LogStart, LogEnd) are shown.
+type liveTaskPage struct {
+	*jobadmin.LiveTask
+
+	SlaveID   string
+	JobIndex  string
+	TaskIndex string
+
+	LogStart int
+	LogEnd   int
+}
+
 func (m *MasterHandler) ServeLiveTaskPage(w http.ResponseWriter, r *http.Request) {
-	job, err := m.liveJobForID(r.FormValue("slave"), r.FormValue("job"))
+	task, err := m.liveTaskForRequest(r)
 	if err != nil {
 		m.serveError(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	page := &liveTaskPage{
+		LiveTask:  task,
+		SlaveID:   r.FormValue("slave"),
+		JobIndex:  r.FormValue("job"),
+		TaskIndex: r.FormValue("task"),
+		LogEnd:    task.LogSize(),
+	}
+	if page.LogEnd > maxPageLogEntries {
+		page.LogStart = page.LogEnd - maxPageLogEntries
+	}
+	m.serveTemplate(w, "liveTask", page)
+}
+
+// ServeTaskLog serves the full log of a task as a text
+// file.
+func (m *MasterHandler) ServeTaskLog(w http.ResponseWriter, r *http.Request) {
+	var task interface {
+		LogSize() int
+		LogEntries(start, end int) []jobproto.LogEntry
+	}
+	var filename string
+	if id := r.FormValue("archive"); id != "" {
+		var archived *jobadmin.ArchivedTaskLog
+		if m.TaskLogs != nil {
+			archived = m.TaskLogs.Log(id)
+		}
+		if archived == nil {
+			m.serveError(w, "archived log not found", http.StatusBadRequest)
+			return
+		}
+		task = archived
+		filename = fmt.Sprintf("task_%s_%d.log", archived.Info.RunID, archived.Info.TaskIndex)
+	} else {
+		liveTask, err := m.liveTaskForRequest(r)
+		if err != nil {
+			m.serveError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		task = liveTask
+		filename = fmt.Sprintf("task_%s_%s_%s.log", r.FormValue("slave"), r.FormValue("job"),
+			r.FormValue("task"))
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Disposition", "attachment; filename="+strconv.Quote(filename))
+	const batchSize = 1000
+	size := task.LogSize()
+	for start := 0; start < size; start += batchSize {
+		end := start + batchSize
+		if end > size {
+			end = size
+		}
+		for _, entry := range task.LogEntries(start, end) {
+			source := "slave"
+			if entry.FromMaster {
+				source = "master"
+			}
+			line := fmt.Sprintf("%s [%s/%s] %s", entry.Time.Format(time.RFC3339Nano), source,
+				entry.Stream, entry.Message)
+			if !strings.HasSuffix(line, "\n") {
+				line += "\n"
+			}
+			if _, err := io.WriteString(w, line); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (m *MasterHandler) liveTaskForRequest(r *http.Request) (*jobadmin.LiveTask, error) {
+	job, err := m.liveJobForID(r.FormValue("slave"), r.FormValue("job"))
+	if err != nil {
+		return nil, err
+	}
 	taskIdx, err := strconv.Atoi(r.FormValue("task"))
 	if err != nil {
-		m.serveError(w, "invalid task index", http.StatusBadRequest)
-		return
+		return nil, errors.New("invalid task index")
 	}
 	count := job.TaskCount()
 	if taskIdx < 0 || taskIdx >= count {
-		m.serveError(w, "task index out of bounds", http.StatusBadRequest)
+		return nil, errors.New("task index out of bounds")
+	}
+	return job.Tasks(taskIdx, taskIdx+1)[0], nil
+}
+
+// logSearchPage is the template object for the log search
+// page. The query fields are kept as strings so that they
+// can be filled back into the search form.
+type logSearchPage struct {
+	Pattern   string
+	Regexp    bool
+	JobID     string
+	SlaveName string
+	Stream    string
+	Since     string
+	Until     string
+	Context   string
+
+	Jobs   []*jobadmin.Job
+	Slaves []string
+
+	Result *jobadmin.LogSearchResult
+	Error  string
+}
+
+func (m *MasterHandler) ServeLogSearchPage(w http.ResponseWriter, r *http.Request) {
+	page := &logSearchPage{
+		Pattern:   r.FormValue("q"),
+		Regexp:    r.FormValue("regexp") != "",
+		JobID:     r.FormValue("job"),
+		SlaveName: r.FormValue("slave"),
+		Stream:    r.FormValue("stream"),
+		Since:     r.FormValue("since"),
+		Until:     r.FormValue("until"),
+		Context:   r.FormValue("context"),
+	}
+	if page.Context == "" {
+		page.Context = "2"
+	}
+
+	if page.Pattern != "" || isAPIRequest(r) {
+		query, err := page.query()
+		if err == nil {
+			if limit := r.FormValue("limit"); limit != "" {
+				query.MaxResults, err = strconv.Atoi(limit)
+			}
+		}
+		if err == nil {
+			page.Result, err = m.Scheduler.SearchLogs(query, m.TaskLogs)
+		}
+		if err != nil {
+			if isAPIRequest(r) {
+				m.serveError(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			page.Error = err.Error()
+		}
+	}
+	if isAPIRequest(r) {
+		m.serveJSON(w, page.Result)
+		return
+	}
+
+	var err error
+	page.Jobs, err = m.Scheduler.Jobs()
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	masters, _, err := m.Scheduler.Masters()
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	task := job.Tasks(taskIdx, taskIdx+1)[0]
-	m.serveTemplate(w, "liveTask", task)
+	seen := map[string]bool{}
+	for _, master := range masters {
+		name := master.SlaveInfo().Name
+		if !seen[name] {
+			seen[name] = true
+			page.Slaves = append(page.Slaves, name)
+		}
+	}
+	sort.Strings(page.Slaves)
+
+	m.serveTemplate(w, "logSearch", page)
+}
+
+func (l *logSearchPage) query() (*jobadmin.LogQuery, error) {
+	q := &jobadmin.LogQuery{
+		Pattern:   l.Pattern,
+		Regexp:    l.Regexp,
+		JobID:     l.JobID,
+		SlaveName: l.SlaveName,
+		Stream:    l.Stream,
+	}
+	var err error
+	if q.Since, err = parseSearchTime(l.Since); err != nil {
+		return nil, err
+	}
+	if q.Until, err = parseSearchTime(l.Until); err != nil {
+		return nil, err
+	}
+	if q.Context, err = strconv.Atoi(l.Context); err != nil || q.Context < 0 {
+		return nil, errors.New("invalid context size: " + l.Context)
+	}
+	return q, nil
+}
+
+// parseSearchTime parses a time from a search form, which
+// may be an RFC 3339 time or a local time from a
+// datetime-local input.
+// An empty string yields the zero time.
+func parseSearchTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04"} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("invalid time: " + s)
 }
 
 func (m *MasterHandler) ServeClonePage(w http.ResponseWriter, r *http.Request) {
@@ -204,6 +877,7 @@ func (m *MasterHandler) ServeClonePage(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 			jCopy.ID = ""
+			jCopy.Key = ""
 			m.serveTemplate(w, "jobEdit", jCopy)
 			return
 		}
@@ -301,6 +975,63 @@ func (m *MasterHandler) ServeLaunch(w http.ResponseWriter, r *http.Request) {
 	m.serveError(w, "job ID not found", http.StatusBadRequest)
 }
 
+func (m *MasterHandler) ServeSettingsPage(w http.ResponseWriter, r *http.Request) {
+	m.serveSettings(w, "")
+}
+
+func (m *MasterHandler) ServeRotateKeys(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		m.serveError(w, "rotation requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := m.Auth.RotateKeys(); err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// Re-issue our own cookie with the new keys.
+	m.Auth.Auth(w, r)
+	http.Redirect(w, r, "/settings", http.StatusSeeOther)
+}
+
+func (m *MasterHandler) ServeAddToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		m.serveError(w, "token creation requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+	name := strings.TrimSpace(r.FormValue("name"))
+	if name == "" {
+		m.serveError(w, "missing token name", http.StatusBadRequest)
+		return
+	}
+	token, err := m.Auth.CreateToken(name)
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	m.serveSettings(w, token)
+}
+
+func (m *MasterHandler) ServeRevokeToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		m.serveError(w, "token revocation requires POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := m.Auth.RevokeToken(r.FormValue("id")); err != nil {
+		m.serveError(w, err.Error(), http.StatusBadRequest)
+	} else {
+		http.Redirect(w, r, "/settings", http.StatusSeeOther)
+	}
+}
+
+func (m *MasterHandler) serveSettings(w http.ResponseWriter, newToken string) {
+	pageObj := map[string]interface{}{
+		"KeysModTime": m.Auth.KeysModTime(),
+		"Tokens":      m.Auth.Tokens(),
+		"NewToken":    newToken,
+	}
+	m.serveTemplate(w, "settings", pageObj)
+}
+
 func (m *MasterHandler) serveAsset(w http.ResponseWriter, r *http.Request, cleanPath string) {
 	if asset, err := Asset(cleanPath[1:]); err != nil {
 		m.serveNotFound(w, r)
@@ -326,6 +1057,61 @@ func (m *MasterHandler) serveTemplate(w http.ResponseWriter, name string, obj in
 	}
 }
 
+func (m *MasterHandler) serveJSON(w http.ResponseWriter, obj interface{}) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		m.serveError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Write(data)
+}
+
+// readJobFile reads a job file from an upload, a form
+// field, or the request body, in that order.
+func (m *MasterHandler) readJobFile(r *http.Request) ([]*jobadmin.Job, error) {
+	var data []byte
+	var filename string
+	if isMultipartRequest(r) {
+		if file, header, err := r.FormFile("file"); err == nil {
+			defer file.Close()
+			filename = header.Filename
+			data, err = ioutil.ReadAll(io.LimitReader(file, maxJobFileSize))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(data) == 0 {
+			data = []byte(r.FormValue("jobs"))
+		}
+	} else if r.Body != nil {
+		// The body is read before anything else looks at the
+		// form, since parsing a form would consume it.
+		var err error
+		data, err = ioutil.ReadAll(io.LimitReader(r.Body, maxJobFileSize))
+		if err != nil {
+			return nil, err
+		}
+	}
+	format, err := parseJobFormat(r.FormValue("format"), filename)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJobFile(data, format)
+}
+
+func isMultipartRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "multipart/form-data"
+}
+
+// isAPIRequest returns true if the request should be
+// answered with JSON rather than a web page.
+func isAPIRequest(r *http.Request) bool {
+	return bearerToken(r) != ""
+}
+
 func (m *MasterHandler) serveNotFound(w http.ResponseWriter, r *http.Request) {
 	http.NotFound(w, r)
 }
@@ -335,7 +1121,7 @@ func (m *MasterHandler) serveError(w http.ResponseWriter, msg string, code int)
 }
 
 func (m *MasterHandler) slaveForID(slaveID string) (*jobadmin.LiveMaster, bool, error) {
-	idx, err := strconv.Atoi(slaveID)
+	id, err := strconv.Atoi(slaveID)
 	if err != nil {
 		return nil, false, errors.New("invalid slave ID")
 	}
@@ -343,10 +1129,12 @@ func (m *MasterHandler) slaveForID(slaveID string) (*jobadmin.LiveMaster, bool,
 	if err != nil {
 		return nil, false, err
 	}
-	if idx < 0 || idx >= len(masters) {
-		return nil, false, errors.New("slave index out of bounds")
+	for i, master := range masters {
+		if master.ID() == id {
+			return master, auto[i], nil
+		}
 	}
-	return masters[idx], auto[idx], nil
+	return nil, false, errors.New("slave not found")
 }
 
 func (m *MasterHandler) liveJobForID(slaveID, jobIdx string) (*jobadmin.LiveJob, error) {
@@ -366,6 +1154,12 @@ func (m *MasterHandler) liveJobForID(slaveID, jobIdx string) (*jobadmin.LiveJob,
 }
 
 func (m *MasterHandler) addJob(job *jobadmin.Job) error {
+	return m.importJobs([]*jobadmin.Job{job})
+}
+
+// importJobs adds new jobs to the front of the pool,
+// giving each one a fresh ID.
+func (m *MasterHandler) importJobs(newJobs []*jobadmin.Job) error {
 	m.JobsLock.Lock()
 	defer m.JobsLock.Unlock()
 
@@ -373,15 +1167,17 @@ func (m *MasterHandler) addJob(job *jobadmin.Job) error {
 	if err != nil {
 		return err
 	}
-
-	idBytes := make([]byte, 16)
-	if _, err := rand.Read(idBytes); err != nil {
+	if err := checkNewKeys(jobs, newJobs); err != nil {
 		return err
 	}
-	idString := hex.EncodeToString(idBytes)
 
-	job.ID = idString
-	jobs = append([]*jobadmin.Job{job}, jobs...)
+	for _, job := range newJobs {
+		job.ID, err = newJobID()
+		if err != nil {
+			return err
+		}
+	}
+	jobs = append(append([]*jobadmin.Job{}, newJobs...), jobs...)
 	if err := m.Scheduler.SetJobs(jobs); err != nil {
 		return err
 	}
@@ -389,6 +1185,27 @@ func (m *MasterHandler) addJob(job *jobadmin.Job) error {
 	return m.saveJobs(jobs)
 }
 
+// applyJobs makes the keyed jobs in the pool match a job
+// file, as described by planApply.
+// If dryRun is set, the pool is left unchanged.
+func (m *MasterHandler) applyJobs(desired []*jobadmin.Job, dryRun bool) ([]*JobChange, error) {
+	m.JobsLock.Lock()
+	defer m.JobsLock.Unlock()
+
+	jobs, err := m.Scheduler.Jobs()
+	if err != nil {
+		return nil, err
+	}
+	changes, newJobs, err := planApply(jobs, desired)
+	if err != nil || dryRun {
+		return changes, err
+	}
+	if err := m.Scheduler.SetJobs(newJobs); err != nil {
+		return nil, err
+	}
+	return changes, m.saveJobs(newJobs)
+}
+
 func (m *MasterHandler) modifyJob(job *jobadmin.Job) error {
 	m.JobsLock.Lock()
 	defer m.JobsLock.Unlock()
@@ -454,10 +1271,98 @@ func (m *MasterHandler) deleteJob(id string) error {
 	return m.saveJobs(newJobs)
 }
 
-func (m *MasterHandler) saveJobs(jobs []*jobadmin.Job) error {
-	encoded, err := json.Marshal(jobs)
+// ReloadJobs reads the job pool from disk and applies it
+// to the scheduler.
+// If the file is invalid, the current pool is kept and the
+// error is logged and reported on the jobs page.
+func (m *MasterHandler) ReloadJobs() error {
+	m.JobsLock.Lock()
+	defer m.JobsLock.Unlock()
+	return m.reloadJobs()
+}
+
+// WatchJobs polls the job pool file and reloads it when it
+// is changed externally.
+// It returns once the scheduler is terminated.
+func (m *MasterHandler) WatchJobs(interval time.Duration) {
+	for !m.Scheduler.Terminated() {
+		time.Sleep(interval)
+		m.JobsLock.Lock()
+		changed, err := m.JobStore.Changed()
+		if err != nil {
+			logError("Failed to check jobs file:", err)
+		} else if changed {
+			m.reloadLock.RLock()
+			retrying := m.reloadError != nil
+			m.reloadLock.RUnlock()
+			if !retrying {
+				logInfo("Jobs file changed; reloading.")
+			}
+			m.reloadJobs()
+		}
+		m.JobsLock.Unlock()
+	}
+}
+
+// reloadJobs replaces the job pool with the contents of
+// the jobs file.
+// If the file cannot be loaded, the current pool is kept
+// and the error is shown on the jobs page.
+func (m *MasterHandler) reloadJobs() error {
+	jobs, err := m.JobStore.Reload()
+	if err == nil {
+		err = m.Scheduler.SetJobs(jobs)
+	}
+	m.reloadLock.RLock()
+	lastErr := m.reloadError
+	m.reloadLock.RUnlock()
+	if err == nil {
+		logInfo("Reloaded", len(jobs), "jobs.")
+	} else if lastErr == nil || lastErr.Error() != err.Error() {
+		// Failed reloads are retried, so only new errors
+		// are logged.
+		logError("Failed to reload jobs:", err)
+	}
+	m.reloadLock.Lock()
+	m.reloadError = err
+	m.reloadTime = time.Now()
+	m.reloadLock.Unlock()
+	return err
+}
+
+func (m *MasterHandler) rollbackJobs(version string) error {
+	m.JobsLock.Lock()
+	defer m.JobsLock.Unlock()
+
+	jobs, err := m.JobStore.LoadVersion(version)
 	if err != nil {
 		return err
 	}
-	return ioutil.WriteFile(m.JobsPath, encoded, 0755)
+	if err := m.Scheduler.SetJobs(jobs); err != nil {
+		return err
+	}
+	return m.saveJobs(jobs)
+}
+
+func newJobID() (string, error) {
+	idBytes := make([]byte, 16)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(idBytes), nil
+}
+
+func (m *MasterHandler) saveJobs(jobs []*jobadmin.Job) error {
+	return m.JobStore.Save(jobs)
+}
+
+// prettyJSON indents JSON data so that it can be diffed
+// line by line.
+// Invalid JSON is returned as-is.
+func prettyJSON(data []byte) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return string(data)
+	}
+	return buf.String()
 }
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/unixpickle/jobempire/jobadmin"
+	"github.com/unixpickle/jobempire/jobproto"
+)
+
+func TestWatchJobs(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobempire_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	handler := &MasterHandler{
+		Scheduler: jobadmin.NewScheduler(),
+		JobStore:  testJobStore(tempDir, 10),
+	}
+	defer handler.Scheduler.Terminate()
+	if err := handler.saveJobs(testJobPool("v0")); err != nil {
+		t.Fatal(err)
+	}
+	if err := handler.Scheduler.SetJobs(testJobPool("v0")); err != nil {
+		t.Fatal(err)
+	}
+	go handler.WatchJobs(time.Millisecond * 10)
+
+	writeJobs := func(data string) {
+		if err := ioutil.WriteFile(handler.JobStore.Path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	encodedPool := func(name string) string {
+		data, err := json.Marshal(testJobPool(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	writeJobs(encodedPool("v1"))
+	waitReload(t, handler, "v1", "")
+
+	writeJobs("[not json")
+	waitReload(t, handler, "v1", "invalid character")
+
+	writeJobs(encodedPool("v2"))
+	waitReload(t, handler, "v2", "")
+
+	if err := os.Remove(handler.JobStore.Path); err != nil {
+		t.Fatal(err)
+	}
+	waitReload(t, handler, "v2", "no such file")
+
+	writeJobs("null")
+	waitReload(t, handler, "v2", "does not contain")
+	if err := handler.ReloadJobs(); err == nil {
+		t.Error("expected error from explicit reload")
+	}
+	checkJobPool(t, "after explicit reload", handler.Scheduler.Jobs, "v2")
+}
+
+// waitReload waits until the handler's job pool and
+// reload error match the expected ones.
+// An empty errMsg means that there should be no error.
+func waitReload(t *testing.T, m *MasterHandler, name, errMsg string) {
+	timeout := time.After(time.Second * 10)
+	for {
+		jobs, err := m.Scheduler.Jobs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		m.reloadLock.RLock()
+		reloadErr := m.reloadError
+		m.reloadLock.RUnlock()
+		errMatches := (errMsg == "" && reloadErr == nil) ||
+			(errMsg != "" && reloadErr != nil && strings.Contains(reloadErr.Error(), errMsg))
+		if len(jobs) == 1 && jobs[0].Name == name && errMatches {
+			return
+		}
+		select {
+		case <-timeout:
+			t.Fatalf("expected pool %s and error %q but got %v and %v", name, errMsg,
+				jobs, reloadErr)
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+}
+
+func TestServeDeleteBlob(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "jobempire_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	jobproto.Blobs, err = jobproto.NewBlobStore(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		jobproto.Blobs = nil
+	}()
+	info, err := jobproto.Blobs.Add("blob", strings.NewReader("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler := &MasterHandler{}
+	form := url.Values{"hash": {info.Hash}}
+	rec := httptest.NewRecorder()
+	handler.ServeDeleteBlob(rec, httptest.NewRequest("GET", "/blobs/delete?"+form.Encode(), nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET: expected status %d but got %d", http.StatusMethodNotAllowed, rec.Code)
+	}
+	if _, err := jobproto.Blobs.Info(info.Hash); err != nil {
+		t.Fatal("blob was deleted by GET:", err)
+	}
+
+	rec = httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "/blobs/delete", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	handler.ServeDeleteBlob(rec, r)
+	if rec.Code != http.StatusSeeOther {
+		t.Errorf("POST: expected status %d but got %d", http.StatusSeeOther, rec.Code)
+	}
+	if _, err := jobproto.Blobs.Info(info.Hash); err == nil {
+		t.Error("blob was not deleted by POST")
+	}
+}
@@ -1,41 +1,200 @@
 package main
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/unixpickle/jobempire/jobproto"
 )
 
-func SlaveMain(host string, port int, password string) {
-	conn, err := net.Dial("tcp", host+":"+strconv.Itoa(port))
+const cachePruneInterval = time.Hour
+
+func SlaveMain(config *SlaveConfig) {
+	if err := setLogLevel(config.LogLevel); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	password, err := readPassFile(config.PassFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to read password:", err)
+		os.Exit(1)
+	}
+
+	jobproto.ExecCache, err = jobproto.NewFileCache(config.ExecCacheDir,
+		int64(config.ExecCacheSize)<<20, time.Duration(config.ExecCacheHours)*time.Hour)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to create executable cache:", err)
+		os.Exit(1)
+	}
+
+	jobproto.BlobCache, err = jobproto.NewFileCache(config.BlobCacheDir,
+		int64(config.BlobCacheSize)<<20, time.Duration(config.BlobCacheHours)*time.Hour)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to create blob cache:", err)
+		os.Exit(1)
+	}
+
+	jobproto.WorkDirs, err = jobproto.NewWorkDirStore(config.WorkDir,
+		time.Duration(config.WorkDirHours)*time.Hour, int64(config.WorkDirSize)<<20,
+		int64(config.WorkDirMinFree)<<20)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to create job directory:", err)
+		os.Exit(1)
+	}
+	go pruneCaches()
+
+	jobproto.TransferCompression, err = jobproto.ParseCompression(config.Compression)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	jobproto.PeerPassword = password
+	if config.TLS {
+		jobproto.PeerTLS, err = peerTLSConfig(config)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to configure peer TLS:", err)
+			os.Exit(1)
+		}
+	}
+	if config.PeerPort != 0 {
+		peerListener, err := net.Listen("tcp", net.JoinHostPort(config.PeerBind,
+			strconv.Itoa(config.PeerPort)))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to listen for peers:", err)
+			os.Exit(1)
+		}
+		defer peerListener.Close()
+		go jobproto.ServePeers(peerListener, jobproto.BlobCache)
+	}
+
+	conn, err := dialConfig(config)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "Failed to connect:", err)
 		os.Exit(1)
 	}
-	slave, err := jobproto.NewSlaveConnAuth(conn, password)
+	slave, err := jobproto.NewSlaveConnAuthInfo(conn, password, slaveInfo(config))
 	if err != nil {
 		fmt.Fprintln(os.Stderr, "Failed to authenticate:", err)
 		os.Exit(1)
 	}
 	defer slave.Close()
+	logInfo("Connected to master", conn.RemoteAddr())
 
 	for {
 		job, err := slave.NextJob()
 		if err != nil {
 			break
 		}
+		logDebug("Starting new job.")
 		go func() {
-			rootDir, err := ioutil.TempDir("", "job")
+			rootDir, err := jobproto.WorkDirs.Create()
 			if err != nil {
+				logError("Failed to create job directory:", err)
 				slave.Close()
 				return
 			}
-			defer os.RemoveAll(rootDir)
 			job.RunTasks(rootDir)
+			kept, err := jobproto.WorkDirs.Finish(rootDir, job.Info(), job.Failed())
+			if err != nil {
+				logError("Failed to clean up job directory:", err)
+			} else if kept {
+				logInfo("Kept job directory", rootDir)
+			}
 		}()
 	}
+	logInfo("Disconnected from master.")
+}
+
+// pruneCaches periodically evicts old executables, blobs,
+// and kept job directories, since they are otherwise only
+// pruned when they grow.
+func pruneCaches() {
+	for {
+		if err := jobproto.ExecCache.Prune(); err != nil {
+			logError("Failed to prune executable cache:", err)
+		}
+		if err := jobproto.BlobCache.Prune(); err != nil {
+			logError("Failed to prune blob cache:", err)
+		}
+		if err := jobproto.WorkDirs.Prune(); err != nil {
+			logError("Failed to prune job directories:", err)
+		}
+		time.Sleep(cachePruneInterval)
+	}
+}
+
+// slaveInfo computes the SlaveInfo to advertise to the
+// master, taking the configured limits into account.
+func slaveInfo(config *SlaveConfig) jobproto.SlaveInfo {
+	info := jobproto.CurrentSlaveInfo()
+	if config.MaxMem > 0 {
+		info.TotalMem = config.MaxMem
+	}
+	if config.MaxCPU > 0 {
+		info.MaxProcs = config.MaxCPU
+	}
+	if config.Name != "" {
+		info.Name = config.Name
+	}
+	info.Labels = config.Labels
+	info.PeerPort = config.PeerPort
+	info.PeerHost = config.PeerHost
+	return info
+}
+
+func dialConfig(config *SlaveConfig) (net.Conn, error) {
+	addr := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	if !config.TLS {
+		return net.Dial("tcp", addr)
+	}
+	tlsConfig, err := clientTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.ServerName = config.Host
+	return tls.Dial("tcp", addr, tlsConfig)
+}
+
+// clientTLSConfig creates a TLS config which trusts the
+// certificate authorities from TLSCA, or the system's if
+// it is not set.
+func clientTLSConfig(config *SlaveConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+	if config.TLSCA != "" {
+		pemData, err := ioutil.ReadFile(config.TLSCA)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pemData) {
+			return nil, errors.New("no certificates in " + config.TLSCA)
+		}
+	}
+	return tlsConfig, nil
+}
+
+// peerTLSConfig creates the TLS config for connections to
+// and from other slaves, which trusts the same authorities
+// as the master connection.
+func peerTLSConfig(config *SlaveConfig) (*tls.Config, error) {
+	tlsConfig, err := clientTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	if config.PeerTLSCert != "" {
+		cert, err := tls.LoadX509KeyPair(config.PeerTLSCert, config.PeerTLSKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
 }